@@ -33,6 +33,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	dlaasClient "github.com/IBM/FfDL/restapi/api_v1/client"
+	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
 	"net/http"
 	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
 	"time"
@@ -321,6 +322,23 @@ func responseError(s string, err error, ui terminal.UI) {
 	return
 }
 
+// printBulkResult prints the models a bulk operation was applied to and fails if it failed for any of them
+func printBulkResult(result *restmodels.BulkResult, ui terminal.UI) {
+	failed := 0
+	table := ui.Table([]string{"ID", "Error"})
+	for _, m := range result.Models {
+		if m.Error != "" {
+			failed++
+		}
+		table.Add(m.ModelID, m.Error)
+	}
+	table.Print()
+	ui.Say("\n%d records found.", len(result.Models))
+	if failed > 0 {
+		ui.Failed("Failed for %d of the models.", failed)
+	}
+}
+
 func formatTimestamp(dateTime string) string {
 	t, err := time.Parse(dateFormat, dateTime)
 	if err != nil {
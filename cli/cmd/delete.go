@@ -17,6 +17,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
 	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
@@ -44,7 +46,9 @@ func (cmd *DeleteCmd) Run(cliContext *cli.Context) error {
 
 	args := cliContext.Args()
 
-	if len(args) == 0 {
+	if selector := cliContext.String("selector"); selector != "" {
+		cmd.deleteSelected(selector)
+	} else if len(args) == 0 {
 		cmd.ui.Failed("Argument MODEL_ID missing")
 	} else {
 		modelID := args[0]
@@ -75,3 +79,31 @@ func (cmd *DeleteCmd) Run(cliContext *cli.Context) error {
 	}
 	return nil
 }
+
+func (cmd *DeleteCmd) deleteSelected(selector string) {
+	cmd.ui.Say("Deleting models matching '%s'...", terminal.EntityNameColor(selector))
+	c, err := NewDlaaSClient()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	params := models.NewDeleteModelsParams().
+		WithLabelSelector(selector).
+		WithTimeout(defaultOpTimeout)
+
+	resp, err := c.Models.DeleteModels(params, BasicAuth())
+
+	if err != nil {
+		var s string
+		switch e := err.(type) {
+		case *models.DeleteModelsUnauthorized:
+			s = badUsernameOrPWD
+		case *models.DeleteModelsBadRequest:
+			if e.Payload != nil {
+				s = fmt.Sprintf("Error: %s. %s", e.Payload.Error, e.Payload.Description)
+			}
+		}
+		responseError(s, err, cmd.ui)
+		return
+	}
+	printBulkResult(resp.Payload, cmd.ui)
+}
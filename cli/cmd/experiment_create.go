@@ -0,0 +1,121 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/FfDL/restapi/api_v1/client/experiments"
+
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
+	"github.com/urfave/cli"
+)
+
+// ExperimentCreateCmd is the struct to start a hyperparameter sweep.
+type ExperimentCreateCmd struct {
+	ui      terminal.UI
+	config  plugin.PluginConfig
+	context plugin.PluginContext
+}
+
+// NewExperimentCreateCmd is used to start a hyperparameter sweep.
+func NewExperimentCreateCmd(ui terminal.UI, context plugin.PluginContext) *ExperimentCreateCmd {
+	return &ExperimentCreateCmd{
+		ui:      ui,
+		context: context,
+	}
+}
+
+// Run is the handler for the experiment-create CLI command.
+func (cmd *ExperimentCreateCmd) Run(cliContext *cli.Context) error {
+	cmd.config = cmd.context.PluginConfig()
+
+	args := cliContext.Args()
+	if len(args) != 3 {
+		cmd.ui.Failed("Incorrect number of arguments.")
+	}
+	params := experiments.NewPostExperimentParams().WithTimeout(defaultOpTimeout)
+
+	params.WithManifest(openManifestFile(cmd.ui, args[0]))
+
+	experimentFile, err := os.Open(args[2])
+	if err != nil {
+		cmd.ui.Failed("Error opening experiment file %s: %v", args[2], err)
+	}
+	params.WithExperiment(*experimentFile)
+
+	_, manifestFile := filepath.Split(args[0])
+	_, experimentFileName := filepath.Split(args[2])
+
+	if strings.Contains(args[1], ".zip") {
+		modelDefinitionFile := args[1]
+		cmd.ui.Say("Creating experiment '%s' with manifest '%s' and model file '%s'...", terminal.EntityNameColor(experimentFileName),
+			terminal.EntityNameColor(manifestFile), terminal.EntityNameColor(modelDefinitionFile))
+		params.WithModelDefinition(*openModelDefinitionFile(cmd.ui, modelDefinitionFile))
+	} else {
+		modelDir := args[1]
+		cmd.ui.Say("Creating experiment '%s' with manifest '%s' and model files in '%s'...", terminal.EntityNameColor(experimentFileName),
+			terminal.EntityNameColor(manifestFile), terminal.EntityNameColor(modelDir))
+		f, err2 := zipit(modelDir + "/")
+		if err2 != nil {
+			cmd.ui.Failed("Unexpected error when compressing model directory: %v", err2)
+			os.Exit(1)
+		}
+
+		zip, err := os.Open(f.Name())
+		if err != nil {
+			cmd.ui.Failed("Error opening temporary ZIP file: %v", err)
+		}
+		defer os.Remove(zip.Name())
+
+		params.WithModelDefinition(*zip)
+	}
+
+	c, err := NewDlaaSClient()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	response, err := c.Experiments.PostExperiment(params, BasicAuth())
+
+	if err != nil {
+		var s string
+		switch err.(type) {
+		case *experiments.PostExperimentUnauthorized:
+			s = badUsernameOrPWD
+		case *experiments.PostExperimentBadRequest:
+			if resp, ok := err.(*experiments.PostExperimentBadRequest); ok {
+				if resp.Payload != nil {
+					s = fmt.Sprintf("Error: %s. %s", resp.Payload.Error, resp.Payload.Description)
+				} else {
+					s = fmt.Sprintf("Bad request: %s", err.Error())
+				}
+			}
+		}
+		responseError(s, err, cmd.ui)
+		return nil
+	}
+
+	id := LocationToID(response.Location)
+	cmd.ui.Say("Experiment ID: %s", terminal.EntityNameColor(id))
+	cmd.ui.Ok()
+
+	return nil
+}
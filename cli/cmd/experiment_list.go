@@ -0,0 +1,73 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
+	"github.com/IBM/FfDL/restapi/api_v1/client/experiments"
+	"github.com/urfave/cli"
+)
+
+// ExperimentListCmd is the struct to get all experiments.
+type ExperimentListCmd struct {
+	ui      terminal.UI
+	config  plugin.PluginConfig
+	context plugin.PluginContext
+}
+
+// NewExperimentListCmd is used to get all experiments.
+func NewExperimentListCmd(ui terminal.UI, context plugin.PluginContext) *ExperimentListCmd {
+	return &ExperimentListCmd{
+		ui:      ui,
+		context: context,
+	}
+}
+
+// Run is the handler for the experiment-list CLI command.
+func (cmd *ExperimentListCmd) Run(cliContext *cli.Context) error {
+	cmd.config = cmd.context.PluginConfig()
+	cmd.ui.Say("Getting all experiments ...")
+
+	c, err := NewDlaaSClient()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	params := experiments.NewListExperimentsParams().WithTimeout(defaultOpTimeout)
+
+	experimentz, err := c.Experiments.ListExperiments(params, BasicAuth())
+
+	if err != nil {
+		var s string
+		switch err.(type) {
+		case *experiments.ListExperimentsUnauthorized:
+			s = badUsernameOrPWD
+		}
+		responseError(s, err, cmd.ui)
+		return nil
+	}
+	table := cmd.ui.Table([]string{"ID", "Name", "Strategy", "State", "Trials", "Best model", "Submitted", "Completed"})
+	for _, v := range experimentz.Payload.Experiments {
+		table.Add(v.ExperimentID, v.Name, v.Strategy, v.State, strconv.Itoa(len(v.Trials)), v.BestModelID,
+			formatTimestamp(v.Submitted), formatTimestamp(v.Completed))
+	}
+	table.Print()
+	cmd.ui.Say("\n%d records found.", len(experimentz.Payload.Experiments))
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
+	"github.com/IBM/FfDL/restapi/api_v1/client/experiments"
+	"github.com/urfave/cli"
+)
+
+// ExperimentShowCmd is the struct to get details of an experiment and its trials.
+type ExperimentShowCmd struct {
+	ui      terminal.UI
+	config  plugin.PluginConfig
+	context plugin.PluginContext
+}
+
+// NewExperimentShowCmd is used to get an experiment's info.
+func NewExperimentShowCmd(ui terminal.UI, context plugin.PluginContext) *ExperimentShowCmd {
+	return &ExperimentShowCmd{
+		ui:      ui,
+		context: context,
+	}
+}
+
+// Run is the handler for the experiment-show CLI command.
+func (cmd *ExperimentShowCmd) Run(cliContext *cli.Context) error {
+	cmd.config = cmd.context.PluginConfig()
+
+	args := cliContext.Args()
+
+	if len(args) == 0 {
+		cmd.ui.Failed("Argument EXPERIMENT_ID missing")
+		return nil
+	}
+	experimentID := args[0]
+	isJSON := cliContext.IsSet("json")
+
+	cmd.ui.Say("Getting experiment '%s'...", terminal.EntityNameColor(experimentID))
+	c, err := NewDlaaSClient()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	params := experiments.NewGetExperimentParams().
+		WithExperimentID(experimentID).
+		WithTimeout(defaultOpTimeout)
+
+	experimentInfo, err := c.Experiments.GetExperiment(params, BasicAuth())
+
+	if err != nil {
+		var s string
+		switch err.(type) {
+		case *experiments.GetExperimentUnauthorized:
+			s = badUsernameOrPWD
+		case *experiments.GetExperimentNotFound:
+			s = "Experiment not found."
+		}
+		responseError(s, err, cmd.ui)
+		return nil
+	}
+
+	if isJSON {
+		jbytes, err := json.Marshal(experimentInfo)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+		}
+		var out bytes.Buffer
+		json.Indent(&out, jbytes, "", "\t")
+		out.WriteTo(os.Stdout)
+		return nil
+	}
+
+	e := experimentInfo.Payload
+
+	cmd.ui.Say("Id: %s", terminal.EntityNameColor(e.ExperimentID))
+	cmd.ui.Say("Name: %s", e.Name)
+	cmd.ui.Say("State: %s", terminal.EntityNameColor(e.State))
+	cmd.ui.Say("Strategy: %s (max. %d in parallel)", e.Strategy, e.MaxParallel)
+	if e.ObjectiveMetric != "" {
+		cmd.ui.Say("Objective: %s %s", e.ObjectiveGoal, e.ObjectiveMetric)
+	}
+	if e.BestValue != nil {
+		cmd.ui.Say("Best model: %s (%v)", terminal.EntityNameColor(e.BestModelID), *e.BestValue)
+	}
+	cmd.ui.Say("Submitted: %s", formatTimestamp(e.Submitted))
+	cmd.ui.Say("Completed: %s", formatTimestamp(e.Completed))
+
+	table := cmd.ui.Table([]string{"Trial", "Model ID", "Parameters", "Training status", "Objective"})
+	for _, t := range e.Trials {
+		names := make([]string, 0, len(t.Parameters))
+		for name := range t.Parameters {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		values := make([]string, 0, len(names))
+		for _, name := range names {
+			values = append(values, name+"="+t.Parameters[name])
+		}
+		value := ""
+		if t.Value != nil {
+			value = strconv.FormatFloat(*t.Value, 'g', -1, 64)
+		}
+		table.Add(strconv.Itoa(int(t.Index)), t.ModelID, strings.Join(values, " "), t.Status, value)
+	}
+	table.Print()
+	cmd.ui.Ok()
+
+	return nil
+}
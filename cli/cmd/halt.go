@@ -17,6 +17,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
 	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
@@ -45,7 +47,9 @@ func (cmd *HaltCmd) Run(cliContext *cli.Context) error {
 
 	args := cliContext.Args()
 
-	if len(args) == 0 {
+	if selector := cliContext.String("selector"); selector != "" {
+		cmd.haltSelected(selector)
+	} else if len(args) == 0 {
 		cmd.ui.Failed("Argument MODEL_ID missing")
 	} else {
 		modelID := args[0]
@@ -77,3 +81,33 @@ func (cmd *HaltCmd) Run(cliContext *cli.Context) error {
 	}
 	return nil
 }
+
+func (cmd *HaltCmd) haltSelected(selector string) {
+	cmd.ui.Say("Halting training jobs matching '%s'...", terminal.EntityNameColor(selector))
+	c, err := NewDlaaSClient()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	params := models.NewPatchModelsParamsWithTimeout(defaultOpTimeout).
+		WithLabelSelector(selector).
+		WithPayload(&restmodels.TrainingUpdate{
+			Status: "halt",
+		})
+	resp, err := c.Models.PatchModels(params, basicAuth)
+
+	if err != nil {
+		var s string
+		switch e := err.(type) {
+		case *models.PatchModelsUnauthorized:
+			s = badUsernameOrPWD
+		case *models.PatchModelsBadRequest:
+			if e.Payload != nil {
+				s = fmt.Sprintf("Error: %s. %s", e.Payload.Error, e.Payload.Description)
+			}
+		}
+		responseError(s, err, cmd.ui)
+		return
+	}
+	printBulkResult(resp.Payload, cmd.ui)
+}
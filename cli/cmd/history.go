@@ -0,0 +1,96 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
+	"github.com/IBM/FfDL/restapi/api_v1/client/models"
+	"github.com/urfave/cli"
+)
+
+// HistoryCmd is the struct to get the status history of a training.
+type HistoryCmd struct {
+	ui      terminal.UI
+	config  plugin.PluginConfig
+	context plugin.PluginContext
+}
+
+// NewHistoryCmd is used to get the status history of a training.
+func NewHistoryCmd(ui terminal.UI, context plugin.PluginContext) *HistoryCmd {
+	return &HistoryCmd{
+		ui:      ui,
+		context: context,
+	}
+}
+
+// Run is the handler for the history CLI command.
+func (cmd *HistoryCmd) Run(cliContext *cli.Context) error {
+	cmd.config = cmd.context.PluginConfig()
+
+	args := cliContext.Args()
+	if len(args) == 0 {
+		cmd.ui.Failed("Argument MODEL_ID missing")
+		return nil
+	}
+	modelID := args[0]
+
+	cmd.ui.Say("Getting status history of model '%s'...", terminal.EntityNameColor(modelID))
+	c, err := NewDlaaSClient()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	params := models.NewGetModelHistoryParams().
+		WithModelID(modelID).
+		WithTimeout(defaultOpTimeout)
+
+	history, err := c.Models.GetModelHistory(params, BasicAuth())
+	if err != nil {
+		var s string
+		switch err.(type) {
+		case *models.GetModelHistoryUnauthorized:
+			s = badUsernameOrPWD
+		case *models.GetModelHistoryNotFound:
+			s = "Model not found."
+		}
+		responseError(s, err, cmd.ui)
+		return nil
+	}
+
+	table := cmd.ui.Table([]string{"Status", "Since", "Duration", "Error code", "Message"})
+	for _, e := range history.Payload.Entries {
+		duration := ""
+		if e.Duration > 0 {
+			duration = (time.Duration(e.Duration) * time.Millisecond).Round(time.Second).String()
+		}
+		table.Add(e.Status, formatMillis(e.Timestamp), duration, e.ErrorCode, e.StatusMessage)
+	}
+	table.Print()
+	return nil
+}
+
+// formatMillis formats a timestamp given in milliseconds since the epoch
+func formatMillis(millis string) string {
+	ms, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return "N/A"
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)).Format(time.RFC1123)
+}
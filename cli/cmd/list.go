@@ -17,6 +17,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli"
 	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
 	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
@@ -52,6 +54,23 @@ func (cmd *ListCmd) Run(cliContext *cli.Context) error {
 	lflog().Debugf("Calling ListModels")
 
 	params := models.NewListModelsParams().WithTimeout(defaultOpTimeout)
+	for flag, param := range map[string]**string{
+		"status":    &params.Status,
+		"framework": &params.Framework,
+		"name":      &params.NamePrefix,
+		"since":     &params.SubmittedAfter,
+		"until":     &params.SubmittedBefore,
+		"sort":      &params.Sort,
+		"selector":  &params.LabelSelector,
+		"page":      &params.PageToken,
+	} {
+		if value := cliContext.String(flag); value != "" {
+			*param = &value
+		}
+	}
+	if pagesize := int32(cliContext.Int("pagesize")); pagesize > 0 {
+		params.PageSize = &pagesize
+	}
 
 	modelz, err := c.Models.ListModels(params, BasicAuth())
 
@@ -61,6 +80,14 @@ func (cmd *ListCmd) Run(cliContext *cli.Context) error {
 		switch err.(type) {
 		case *models.ListModelsUnauthorized:
 			s = badUsernameOrPWD
+		case *models.ListModelsBadRequest:
+			if resp, ok := err.(*models.ListModelsBadRequest); ok {
+				if resp.Payload != nil { // we may not have a payload
+					s = fmt.Sprintf("Error: %s. %s", resp.Payload.Error, resp.Payload.Description)
+				} else {
+					s = fmt.Sprintf("Bad request: %s", err.Error())
+				}
+			}
 		}
 		responseError(s, err, cmd.ui)
 		return nil
@@ -73,5 +100,8 @@ func (cmd *ListCmd) Run(cliContext *cli.Context) error {
 	}
 	table.Print()
 	cmd.ui.Say("\n%d records found.", len(modelz.Payload.Models))
+	if modelz.Payload.NextPageToken != "" {
+		cmd.ui.Say("More records are available, list them with --page %s", modelz.Payload.NextPageToken)
+	}
 	return nil
 }
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
+	"github.com/IBM/FfDL/restapi/api_v1/client/models"
+	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
+	"github.com/urfave/cli"
+)
+
+// PriorityCmd is the struct to change the priority of a queued training job.
+type PriorityCmd struct {
+	ui      terminal.UI
+	config  plugin.PluginConfig
+	context plugin.PluginContext
+}
+
+// NewPriorityCmd is used to change the priority of a queued training job.
+func NewPriorityCmd(ui terminal.UI, context plugin.PluginContext) *PriorityCmd {
+	return &PriorityCmd{
+		ui:      ui,
+		context: context,
+	}
+}
+
+// Run is the handler for the training-priority CLI command.
+func (cmd *PriorityCmd) Run(cliContext *cli.Context) error {
+	cmd.config = cmd.context.PluginConfig()
+
+	args := cliContext.Args()
+
+	if len(args) == 0 {
+		cmd.ui.Failed("Argument MODEL_ID missing")
+	} else if len(args) == 1 {
+		cmd.ui.Failed("Argument PRIORITY missing")
+	} else {
+		modelID := args[0]
+		priority, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil {
+			cmd.ui.Failed("Argument PRIORITY must be an integer")
+		}
+		p := int32(priority)
+
+		cmd.ui.Say("Setting the priority of training job '%s' to %d...", terminal.EntityNameColor(modelID), p)
+		c, err := NewDlaaSClient()
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+		}
+
+		params := models.NewPatchModelParamsWithTimeout(defaultOpTimeout).
+			WithModelID(modelID).
+			WithPayload(&restmodels.TrainingUpdate{
+				Priority: &p,
+			})
+		_, err = c.Models.PatchModel(params, basicAuth)
+
+		if err != nil {
+			var s string
+			switch err.(type) {
+			case *models.PatchModelUnauthorized:
+				s = "Bad username or password."
+			case *models.PatchModelForbidden:
+				s = "Only priority administrators can set the priority of training jobs."
+			case *models.PatchModelNotFound:
+				s = "Model ID not found."
+			case *models.PatchModelBadRequest:
+				s = "Only the priority of queued training jobs can be set."
+			}
+			responseError(s, err, cmd.ui)
+		}
+		cmd.ui.Ok()
+	}
+	return nil
+}
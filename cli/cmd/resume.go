@@ -0,0 +1,95 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"io/ioutil"
+
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/bluemix/terminal"
+	"github.com/IBM-Bluemix/bluemix-cli-sdk/plugin"
+	"github.com/IBM/FfDL/restapi/api_v1/client/models"
+	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
+	"github.com/urfave/cli"
+)
+
+// ResumeCmd is the struct to resume a halted training job.
+type ResumeCmd struct {
+	ui      terminal.UI
+	config  plugin.PluginConfig
+	context plugin.PluginContext
+}
+
+// NewResumeCmd is used to resume a halted training job.
+func NewResumeCmd(ui terminal.UI, context plugin.PluginContext) *ResumeCmd {
+	return &ResumeCmd{
+		ui:      ui,
+		context: context,
+	}
+}
+
+// Run is the handler for the training-resume CLI command.
+func (cmd *ResumeCmd) Run(cliContext *cli.Context) error {
+	cmd.config = cmd.context.PluginConfig()
+
+	args := cliContext.Args()
+
+	if len(args) == 0 {
+		cmd.ui.Failed("Argument MODEL_ID missing")
+	} else if len(args) == 1 {
+		cmd.ui.Failed("Argument MANIFEST_FILE missing")
+	} else {
+		modelID := args[0]
+
+		// the credentials of the data stores were erased when the training was halted
+		manifest, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			cmd.ui.Failed("Error reading manifest file.")
+		}
+		if !IsValidManifest(manifest) {
+			cmd.ui.Failed("Bad manifest file.")
+		}
+
+		cmd.ui.Say("Resuming training job '%s'...", terminal.EntityNameColor(modelID))
+		c, err := NewDlaaSClient()
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+		}
+
+		params := models.NewPatchModelParamsWithTimeout(defaultOpTimeout).
+			WithModelID(modelID).
+			WithPayload(&restmodels.TrainingUpdate{
+				Status:   "resume",
+				Manifest: string(manifest),
+			})
+		_, err = c.Models.PatchModel(params, basicAuth)
+
+		if err != nil {
+			var s string
+			switch err.(type) {
+			case *models.PatchModelUnauthorized:
+				s = "Bad username or password."
+			case *models.PatchModelNotFound:
+				s = "Model ID not found."
+			case *models.PatchModelBadRequest:
+				s = "Only halted training jobs can be resumed, with the data stores of the manifest they were created with."
+			}
+			responseError(s, err, cmd.ui)
+		}
+		cmd.ui.Ok()
+	}
+	return nil
+}
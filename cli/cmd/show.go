@@ -94,6 +94,12 @@ func (cmd *ShowCmd) Run(cliContext *cli.Context) error {
 			cmd.ui.Say("  Name: %s", m.Name)
 			cmd.ui.Say("  Description: %s", m.Description)
 			cmd.ui.Say("  Framework: %s:%s", m.Framework.Name, m.Framework.Version)
+			if len(m.Labels) > 0 {
+				cmd.ui.Say("Labels:")
+				for k, v := range m.Labels {
+					cmd.ui.Say("  %s: %s", k, v)
+				}
+			}
 
 			cmd.ui.Say("Training:")
 			cmd.ui.Say("  Status: %s", terminal.EntityNameColor(m.Training.TrainingStatus.Status))
@@ -108,6 +114,9 @@ func (cmd *ShowCmd) Run(cliContext *cli.Context) error {
 			cmd.ui.Say("  Command: %s", strings.TrimSpace(m.Training.Command))
 			cmd.ui.Say("  Input data : %s", strings.Join(m.Training.InputData, ","))
 			cmd.ui.Say("  Output data: %s", strings.Join(m.Training.OutputData, ","))
+			if len(m.Training.DependsOn) > 0 {
+				cmd.ui.Say("  Depends on : %s", strings.Join(m.Training.DependsOn, ","))
+			}
 
 			cmd.ui.Say("Data stores:")
 			for _, ds := range m.DataStores {
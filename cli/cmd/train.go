@@ -17,6 +17,8 @@
 package cmd
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
@@ -49,16 +51,31 @@ func (cmd *TrainCmd) Run(cliContext *cli.Context) error {
 	cmd.config = cmd.context.PluginConfig()
 
 	args := cliContext.Args()
-	if len(args) != 2 {
+	if len(args) < 1 || len(args) > 2 {
 		cmd.ui.Failed("Incorrect number of arguments.")
 	}
 	params := models.NewPostModelParams().WithTimeout(defaultOpTimeout)
 
+	dryRun := cliContext.Bool("dry-run")
+	params.WithDryRun(&dryRun)
+
+	// lets the server recognize a retry of a request that timed out after the training was created
+	idempotencyKey := cliContext.String("idempotency-key")
+	if idempotencyKey == "" && !dryRun {
+		idempotencyKey = newIdempotencyKey()
+	}
+	if idempotencyKey != "" {
+		params.WithIdempotencyKey(&idempotencyKey)
+	}
+
 	params.WithManifest(openManifestFile(cmd.ui, args[0]))
 
 	_, manifestFile := filepath.Split(args[0])
 
-	if strings.Contains(args[1], ".zip") {
+	if len(args) == 1 {
+		// the manifest sets model_definition.location
+		cmd.ui.Say("Deploying model with manifest '%s'...", terminal.EntityNameColor(manifestFile))
+	} else if strings.Contains(args[1], ".zip") {
 		modelDefinitionFile := args[1]
 		cmd.ui.Say("Deploying model with manifest '%s' and model file '%s'...", terminal.EntityNameColor(manifestFile), terminal.EntityNameColor(modelDefinitionFile))
 		params.WithModelDefinition(openModelDefinitionFile(cmd.ui, modelDefinitionFile))
@@ -87,7 +104,7 @@ func (cmd *TrainCmd) Run(cliContext *cli.Context) error {
 		cmd.ui.Failed(err.Error())
 	}
 
-	response, err := c.Models.PostModel(params, BasicAuth())
+	rendered, response, err := c.Models.PostModel(params, BasicAuth())
 
 	if err != nil {
 		var s string
@@ -103,13 +120,37 @@ func (cmd *TrainCmd) Run(cliContext *cli.Context) error {
 				}
 			}
 		}
+		if s == "" && !dryRun && idempotencyKey != "" {
+			cmd.ui.Say("If the training was started anyway, run the command again with '--idempotency-key %s' to get its ID without starting another one.", idempotencyKey)
+		}
 		responseError(s, err, cmd.ui)
 		return nil
 	}
 
+	if rendered != nil {
+		// the objects the LCM would create, as a multi-document YAML stream
+		for i, obj := range rendered.Payload.Objects {
+			if i > 0 {
+				cmd.ui.Say("---")
+			}
+			cmd.ui.Say("%s", strings.TrimSuffix(obj.Manifest, "\n"))
+		}
+		cmd.ui.Ok()
+		return nil
+	}
+
 	id := LocationToID(response.Location)
 	cmd.ui.Say("Model ID: %s", terminal.EntityNameColor(id))
 	cmd.ui.Ok()
 
 	return nil
 }
+
+// newIdempotencyKey returns a random key for a train request
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -71,3 +71,26 @@ func TrainCmdCompletion(c *cli.Context) {
 
 	return
 }
+
+// ExperimentCreateCmdCompletion provide bash auto completion options
+func ExperimentCreateCmdCompletion(c *cli.Context) {
+	if c.NArg() < 2 {
+		TrainCmdCompletion(c)
+		return
+	}
+	if c.NArg() > 2 {
+		return
+	}
+	err := filepath.Walk(".", func(path string, f os.FileInfo, err error) error {
+		if err != nil || f.IsDir() {
+			return nil
+		}
+		if strings.HasSuffix(path, ".yml") || strings.HasSuffix(path, ".yaml") {
+			fmt.Println(path)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+}
@@ -127,6 +127,9 @@ func (c *DlaasPlugin) Run(context plugin.PluginContext, args []string) {
 		metadata.Show: func(c *cli.Context) error {
 			return cmd.NewShowCmd(ui, context).Run(c)
 		},
+		metadata.History: func(c *cli.Context) error {
+			return cmd.NewHistoryCmd(ui, context).Run(c)
+		},
 		metadata.Delete: func(c *cli.Context) error {
 			return cmd.NewDeleteCmd(ui, context).Run(c)
 		},
@@ -148,6 +151,21 @@ func (c *DlaasPlugin) Run(context plugin.PluginContext, args []string) {
 		metadata.Halt: func(c *cli.Context) error {
 			return cmd.NewHaltCmd(ui, context).Run(c)
 		},
+		metadata.Resume: func(c *cli.Context) error {
+			return cmd.NewResumeCmd(ui, context).Run(c)
+		},
+		metadata.Priority: func(c *cli.Context) error {
+			return cmd.NewPriorityCmd(ui, context).Run(c)
+		},
+		metadata.ExperimentCreate: func(c *cli.Context) error {
+			return cmd.NewExperimentCreateCmd(ui, context).Run(c)
+		},
+		metadata.ExperimentShow: func(c *cli.Context) error {
+			return cmd.NewExperimentShowCmd(ui, context).Run(c)
+		},
+		metadata.ExperimentList: func(c *cli.Context) error {
+			return cmd.NewExperimentListCmd(ui, context).Run(c)
+		},
 		metadata.Version: func(c *cli.Context) error {
 			return cmd.NewVersion(ui, context).Run(c)
 		},
@@ -157,12 +175,16 @@ func (c *DlaasPlugin) Run(context plugin.PluginContext, args []string) {
 		metadata.Delete:    	cmd.ModelIDCompletion,
 		metadata.Train:     	cmd.TrainCmdCompletion,
 		metadata.Show:       	cmd.ModelIDCompletion,
+		metadata.History:    	cmd.ModelIDCompletion,
 		metadata.ProjectInit: cmd.InitCmdCompletion,
 		metadata.Download: 		cmd.DownloadCmdCompletion,
 		metadata.Logs:    		cmd.TrainingLogsCompletion,
 		metadata.Loglines:    	cmd.LoglinesCompletion,
 		metadata.Emetrics:    	cmd.EMetricsCompletion,
 		metadata.Halt:    		cmd.ModelIDCompletion,
+		metadata.Resume:    	cmd.ModelIDCompletion,
+		metadata.Priority:    	cmd.ModelIDCompletion,
+		metadata.ExperimentCreate: cmd.ExperimentCreateCmdCompletion,
 	}
 
   cli.CommandHelpTemplate = commandHelp
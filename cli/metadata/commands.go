@@ -33,6 +33,9 @@ const (
 	// Show is the name of the CLI command to get a model's info.
 	Show = "show"
 
+	// History is the name of the CLI command to get the status history of a training.
+	History = "history"
+
 	// Delete is the name of the CLI command to delete a model.
 	Delete = "delete"
 
@@ -45,6 +48,12 @@ const (
 	// Halt is the name of the CLI command to get a training job's info.
 	Halt = "halt"
 
+	// Resume is the name of the CLI command to resume a halted training job.
+	Resume = "resume"
+
+	// Priority is the name of the CLI command to change the priority of a queued training job.
+	Priority = "priority"
+
 	// Logs is the name of the CLI command to get the training logs. (deprecated)
 	Logs = "logs"
 
@@ -54,6 +63,15 @@ const (
 	// Emetrics is the name of the CLI command to get the evaluation metrics.
 	Emetrics = "emetrics"
 
+	// ExperimentCreate is the name of the CLI command to start a hyperparameter sweep.
+	ExperimentCreate = "experiment-create"
+
+	// ExperimentShow is the name of the CLI command to get an experiment's trials.
+	ExperimentShow = "experiment-show"
+
+	// ExperimentList is the name of the CLI command to list all experiments.
+	ExperimentList = "experiment-list"
+
 	// Version is the version CLI command.
 	Version = "version"
 )
@@ -93,9 +111,28 @@ var (
 			Namespace:   deepLearningNS,
 			Name:        Train,
 			Description: "Trains a model",
-			Usage:       "bx dl train MANIFEST_FILE (MODEL_DEFINITION_ZIP|MODEL_DEFINITION_DIR)",
-			PluginFlags: []plugin.Flag{},
-			CliFlags:    []cli.Flag{},
+			Usage:       "bx dl train MANIFEST_FILE [MODEL_DEFINITION_ZIP|MODEL_DEFINITION_DIR] [--dry-run] [--idempotency-key KEY]",
+			PluginFlags: []plugin.Flag{
+				{
+					Name:        "dry-run",
+					Description: "Print the Kubernetes objects the training would create without starting it.",
+				},
+				{
+					Name:        "idempotency-key",
+					HasValue:    true,
+					Description: "Key of an earlier attempt of the same command, to retry it without starting a second training.",
+				},
+			},
+			CliFlags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "Print the Kubernetes objects the training would create without starting it.",
+				},
+				cli.StringFlag{
+					Name:  "idempotency-key",
+					Usage: "Key of an earlier attempt of the same command, to retry it without starting a second training.",
+				},
+			},
 		},
 		{
 			Namespace:   deepLearningNS,
@@ -118,19 +155,121 @@ var (
 		},
 		{
 			Namespace:   deepLearningNS,
-			Name:        Delete,
-			Description: "Delete a model",
-			Usage:       "bx dl delete MODEL_ID",
+			Name:        History,
+			Description: "Get the status transitions of a training with the time spent in each status",
+			Usage:       "bx dl history MODEL_ID",
 			PluginFlags: []plugin.Flag{},
 			CliFlags:    []cli.Flag{},
 		},
+		{
+			Namespace:   deepLearningNS,
+			Name:        Delete,
+			Description: "Delete a model, or all models whose labels match a selector",
+			Usage:       "bx dl delete (MODEL_ID | --selector SELECTOR)",
+			PluginFlags: []plugin.Flag{
+				{
+					Name:        "selector",
+					HasValue:    true,
+					Description: "Comma separated label requirements, such as team=vision,dataset!=v2",
+				},
+			},
+			CliFlags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "selector",
+					Usage: "Comma separated label requirements, such as team=vision,dataset!=v2.",
+				},
+			},
+		},
 		{
 			Namespace:   deepLearningNS,
 			Name:        List,
 			Description: "List all models",
-			Usage:       "bx dl list",
-			PluginFlags: []plugin.Flag{},
-			CliFlags:    []cli.Flag{},
+			Usage:       "bx dl list [--status STATUS] [--framework FRAMEWORK] [--name PREFIX] [--since TIME] [--until TIME] [--selector SELECTOR] [--sort ORDER] [--pagesize N] [--page TOKEN]",
+			PluginFlags: []plugin.Flag{
+				{
+					Name:        "status",
+					HasValue:    true,
+					Description: "Comma separated training statuses to list, such as COMPLETED,FAILED",
+				},
+				{
+					Name:        "framework",
+					HasValue:    true,
+					Description: "Only list models using this framework",
+				},
+				{
+					Name:        "name",
+					HasValue:    true,
+					Description: "Only list models whose name starts with this prefix",
+				},
+				{
+					Name:        "since",
+					HasValue:    true,
+					Description: "Only list models submitted at or after this time (RFC3339)",
+				},
+				{
+					Name:        "until",
+					HasValue:    true,
+					Description: "Only list models submitted before this time (RFC3339)",
+				},
+				{
+					Name:        "sort",
+					HasValue:    true,
+					Description: "Sort by submitted or name, prefixed with - for descending order (default -submitted)",
+				},
+				{
+					Name:        "selector",
+					HasValue:    true,
+					Description: "Comma separated label requirements, such as team=vision,dataset!=v2",
+				},
+				{
+					Name:        "pagesize",
+					HasValue:    true,
+					Description: "Number of models to list",
+				},
+				{
+					Name:        "page",
+					HasValue:    true,
+					Description: "Token of the page to list, printed by the previous list command",
+				},
+			},
+			CliFlags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "status",
+					Usage: "Comma separated training statuses to list, such as COMPLETED,FAILED.",
+				},
+				cli.StringFlag{
+					Name:  "framework",
+					Usage: "Only list models using this framework.",
+				},
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "Only list models whose name starts with this prefix.",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "Only list models submitted at or after this time (RFC3339).",
+				},
+				cli.StringFlag{
+					Name:  "until",
+					Usage: "Only list models submitted before this time (RFC3339).",
+				},
+				cli.StringFlag{
+					Name:  "sort",
+					Usage: "Sort by submitted or name, prefixed with - for descending order (default -submitted).",
+				},
+				cli.StringFlag{
+					Name:  "selector",
+					Usage: "Comma separated label requirements, such as team=vision,dataset!=v2.",
+				},
+				cli.IntFlag{
+					Name:  "pagesize",
+					Usage: "Number of models to list.",
+				},
+				cli.StringFlag{
+					Name:  "page",
+					Usage: "Token of the page to list, printed by the previous list command.",
+				},
+			},
 		},
 		{
 			Namespace:   deepLearningNS,
@@ -316,8 +455,70 @@ var (
 		{
 			Namespace:   deepLearningNS,
 			Name:        Halt,
-			Description: "Halt a training job",
-			Usage:       "bx dl halt MODEL_ID",
+			Description: "Halt a training job, or all training jobs whose labels match a selector",
+			Usage:       "bx dl halt (MODEL_ID | --selector SELECTOR)",
+			PluginFlags: []plugin.Flag{
+				{
+					Name:        "selector",
+					HasValue:    true,
+					Description: "Comma separated label requirements, such as team=vision,dataset!=v2",
+				},
+			},
+			CliFlags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "selector",
+					Usage: "Comma separated label requirements, such as team=vision,dataset!=v2.",
+				},
+			},
+		},
+		{
+			Namespace:   deepLearningNS,
+			Name:        Resume,
+			Description: "Resume a halted training job from its last checkpoint, with the credentials of the manifest it was created with",
+			Usage:       "bx dl resume MODEL_ID MANIFEST_FILE",
+			PluginFlags: []plugin.Flag{},
+			CliFlags:    []cli.Flag{},
+		},
+		{
+			Namespace:   deepLearningNS,
+			Name:        Priority,
+			Description: "Change the priority of a queued training job (priority administrators only)",
+			Usage:       "bx dl priority MODEL_ID PRIORITY",
+			PluginFlags: []plugin.Flag{},
+			CliFlags:    []cli.Flag{},
+		},
+		{
+			Namespace:   deepLearningNS,
+			Name:        ExperimentCreate,
+			Description: "Runs a training once for every set of parameter values of an experiment",
+			Usage:       "bx dl experiment-create MANIFEST_FILE (MODEL_DEFINITION_ZIP|MODEL_DEFINITION_DIR) EXPERIMENT_FILE",
+			PluginFlags: []plugin.Flag{},
+			CliFlags:    []cli.Flag{},
+		},
+		{
+			Namespace:   deepLearningNS,
+			Name:        ExperimentShow,
+			Description: "Get detailed information about an experiment and its trials",
+			Usage:       "bx dl experiment-show EXPERIMENT_ID",
+			PluginFlags: []plugin.Flag{
+				{
+					Name:        "json",
+					HasValue:    false,
+					Description: "If specified, output as json",
+				},
+			},
+			CliFlags: []cli.Flag{
+				cli.BoolTFlag{
+					Name:  "json",
+					Usage: "If specified, output as json.",
+				},
+			},
+		},
+		{
+			Namespace:   deepLearningNS,
+			Name:        ExperimentList,
+			Description: "List all experiments",
+			Usage:       "bx dl experiment-list",
 			PluginFlags: []plugin.Flag{},
 			CliFlags:    []cli.Flag{},
 		},
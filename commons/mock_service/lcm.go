@@ -88,3 +88,21 @@ func (mr *MockLifecycleManagerClientMockRecorder) KillTrainingJob(arg0, arg1 int
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillTrainingJob", reflect.TypeOf((*MockLifecycleManagerClient)(nil).KillTrainingJob), varargs...)
 }
+
+// RenderTrainingJob mocks base method
+func (m *MockLifecycleManagerClient) RenderTrainingJob(arg0 context.Context, arg1 *service.JobDeploymentRequest, arg2 ...grpc.CallOption) (*service.JobRenderResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenderTrainingJob", varargs...)
+	ret0, _ := ret[0].(*service.JobRenderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderTrainingJob indicates an expected call of RenderTrainingJob
+func (mr *MockLifecycleManagerClientMockRecorder) RenderTrainingJob(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderTrainingJob", reflect.TypeOf((*MockLifecycleManagerClient)(nil).RenderTrainingJob), varargs...)
+}
@@ -11,12 +11,16 @@ It has these top-level messages:
 	ResourceRequirements
 	User
 	JobDeploymentRequest
+	Toleration
+	SecretKeyRef
 	ImageLocation
 	JobDeploymentResponse
 	JobKillRequest
 	JobKillResponse
 	JobHaltRequest
 	JobHaltResponse
+	JobRenderResponse
+	RenderedObject
 */
 package service
 
@@ -111,6 +115,8 @@ type ResourceRequirements struct {
 	Storage      float64                         `protobuf:"fixed64,9,opt,name=storage" json:"storage,omitempty"`
 	StorageUnit  ResourceRequirements_MemoryUnit `protobuf:"varint,10,opt,name=storage_unit,json=storageUnit,enum=service.ResourceRequirements_MemoryUnit" json:"storage_unit,omitempty"`
 	GpuType      string                          `protobuf:"bytes,11,opt,name=gpu_type,json=gpuType" json:"gpu_type,omitempty"`
+	NodeSelector map[string]string               `protobuf:"bytes,12,rep,name=node_selector,json=nodeSelector" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tolerations  []*Toleration                   `protobuf:"bytes,13,rep,name=tolerations" json:"tolerations,omitempty"`
 }
 
 func (m *ResourceRequirements) Reset()                    { *m = ResourceRequirements{} }
@@ -195,6 +201,20 @@ func (m *ResourceRequirements) GetGpuType() string {
 	return ""
 }
 
+func (m *ResourceRequirements) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *ResourceRequirements) GetTolerations() []*Toleration {
+	if m != nil {
+		return m.Tolerations
+	}
+	return nil
+}
+
 type User struct {
 	Id        string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Roles     []string `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
@@ -244,17 +264,20 @@ func (m *User) GetEmail() string {
 }
 
 type JobDeploymentRequest struct {
-	Name                  string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Resources             *ResourceRequirements `protobuf:"bytes,4,opt,name=resources" json:"resources,omitempty"`
-	EnvVars               map[string]string     `protobuf:"bytes,5,rep,name=env_vars,json=envVars" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Labels                map[string]string     `protobuf:"bytes,6,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	UserId                string                `protobuf:"bytes,7,opt,name=user_id,json=userId" json:"user_id,omitempty"`
-	TrainingId            string                `protobuf:"bytes,8,opt,name=training_id,json=trainingId" json:"training_id,omitempty"`
-	Framework             string                `protobuf:"bytes,9,opt,name=framework" json:"framework,omitempty"`
-	Version               string                `protobuf:"bytes,10,opt,name=version" json:"version,omitempty"`
-	EvaluationMetricsSpec string                `protobuf:"bytes,11,opt,name=evaluation_metrics_spec,json=evaluationMetricsSpec" json:"evaluation_metrics_spec,omitempty"`
-	ImageTag              string                `protobuf:"bytes,12,opt,name=image_tag,json=imageTag" json:"image_tag,omitempty"`
-	ImageLocation         *ImageLocation        `protobuf:"bytes,13,opt,name=image_location,json=imageLocation" json:"image_location,omitempty"`
+	Name                  string                   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Resources             *ResourceRequirements    `protobuf:"bytes,4,opt,name=resources" json:"resources,omitempty"`
+	EnvVars               map[string]string        `protobuf:"bytes,5,rep,name=env_vars,json=envVars" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels                map[string]string        `protobuf:"bytes,6,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	UserId                string                   `protobuf:"bytes,7,opt,name=user_id,json=userId" json:"user_id,omitempty"`
+	TrainingId            string                   `protobuf:"bytes,8,opt,name=training_id,json=trainingId" json:"training_id,omitempty"`
+	Framework             string                   `protobuf:"bytes,9,opt,name=framework" json:"framework,omitempty"`
+	Version               string                   `protobuf:"bytes,10,opt,name=version" json:"version,omitempty"`
+	EvaluationMetricsSpec string                   `protobuf:"bytes,11,opt,name=evaluation_metrics_spec,json=evaluationMetricsSpec" json:"evaluation_metrics_spec,omitempty"`
+	ImageTag              string                   `protobuf:"bytes,12,opt,name=image_tag,json=imageTag" json:"image_tag,omitempty"`
+	ImageLocation         *ImageLocation           `protobuf:"bytes,13,opt,name=image_location,json=imageLocation" json:"image_location,omitempty"`
+	MaxDurationSeconds    int64                    `protobuf:"varint,14,opt,name=max_duration_seconds,json=maxDurationSeconds" json:"max_duration_seconds,omitempty"`
+	LearnerEnvVars        map[string]string        `protobuf:"bytes,15,rep,name=learner_env_vars,json=learnerEnvVars" json:"learner_env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	LearnerSecretEnvVars  map[string]*SecretKeyRef `protobuf:"bytes,16,rep,name=learner_secret_env_vars,json=learnerSecretEnvVars" json:"learner_secret_env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *JobDeploymentRequest) Reset()                    { *m = JobDeploymentRequest{} }
@@ -339,6 +362,93 @@ func (m *JobDeploymentRequest) GetImageLocation() *ImageLocation {
 	return nil
 }
 
+func (m *JobDeploymentRequest) GetMaxDurationSeconds() int64 {
+	if m != nil {
+		return m.MaxDurationSeconds
+	}
+	return 0
+}
+
+func (m *JobDeploymentRequest) GetLearnerEnvVars() map[string]string {
+	if m != nil {
+		return m.LearnerEnvVars
+	}
+	return nil
+}
+
+func (m *JobDeploymentRequest) GetLearnerSecretEnvVars() map[string]*SecretKeyRef {
+	if m != nil {
+		return m.LearnerSecretEnvVars
+	}
+	return nil
+}
+
+// Toleration lets the learners run on nodes with a matching taint
+type Toleration struct {
+	Key      string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator" json:"operator,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+	Effect   string `protobuf:"bytes,4,opt,name=effect" json:"effect,omitempty"`
+}
+
+func (m *Toleration) Reset()                    { *m = Toleration{} }
+func (m *Toleration) String() string            { return proto.CompactTextString(m) }
+func (*Toleration) ProtoMessage()               {}
+func (*Toleration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Toleration) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Toleration) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Toleration) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Toleration) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+// SecretKeyRef refers to a key of a secret in the learner namespace
+type SecretKeyRef struct {
+	Secret string `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *SecretKeyRef) Reset()                    { *m = SecretKeyRef{} }
+func (m *SecretKeyRef) String() string            { return proto.CompactTextString(m) }
+func (*SecretKeyRef) ProtoMessage()               {}
+func (*SecretKeyRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *SecretKeyRef) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *SecretKeyRef) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
 type ImageLocation struct {
 	Registry    string `protobuf:"bytes,1,opt,name=registry" json:"registry,omitempty"`
 	Namespace   string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
@@ -349,7 +459,7 @@ type ImageLocation struct {
 func (m *ImageLocation) Reset()                    { *m = ImageLocation{} }
 func (m *ImageLocation) String() string            { return proto.CompactTextString(m) }
 func (*ImageLocation) ProtoMessage()               {}
-func (*ImageLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*ImageLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *ImageLocation) GetRegistry() string {
 	if m != nil {
@@ -387,7 +497,7 @@ type JobDeploymentResponse struct {
 func (m *JobDeploymentResponse) Reset()                    { *m = JobDeploymentResponse{} }
 func (m *JobDeploymentResponse) String() string            { return proto.CompactTextString(m) }
 func (*JobDeploymentResponse) ProtoMessage()               {}
-func (*JobDeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*JobDeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *JobDeploymentResponse) GetName() string {
 	if m != nil {
@@ -412,7 +522,7 @@ type JobKillRequest struct {
 func (m *JobKillRequest) Reset()                    { *m = JobKillRequest{} }
 func (m *JobKillRequest) String() string            { return proto.CompactTextString(m) }
 func (*JobKillRequest) ProtoMessage()               {}
-func (*JobKillRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*JobKillRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *JobKillRequest) GetName() string {
 	if m != nil {
@@ -441,7 +551,7 @@ type JobKillResponse struct {
 func (m *JobKillResponse) Reset()                    { *m = JobKillResponse{} }
 func (m *JobKillResponse) String() string            { return proto.CompactTextString(m) }
 func (*JobKillResponse) ProtoMessage()               {}
-func (*JobKillResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*JobKillResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 type JobHaltRequest struct {
 	Name       string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -452,7 +562,7 @@ type JobHaltRequest struct {
 func (m *JobHaltRequest) Reset()                    { *m = JobHaltRequest{} }
 func (m *JobHaltRequest) String() string            { return proto.CompactTextString(m) }
 func (*JobHaltRequest) ProtoMessage()               {}
-func (*JobHaltRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*JobHaltRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *JobHaltRequest) GetName() string {
 	if m != nil {
@@ -481,18 +591,70 @@ type JobHaltResponse struct {
 func (m *JobHaltResponse) Reset()                    { *m = JobHaltResponse{} }
 func (m *JobHaltResponse) String() string            { return proto.CompactTextString(m) }
 func (*JobHaltResponse) ProtoMessage()               {}
-func (*JobHaltResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*JobHaltResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+type JobRenderResponse struct {
+	Objects []*RenderedObject `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+}
+
+func (m *JobRenderResponse) Reset()                    { *m = JobRenderResponse{} }
+func (m *JobRenderResponse) String() string            { return proto.CompactTextString(m) }
+func (*JobRenderResponse) ProtoMessage()               {}
+func (*JobRenderResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *JobRenderResponse) GetObjects() []*RenderedObject {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type RenderedObject struct {
+	Kind     string `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Manifest string `protobuf:"bytes,3,opt,name=manifest" json:"manifest,omitempty"`
+}
+
+func (m *RenderedObject) Reset()                    { *m = RenderedObject{} }
+func (m *RenderedObject) String() string            { return proto.CompactTextString(m) }
+func (*RenderedObject) ProtoMessage()               {}
+func (*RenderedObject) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *RenderedObject) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RenderedObject) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RenderedObject) GetManifest() string {
+	if m != nil {
+		return m.Manifest
+	}
+	return ""
+}
 
 func init() {
 	proto.RegisterType((*ResourceRequirements)(nil), "service.ResourceRequirements")
 	proto.RegisterType((*User)(nil), "service.User")
 	proto.RegisterType((*JobDeploymentRequest)(nil), "service.JobDeploymentRequest")
+	proto.RegisterType((*Toleration)(nil), "service.Toleration")
+	proto.RegisterType((*SecretKeyRef)(nil), "service.SecretKeyRef")
 	proto.RegisterType((*ImageLocation)(nil), "service.ImageLocation")
 	proto.RegisterType((*JobDeploymentResponse)(nil), "service.JobDeploymentResponse")
 	proto.RegisterType((*JobKillRequest)(nil), "service.JobKillRequest")
 	proto.RegisterType((*JobKillResponse)(nil), "service.JobKillResponse")
 	proto.RegisterType((*JobHaltRequest)(nil), "service.JobHaltRequest")
 	proto.RegisterType((*JobHaltResponse)(nil), "service.JobHaltResponse")
+	proto.RegisterType((*JobRenderResponse)(nil), "service.JobRenderResponse")
+	proto.RegisterType((*RenderedObject)(nil), "service.RenderedObject")
 	proto.RegisterEnum("service.StatusMessages", StatusMessages_name, StatusMessages_value)
 	proto.RegisterEnum("service.ResourceRequirements_MemoryUnit", ResourceRequirements_MemoryUnit_name, ResourceRequirements_MemoryUnit_value)
 }
@@ -511,6 +673,8 @@ type LifecycleManagerClient interface {
 	DeployTrainingJob(ctx context.Context, in *JobDeploymentRequest, opts ...grpc.CallOption) (*JobDeploymentResponse, error)
 	KillTrainingJob(ctx context.Context, in *JobKillRequest, opts ...grpc.CallOption) (*JobKillResponse, error)
 	HaltTrainingJob(ctx context.Context, in *JobHaltRequest, opts ...grpc.CallOption) (*JobHaltResponse, error)
+	// Returns the Kubernetes objects DeployTrainingJob would create, without deploying anything
+	RenderTrainingJob(ctx context.Context, in *JobDeploymentRequest, opts ...grpc.CallOption) (*JobRenderResponse, error)
 }
 
 type lifecycleManagerClient struct {
@@ -548,12 +712,23 @@ func (c *lifecycleManagerClient) HaltTrainingJob(ctx context.Context, in *JobHal
 	return out, nil
 }
 
+func (c *lifecycleManagerClient) RenderTrainingJob(ctx context.Context, in *JobDeploymentRequest, opts ...grpc.CallOption) (*JobRenderResponse, error) {
+	out := new(JobRenderResponse)
+	err := grpc.Invoke(ctx, "/service.LifecycleManager/RenderTrainingJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for LifecycleManager service
 
 type LifecycleManagerServer interface {
 	DeployTrainingJob(context.Context, *JobDeploymentRequest) (*JobDeploymentResponse, error)
 	KillTrainingJob(context.Context, *JobKillRequest) (*JobKillResponse, error)
 	HaltTrainingJob(context.Context, *JobHaltRequest) (*JobHaltResponse, error)
+	// Returns the Kubernetes objects DeployTrainingJob would create, without deploying anything
+	RenderTrainingJob(context.Context, *JobDeploymentRequest) (*JobRenderResponse, error)
 }
 
 func RegisterLifecycleManagerServer(s *grpc.Server, srv LifecycleManagerServer) {
@@ -614,6 +789,24 @@ func _LifecycleManager_HaltTrainingJob_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LifecycleManager_RenderTrainingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LifecycleManagerServer).RenderTrainingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.LifecycleManager/RenderTrainingJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LifecycleManagerServer).RenderTrainingJob(ctx, req.(*JobDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LifecycleManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service.LifecycleManager",
 	HandlerType: (*LifecycleManagerServer)(nil),
@@ -630,6 +823,10 @@ var _LifecycleManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HaltTrainingJob",
 			Handler:    _LifecycleManager_HaltTrainingJob_Handler,
 		},
+		{
+			MethodName: "RenderTrainingJob",
+			Handler:    _LifecycleManager_RenderTrainingJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lcm.proto",
@@ -638,63 +835,82 @@ var _LifecycleManager_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("lcm.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 919 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6f, 0xdb, 0xb6,
-	0x17, 0x8e, 0x6c, 0xc7, 0x8e, 0x9e, 0x13, 0x57, 0x25, 0xd2, 0x44, 0x3f, 0xff, 0xd6, 0x2d, 0xf3,
-	0xc9, 0xeb, 0x21, 0x87, 0x0c, 0x18, 0xb6, 0x0e, 0xc3, 0x10, 0x67, 0x4e, 0xe7, 0x34, 0x76, 0x06,
-	0x5a, 0xe9, 0x71, 0x02, 0x2d, 0xbf, 0xaa, 0x44, 0x24, 0x51, 0x23, 0x69, 0x0f, 0x06, 0x76, 0xda,
-	0x9f, 0xba, 0x3f, 0x60, 0xe7, 0x1d, 0x07, 0x52, 0xb2, 0xad, 0xb4, 0x69, 0x80, 0x1e, 0x76, 0xe3,
-	0xf7, 0x3d, 0xbf, 0x8f, 0xe4, 0xf7, 0x3e, 0xca, 0xe0, 0x26, 0x51, 0x7a, 0x9a, 0x4b, 0xa1, 0x05,
-	0x69, 0x29, 0x94, 0x4b, 0x1e, 0x61, 0xef, 0xaf, 0x3a, 0x1c, 0x52, 0x54, 0x62, 0x21, 0x23, 0xa4,
-	0xf8, 0xdb, 0x82, 0x4b, 0x4c, 0x31, 0xd3, 0x8a, 0x10, 0x68, 0x44, 0xf9, 0x42, 0xf9, 0xce, 0x89,
-	0xd3, 0x77, 0xa8, 0x5d, 0x1b, 0x2e, 0x36, 0x5c, 0xad, 0xe0, 0xcc, 0x9a, 0x1c, 0x41, 0x33, 0xc5,
-	0x54, 0xc8, 0x95, 0x5f, 0xb7, 0x6c, 0x89, 0xc8, 0x08, 0xda, 0xc5, 0x2a, 0x5c, 0x64, 0x5c, 0xfb,
-	0x8d, 0x13, 0xa7, 0xdf, 0x39, 0xeb, 0x9f, 0x96, 0xfb, 0x9e, 0x3e, 0xb4, 0xe7, 0xe9, 0xd8, 0x36,
-	0xdc, 0x66, 0x5c, 0x53, 0x48, 0x37, 0x6b, 0xd2, 0x85, 0xbd, 0x04, 0x99, 0xcc, 0x50, 0x2a, 0x7f,
-	0xf7, 0xc4, 0xe9, 0xef, 0xd2, 0x0d, 0x26, 0x27, 0xd0, 0x56, 0xd1, 0x3b, 0x9c, 0xe7, 0x22, 0xe1,
-	0xd1, 0xca, 0x6f, 0x9e, 0x38, 0x7d, 0x97, 0x56, 0x29, 0xd3, 0xad, 0x45, 0x2e, 0x12, 0x11, 0xaf,
-	0xfc, 0x96, 0x2d, 0x6f, 0x30, 0xe9, 0xc1, 0x3e, 0x93, 0xd1, 0x3b, 0xae, 0x31, 0xd2, 0x0b, 0x89,
-	0xfe, 0x9e, 0xad, 0xdf, 0xe3, 0x88, 0x0f, 0x2d, 0xa5, 0x85, 0x64, 0x31, 0xfa, 0xae, 0xbd, 0xe1,
-	0x1a, 0x92, 0xd7, 0xb0, 0x5f, 0x2e, 0x8b, 0x3b, 0xc2, 0x27, 0xde, 0xb1, 0x5d, 0x76, 0xdb, 0x4b,
-	0xfe, 0x0f, 0xf6, 0xe2, 0x7c, 0x11, 0xea, 0x55, 0x8e, 0x7e, 0xdb, 0x1e, 0xa3, 0x15, 0xe7, 0x8b,
-	0x60, 0x95, 0x63, 0xef, 0x47, 0x80, 0x6d, 0x17, 0x69, 0x42, 0x6d, 0x3c, 0xf0, 0x76, 0x48, 0x0b,
-	0xea, 0x63, 0x3e, 0xf0, 0x1c, 0x43, 0xbc, 0x1a, 0x78, 0x35, 0x43, 0xbc, 0xe2, 0x03, 0xaf, 0x6e,
-	0x88, 0x60, 0xe0, 0x35, 0x0c, 0x11, 0xf0, 0x81, 0xb7, 0xdb, 0xfb, 0x03, 0x1a, 0xb7, 0x0a, 0x25,
-	0xe9, 0x40, 0x8d, 0xcf, 0xed, 0x44, 0x5d, 0x5a, 0xe3, 0x73, 0x72, 0x08, 0xbb, 0x52, 0x24, 0x68,
-	0x06, 0x5a, 0xef, 0xbb, 0xb4, 0x00, 0xe4, 0x33, 0x70, 0xdf, 0x72, 0xa9, 0x74, 0xc6, 0x52, 0xb4,
-	0x43, 0x75, 0xe9, 0x96, 0xb0, 0xc3, 0x60, 0x65, 0xb1, 0x51, 0xd8, 0xb9, 0xc6, 0x46, 0x0f, 0x53,
-	0xc6, 0x13, 0x3b, 0x25, 0x97, 0x16, 0xa0, 0xf7, 0x77, 0x03, 0x0e, 0xaf, 0xc4, 0xec, 0x27, 0xcc,
-	0x13, 0xb1, 0x32, 0x26, 0x18, 0x3f, 0x50, 0x69, 0x13, 0x27, 0x2b, 0x53, 0x1c, 0xc8, 0xae, 0xc9,
-	0xf7, 0xe0, 0xca, 0xd2, 0x36, 0x65, 0xf5, 0xdb, 0x67, 0xcf, 0x1f, 0x35, 0x94, 0x6e, 0x7f, 0x4f,
-	0x86, 0xb0, 0x87, 0xd9, 0x32, 0x5c, 0x32, 0x1b, 0x94, 0x7a, 0xbf, 0x7d, 0xf6, 0x62, 0xd3, 0xfb,
-	0xd0, 0x09, 0x4e, 0x87, 0xd9, 0xf2, 0x0d, 0x93, 0x6a, 0x98, 0x69, 0xb9, 0xa2, 0x2d, 0x2c, 0x10,
-	0x39, 0x87, 0x66, 0xc2, 0x66, 0x98, 0x28, 0xbf, 0x69, 0x45, 0xbe, 0x7a, 0x5c, 0xe4, 0xda, 0xfe,
-	0xb6, 0xd0, 0x28, 0x1b, 0xc9, 0x31, 0xb4, 0x16, 0x0a, 0x65, 0xc8, 0xe7, 0x65, 0xe6, 0x9a, 0x06,
-	0x8e, 0xe6, 0xe4, 0x0b, 0x68, 0x6b, 0xc9, 0x78, 0xc6, 0xb3, 0xd8, 0x14, 0x8b, 0xc0, 0xc1, 0x9a,
-	0x1a, 0xcd, 0xad, 0xfb, 0x92, 0xa5, 0xf8, 0xbb, 0x90, 0x77, 0x36, 0x70, 0xc6, 0xfd, 0x35, 0x61,
-	0xc2, 0xb8, 0x44, 0xa9, 0xb8, 0xc8, 0x6c, 0xda, 0x5c, 0xba, 0x86, 0xe4, 0x1b, 0x38, 0xc6, 0x25,
-	0x4b, 0x16, 0x4c, 0x73, 0x91, 0x85, 0x29, 0x6a, 0xc9, 0x23, 0x15, 0xaa, 0x1c, 0xa3, 0x32, 0x4e,
-	0xcf, 0xb6, 0xe5, 0x71, 0x51, 0x9d, 0xe6, 0x18, 0x91, 0xff, 0x83, 0xcb, 0x53, 0x13, 0x61, 0xcd,
-	0x62, 0x7f, 0xbf, 0x18, 0xa8, 0x25, 0x02, 0x16, 0x93, 0x1f, 0xa0, 0x53, 0x14, 0x13, 0x11, 0xd9,
-	0x4e, 0xff, 0xc0, 0x8e, 0xe4, 0x68, 0xe3, 0xc8, 0xc8, 0x94, 0xaf, 0xcb, 0x2a, 0x3d, 0xe0, 0x55,
-	0xd8, 0x7d, 0x09, 0xfb, 0x55, 0x87, 0x89, 0x07, 0xf5, 0x3b, 0x5c, 0x95, 0xf3, 0x36, 0x4b, 0x93,
-	0x18, 0x73, 0x2a, 0xb4, 0x9f, 0x14, 0x97, 0x16, 0xe0, 0x65, 0xed, 0x5b, 0xa7, 0xfb, 0x1d, 0xb4,
-	0x2b, 0xc6, 0x7e, 0x4a, 0x6b, 0xef, 0x4f, 0x07, 0x0e, 0xee, 0x9d, 0xcb, 0x84, 0x56, 0x62, 0xcc,
-	0x95, 0x96, 0x6b, 0x89, 0x0d, 0x36, 0x86, 0x9b, 0xe4, 0xa9, 0x9c, 0x45, 0x6b, 0xad, 0x2d, 0x41,
-	0xbe, 0x84, 0x7d, 0x16, 0x45, 0xa8, 0x54, 0xa8, 0xc5, 0x1d, 0x66, 0xe5, 0x7b, 0x68, 0x17, 0x5c,
-	0x60, 0xa8, 0x6d, 0xea, 0x1b, 0xd5, 0xd4, 0x5f, 0xc0, 0xb3, 0xf7, 0xd2, 0xa2, 0x72, 0x91, 0x29,
-	0x7c, 0x30, 0xf5, 0x47, 0xd0, 0x54, 0x9a, 0xe9, 0xf2, 0xd3, 0xea, 0xd2, 0x12, 0xf5, 0x7e, 0x85,
-	0xce, 0x95, 0x98, 0xbd, 0xe6, 0x49, 0xf2, 0xd8, 0x9b, 0x79, 0x2f, 0x53, 0xb5, 0x0f, 0x32, 0x55,
-	0x49, 0x63, 0xbd, 0x9a, 0xc6, 0xde, 0x53, 0x78, 0xb2, 0xd1, 0x2f, 0x8e, 0x57, 0x6e, 0xf9, 0x33,
-	0x4b, 0xf4, 0x7f, 0xb9, 0x65, 0xa1, 0x5f, 0x6c, 0xf9, 0xe2, 0x0d, 0x74, 0xa6, 0xf6, 0xbe, 0x63,
-	0x54, 0x8a, 0xc5, 0xa8, 0xc8, 0x21, 0x78, 0x93, 0x1b, 0x3a, 0x3e, 0xbf, 0x0e, 0x6f, 0x7e, 0x19,
-	0xd2, 0xf3, 0x60, 0x74, 0x33, 0xf1, 0x76, 0x08, 0x81, 0xce, 0x68, 0x12, 0x0c, 0xe9, 0xe4, 0xfc,
-	0x3a, 0x1c, 0x52, 0x7a, 0x43, 0x3d, 0x20, 0x5d, 0x38, 0x1a, 0x4d, 0xa6, 0xb7, 0x97, 0x97, 0xa3,
-	0x8b, 0xd1, 0x70, 0x12, 0x84, 0x74, 0x38, 0xbd, 0xb9, 0xa5, 0x17, 0xc3, 0xa9, 0x77, 0x78, 0xf6,
-	0x8f, 0x03, 0xde, 0x35, 0x7f, 0x8b, 0xd1, 0x2a, 0x4a, 0x70, 0xcc, 0x32, 0x16, 0xa3, 0x24, 0x01,
-	0x3c, 0x2d, 0x86, 0x12, 0x94, 0x87, 0xbd, 0x12, 0x33, 0xf2, 0xfc, 0xd1, 0x17, 0xde, 0xfd, 0xfc,
-	0x63, 0xe5, 0xd2, 0xb3, 0x1d, 0x72, 0x09, 0x4f, 0x8c, 0x8b, 0x55, 0xcd, 0xe3, 0x6a, 0x53, 0x65,
-	0x84, 0x5d, 0xff, 0xc3, 0x42, 0x55, 0xc7, 0x58, 0xf3, 0x51, 0x9d, 0xca, 0x5c, 0xee, 0xeb, 0x54,
-	0x0d, 0xed, 0xed, 0xcc, 0x9a, 0xf6, 0x6f, 0xfe, 0xeb, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x54,
-	0x84, 0xb1, 0xe4, 0xf3, 0x07, 0x00, 0x00,
+	// 1225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5f, 0x73, 0xdb, 0x44,
+	0x10, 0x8f, 0xed, 0xc4, 0x7f, 0xd6, 0x8e, 0xab, 0x5c, 0xdd, 0x46, 0x35, 0x14, 0x8c, 0x9f, 0x4c,
+	0x99, 0x09, 0x34, 0x0c, 0x50, 0xca, 0x30, 0x9d, 0xa4, 0x75, 0x8a, 0xd3, 0xd8, 0xe9, 0x9c, 0x9d,
+	0xbe, 0x30, 0x53, 0x8d, 0x2c, 0x6f, 0x5c, 0x11, 0x49, 0x27, 0xee, 0xce, 0xa1, 0x9e, 0xe1, 0x89,
+	0x4f, 0xc0, 0x47, 0xe1, 0x73, 0xf0, 0xa9, 0x98, 0x3b, 0x9d, 0x65, 0xb9, 0x71, 0x02, 0x79, 0xe0,
+	0xed, 0x76, 0x7f, 0xb7, 0xbf, 0xdd, 0xdb, 0x7f, 0x12, 0x54, 0x02, 0x2f, 0xdc, 0x8b, 0x39, 0x93,
+	0x8c, 0x94, 0x04, 0xf2, 0x4b, 0xdf, 0xc3, 0xf6, 0x5f, 0x5b, 0xd0, 0xa0, 0x28, 0xd8, 0x8c, 0x7b,
+	0x48, 0xf1, 0xd7, 0x99, 0xcf, 0x31, 0xc4, 0x48, 0x0a, 0x42, 0x60, 0xd3, 0x8b, 0x67, 0xc2, 0xce,
+	0xb5, 0x72, 0x9d, 0x1c, 0xd5, 0x67, 0xa5, 0x9b, 0x2a, 0x5d, 0x3e, 0xd1, 0xa9, 0x33, 0xb9, 0x0f,
+	0xc5, 0x10, 0x43, 0xc6, 0xe7, 0x76, 0x41, 0x6b, 0x8d, 0x44, 0x7a, 0x50, 0x4d, 0x4e, 0xce, 0x2c,
+	0xf2, 0xa5, 0xbd, 0xd9, 0xca, 0x75, 0xea, 0xfb, 0x9d, 0x3d, 0xe3, 0x77, 0x6f, 0x9d, 0xcf, 0xbd,
+	0xbe, 0x36, 0x38, 0x8b, 0x7c, 0x49, 0x21, 0x4c, 0xcf, 0xa4, 0x09, 0xe5, 0x00, 0x5d, 0x1e, 0x21,
+	0x17, 0xf6, 0x56, 0x2b, 0xd7, 0xd9, 0xa2, 0xa9, 0x4c, 0x5a, 0x50, 0x15, 0xde, 0x3b, 0x9c, 0xc4,
+	0x2c, 0xf0, 0xbd, 0xb9, 0x5d, 0x6c, 0xe5, 0x3a, 0x15, 0x9a, 0x55, 0x29, 0x6b, 0xc9, 0x62, 0x16,
+	0xb0, 0xe9, 0xdc, 0x2e, 0x69, 0x38, 0x95, 0x49, 0x1b, 0x6a, 0x2e, 0xf7, 0xde, 0xf9, 0x12, 0x3d,
+	0x39, 0xe3, 0x68, 0x97, 0x35, 0xbe, 0xa2, 0x23, 0x36, 0x94, 0x84, 0x64, 0xdc, 0x9d, 0xa2, 0x5d,
+	0xd1, 0x2f, 0x5c, 0x88, 0xe4, 0x15, 0xd4, 0xcc, 0x31, 0x79, 0x23, 0xdc, 0xf2, 0x8d, 0x55, 0x63,
+	0xad, 0x1f, 0xf9, 0x00, 0xca, 0xd3, 0x78, 0xe6, 0xc8, 0x79, 0x8c, 0x76, 0x55, 0x87, 0x51, 0x9a,
+	0xc6, 0xb3, 0xd1, 0x3c, 0x46, 0x32, 0x82, 0xed, 0x88, 0x4d, 0xd0, 0x11, 0x18, 0xa0, 0x27, 0x19,
+	0xb7, 0x6b, 0xad, 0x42, 0xa7, 0xba, 0xff, 0xe5, 0xcd, 0x8e, 0x06, 0x6c, 0x82, 0x43, 0x63, 0xd1,
+	0x8d, 0x24, 0x9f, 0xd3, 0x5a, 0x94, 0x51, 0x91, 0x6f, 0xa0, 0x2a, 0x59, 0x80, 0xdc, 0x95, 0x3e,
+	0x8b, 0x84, 0xbd, 0xad, 0x39, 0xef, 0xa6, 0x9c, 0xa3, 0x14, 0xa3, 0xd9, 0x7b, 0xcd, 0x67, 0xb0,
+	0x73, 0x85, 0x99, 0x58, 0x50, 0xb8, 0xc0, 0xb9, 0xee, 0x95, 0x0a, 0x55, 0x47, 0xd2, 0x80, 0xad,
+	0x4b, 0x37, 0x98, 0xa1, 0xee, 0x95, 0x0a, 0x4d, 0x84, 0xa7, 0xf9, 0x27, 0xb9, 0xf6, 0x33, 0x80,
+	0x65, 0x0e, 0x48, 0x11, 0xf2, 0xfd, 0x43, 0x6b, 0x83, 0x94, 0xa0, 0xd0, 0xf7, 0x0f, 0xad, 0x9c,
+	0x52, 0xbc, 0x3c, 0xb4, 0xf2, 0x4a, 0xf1, 0xd2, 0x3f, 0xb4, 0x0a, 0x4a, 0x31, 0x3a, 0xb4, 0x36,
+	0x95, 0x62, 0xe4, 0x1f, 0x5a, 0x5b, 0xed, 0xdf, 0x61, 0xf3, 0x4c, 0x20, 0x27, 0x75, 0xc8, 0xfb,
+	0x13, 0xe3, 0x33, 0xef, 0x4f, 0x94, 0x4b, 0xce, 0x02, 0x54, 0xed, 0x59, 0x50, 0x2e, 0xb5, 0x40,
+	0x3e, 0x86, 0xca, 0xb9, 0xcf, 0x85, 0x8c, 0xdc, 0x10, 0x75, 0x8b, 0x56, 0xe8, 0x52, 0xa1, 0x5b,
+	0xcb, 0x35, 0xe0, 0x66, 0xd2, 0x1c, 0x0b, 0x59, 0xf1, 0x61, 0xe8, 0xfa, 0x81, 0xee, 0xb9, 0x0a,
+	0x4d, 0x84, 0xf6, 0x9f, 0x65, 0x68, 0x1c, 0xb3, 0xf1, 0x0b, 0x8c, 0x03, 0x36, 0x57, 0x99, 0x56,
+	0x49, 0x47, 0x21, 0xd5, 0x70, 0x68, 0x9a, 0x24, 0x20, 0x7d, 0x26, 0x3f, 0x40, 0x85, 0x9b, 0xda,
+	0x08, 0xcd, 0x5f, 0xdd, 0x7f, 0x78, 0x63, 0xd5, 0xe8, 0xf2, 0x3e, 0xe9, 0x42, 0x19, 0xa3, 0x4b,
+	0xe7, 0xd2, 0xd5, 0x6d, 0xaf, 0xaa, 0xf3, 0x28, 0xb5, 0x5d, 0x17, 0xc1, 0x5e, 0x37, 0xba, 0x7c,
+	0xe3, 0x72, 0x91, 0x14, 0xbb, 0x84, 0x89, 0x44, 0x0e, 0xa0, 0x18, 0xb8, 0x63, 0x0c, 0x84, 0x5d,
+	0xd4, 0x24, 0x9f, 0xdf, 0x4c, 0x72, 0xa2, 0xef, 0x26, 0x1c, 0xc6, 0x90, 0xec, 0x42, 0x69, 0x26,
+	0x90, 0x3b, 0xfe, 0xc4, 0x4c, 0x50, 0x51, 0x89, 0xbd, 0x09, 0xf9, 0x14, 0xaa, 0x92, 0xbb, 0x7e,
+	0xe4, 0x47, 0x53, 0x05, 0x26, 0xe3, 0x03, 0x0b, 0x55, 0x6f, 0xa2, 0xb3, 0xcf, 0xdd, 0x10, 0x7f,
+	0x63, 0xfc, 0xc2, 0xae, 0x98, 0xec, 0x2f, 0x14, 0x6a, 0xb4, 0x2e, 0x91, 0x0b, 0x9f, 0x45, 0x7a,
+	0x76, 0x2a, 0x74, 0x21, 0x92, 0x6f, 0x61, 0x17, 0x55, 0xcb, 0xe8, 0xa6, 0x73, 0x42, 0x94, 0xdc,
+	0xf7, 0x84, 0x23, 0x62, 0xf4, 0xcc, 0x70, 0xdc, 0x5b, 0xc2, 0xfd, 0x04, 0x1d, 0xc6, 0xe8, 0x91,
+	0x8f, 0xa0, 0xe2, 0x87, 0x6a, 0x20, 0xa5, 0x3b, 0xb5, 0x6b, 0x49, 0x41, 0xb5, 0x62, 0xe4, 0x4e,
+	0xc9, 0x8f, 0x50, 0x4f, 0xc0, 0x80, 0x79, 0xda, 0xd2, 0xde, 0xd6, 0x25, 0xb9, 0x9f, 0x66, 0xa4,
+	0xa7, 0xe0, 0x13, 0x83, 0xd2, 0x6d, 0x3f, 0x2b, 0x92, 0xaf, 0xa0, 0x11, 0xba, 0xef, 0x9d, 0xc9,
+	0x2c, 0x19, 0x05, 0x47, 0xa0, 0xc7, 0xa2, 0x89, 0xb0, 0xeb, 0xad, 0x5c, 0xa7, 0x40, 0x49, 0xe8,
+	0xbe, 0x7f, 0x61, 0xa0, 0x61, 0x82, 0x90, 0x9f, 0xc1, 0x32, 0x8b, 0xca, 0x49, 0x2b, 0x79, 0x47,
+	0x17, 0xe1, 0xf1, 0xbf, 0x14, 0x21, 0xb1, 0x5a, 0x29, 0x68, 0x3d, 0x58, 0x51, 0x92, 0x08, 0x76,
+	0x17, 0xe4, 0x02, 0x3d, 0x8e, 0x72, 0xe9, 0xc3, 0xd2, 0x3e, 0xbe, 0xfb, 0x4f, 0x3e, 0x86, 0xda,
+	0x76, 0xc5, 0x53, 0x23, 0x58, 0x03, 0x35, 0x9f, 0x42, 0x2d, 0x7b, 0xeb, 0x36, 0x33, 0xdf, 0xfc,
+	0x1e, 0xaa, 0x99, 0xbe, 0xba, 0x95, 0xe9, 0x01, 0xdc, 0x5d, 0x93, 0x8d, 0x5b, 0x51, 0xbc, 0x85,
+	0x07, 0xd7, 0x3e, 0x76, 0x0d, 0xd1, 0x17, 0x59, 0xa2, 0xea, 0xfe, 0xbd, 0x34, 0x8d, 0x89, 0xf5,
+	0x2b, 0x9c, 0x53, 0x3c, 0xcf, 0x6e, 0xb4, 0x77, 0x00, 0xcb, 0x6d, 0xb9, 0x86, 0xb0, 0x09, 0x65,
+	0x16, 0x2b, 0x98, 0x71, 0x13, 0x5c, 0x2a, 0x2f, 0xa3, 0x2e, 0x64, 0xa2, 0x56, 0x1f, 0x55, 0x3c,
+	0x3f, 0x47, 0x4f, 0x9a, 0xa5, 0x64, 0xa4, 0xf6, 0x13, 0xa8, 0x65, 0x83, 0x50, 0xf7, 0x92, 0xda,
+	0x1b, 0x77, 0x46, 0x5a, 0xc4, 0x90, 0x4f, 0x63, 0x68, 0xff, 0x91, 0x83, 0xed, 0x95, 0xee, 0x56,
+	0x51, 0x71, 0x9c, 0xfa, 0x42, 0xf2, 0x45, 0xb0, 0xa9, 0xac, 0xc6, 0x56, 0xed, 0x2f, 0x11, 0xbb,
+	0xde, 0x22, 0x9f, 0x4b, 0x05, 0xf9, 0x0c, 0x6a, 0xae, 0xe7, 0xa1, 0x10, 0x8e, 0x64, 0x17, 0x18,
+	0x99, 0xd0, 0xab, 0x89, 0x6e, 0xa4, 0x54, 0xcb, 0xdd, 0xb9, 0x99, 0xdd, 0x9d, 0xcf, 0xe1, 0xde,
+	0x07, 0xad, 0x28, 0x62, 0x16, 0x09, 0x5c, 0xbb, 0x3b, 0xd5, 0xdb, 0xa4, 0x2b, 0xcd, 0xef, 0x46,
+	0x85, 0x1a, 0xa9, 0xfd, 0x16, 0xea, 0xc7, 0x6c, 0xfc, 0xca, 0x0f, 0x82, 0x9b, 0x36, 0xef, 0x07,
+	0x9b, 0x29, 0x7f, 0x65, 0x33, 0x65, 0x76, 0x5a, 0x21, 0xbb, 0xd3, 0xda, 0x3b, 0x70, 0x27, 0xe5,
+	0x4f, 0xc2, 0x33, 0x2e, 0x7f, 0x72, 0x03, 0xf9, 0x7f, 0xba, 0x4c, 0xf8, 0x8d, 0xcb, 0x23, 0xd8,
+	0x39, 0x66, 0x63, 0x8a, 0xd1, 0x04, 0x79, 0x9a, 0xa6, 0xc7, 0x50, 0x62, 0xe3, 0x5f, 0xd0, 0x93,
+	0xea, 0xb7, 0x4c, 0x8d, 0xf8, 0x6e, 0xe6, 0x63, 0xa2, 0x6e, 0xe2, 0xe4, 0x54, 0xe3, 0x74, 0x71,
+	0xaf, 0x3d, 0x82, 0xfa, 0x2a, 0xa4, 0x42, 0xbf, 0xf0, 0xa3, 0xc5, 0x87, 0x53, 0x9f, 0xd3, 0xe7,
+	0xe4, 0x33, 0xcf, 0x69, 0x42, 0x39, 0x74, 0x23, 0xff, 0x1c, 0x85, 0x34, 0xe1, 0xa6, 0xf2, 0xa3,
+	0x37, 0x50, 0x1f, 0xea, 0x6a, 0xf4, 0x51, 0x08, 0x77, 0x8a, 0x82, 0x34, 0xc0, 0x1a, 0x9c, 0xd2,
+	0xfe, 0xc1, 0x89, 0x73, 0xfa, 0xba, 0x4b, 0x0f, 0x46, 0xbd, 0xd3, 0x81, 0xb5, 0x41, 0x08, 0xd4,
+	0x7b, 0x83, 0x51, 0x97, 0x0e, 0x0e, 0x4e, 0x9c, 0x2e, 0xa5, 0xa7, 0xd4, 0x02, 0xd2, 0x84, 0xfb,
+	0xbd, 0xc1, 0xf0, 0xec, 0xe8, 0xa8, 0xf7, 0xbc, 0xd7, 0x1d, 0x8c, 0x1c, 0xda, 0x1d, 0x9e, 0x9e,
+	0xd1, 0xe7, 0xdd, 0xa1, 0xd5, 0xd8, 0xff, 0x3b, 0x0f, 0xd6, 0x89, 0x7f, 0x8e, 0xde, 0xdc, 0x0b,
+	0xb0, 0xef, 0x46, 0xee, 0x14, 0x39, 0x19, 0xc1, 0x4e, 0xd2, 0x32, 0x23, 0x93, 0xca, 0x63, 0x36,
+	0x26, 0x0f, 0x6f, 0x5c, 0x6e, 0xcd, 0x4f, 0xae, 0x83, 0x4d, 0x7a, 0x37, 0xc8, 0x11, 0xdc, 0x51,
+	0x35, 0xce, 0x72, 0xee, 0x66, 0x8d, 0x32, 0x0d, 0xd6, 0xb4, 0xaf, 0x02, 0x59, 0x1e, 0x55, 0xb8,
+	0x6b, 0x79, 0x32, 0x5d, 0xd3, 0xb4, 0xaf, 0x02, 0x29, 0xcf, 0x6b, 0xd8, 0x49, 0x0a, 0x75, 0x8b,
+	0x57, 0x36, 0xb3, 0xf0, 0x6a, 0xaf, 0xb4, 0x37, 0xc6, 0x45, 0xfd, 0xab, 0xff, 0xf5, 0x3f, 0x03,
+	0x00, 0xb9, 0x9a, 0xc8, 0xe7, 0xf7, 0x0b, 0x00, 0x00,
 }
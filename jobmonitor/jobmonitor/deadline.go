@@ -0,0 +1,141 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jobmonitor
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/cenkalti/backoff"
+)
+
+const (
+	zkHalt          = "halt"
+	zkDeployedSince = "deployed_since"
+
+	// time the learners get to store the results and logs after they were asked to halt
+	maxDurationGracePeriod = 10 * time.Minute
+)
+
+func haltPath(trainingID string) string {
+	return trainingID + "/" + zkHalt
+}
+
+func deployedSincePath(trainingID string) string {
+	return trainingID + "/" + zkDeployedSince
+}
+
+// deadline returns the time the job has to finish by, given the value stored at deployedSincePath()
+func deadline(deployedSince string, maxDuration time.Duration) (time.Time, error) {
+	millis, err := strconv.ParseInt(deployedSince, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, millis*int64(time.Millisecond)).Add(maxDuration), nil
+}
+
+// markMaxDurationExceeded sets the error code and message of the HALTED status of a job that ran out of time
+func markMaxDurationExceeded(statusUpdate *client.TrainingStatusUpdate, maxDuration time.Duration) {
+	statusUpdate.ErrorCode = client.ErrMaxDurationExceeded
+	statusUpdate.StatusMessage = fmt.Sprintf("Training exceeded its maximum duration of %s", maxDuration)
+}
+
+// deployedSince returns when the job was deployed. The time is kept in etcd, so that a restarted job monitor
+// enforces the same deadline.
+func (jm *JobMonitor) deployedSince(logr *logger.LocLoggingEntry) time.Time {
+	now := time.Now()
+	path := deployedSincePath(jm.TrainingID)
+	var since time.Time
+	err := backoff.RetryNotify(func() error {
+		_, err := jm.EtcdClient.PutIfKeyMissing(path, client.CurrentTimestampAsString(), logr)
+		if err != nil {
+			return err
+		}
+		response, err := jm.EtcdClient.Get(path, logr)
+		if err != nil {
+			return err
+		}
+		if len(response) == 0 {
+			return fmt.Errorf("the value at %s was empty", path)
+		}
+		since, err = deadline(response[0].Value, 0)
+		return err
+	}, etdInteractionBackoff(1*time.Minute, 10*time.Second), func(err error, t time.Duration) { jm.metrics.failedETCDConnectivityCounter.Add(1) })
+
+	if err != nil {
+		logr.WithError(err).Warnf("Could not read the deployment time of %s, starting the clock now", jm.TrainingID)
+		return now
+	}
+	return since
+}
+
+// isJobFinished returns true if the overall status of the job is COMPLETED, FAILED or HALTED
+func (jm *JobMonitor) isJobFinished(logr *logger.LocLoggingEntry) bool {
+	response, err := jm.EtcdClient.Get(overallJobStatusPath(jm.TrainingID), logr)
+	if err != nil || len(response) == 0 {
+		return false
+	}
+	status := client.GetStatus(response[0].Value, logr).Status
+	return status == grpc_trainer_v2.Status_COMPLETED || status == grpc_trainer_v2.Status_FAILED || status == grpc_trainer_v2.Status_HALTED
+}
+
+// enforceMaxDuration halts the job once it has been deployed for longer than MaxDuration. The learners store the
+// results and logs as they do when the user halts the job. If they do not report HALTED in time, the job monitor
+// reports it for them and tears the job down.
+func (jm *JobMonitor) enforceMaxDuration(logr *logger.LocLoggingEntry) {
+	end := jm.deployedSince(logr).Add(jm.MaxDuration)
+	logr.Infof("Training %s has to finish by %s", jm.TrainingID, end.UTC().Format(time.RFC3339))
+	time.Sleep(end.Sub(time.Now()))
+
+	if jm.isJobFinished(logr) {
+		return
+	}
+	logr.Warnf("Training %s exceeded its maximum duration of %s, halting it", jm.TrainingID, jm.MaxDuration)
+	atomic.StoreInt32(&jm.maxDurationExceeded, 1)
+	jm.metrics.maxDurationExceededCounter.Add(1)
+
+	err := backoff.RetryNotify(func() error {
+		_, err := jm.EtcdClient.PutIfKeyMissing(haltPath(jm.TrainingID), "", logr)
+		return err
+	}, etdInteractionBackoff(1*time.Minute, 10*time.Second), func(err error, t time.Duration) { jm.metrics.failedETCDConnectivityCounter.Add(1) })
+	if err != nil {
+		logr.WithError(err).Errorf("Failed to ask the learners of %s to halt", jm.TrainingID)
+	}
+
+	time.Sleep(maxDurationGracePeriod)
+	if jm.isJobFinished(logr) {
+		return
+	}
+
+	logr.Warnf("Learners of %s did not halt within %s, killing the job", jm.TrainingID, maxDurationGracePeriod)
+	statusUpdate := &client.TrainingStatusUpdate{
+		Status:    grpc_trainer_v2.Status_HALTED,
+		Timestamp: client.CurrentTimestampAsString(),
+	}
+	markMaxDurationExceeded(statusUpdate, jm.MaxDuration)
+	if err := updateJobStatusInTrainer(jm.TrainingID, jm.UserID, statusUpdate, logr); err != nil {
+		logr.WithError(err).Errorf("Failed to write the status %s for training %s to trainer", grpc_trainer_v2.Status_HALTED, jm.TrainingID)
+	}
+	if err := KillDeployedJob(jm.TrainingID, jm.UserID, jm.JobName, logr); err != nil {
+		logr.WithError(err).Errorf("Failed to kill the deployed job %s", jm.TrainingID)
+	}
+}
@@ -61,7 +61,7 @@ const (
 
 type jobMonitorMetrics struct {
 	failedETCDConnectivityCounter, failedK8sConnectivityCounter, insufficientK8sResourcesErrorCounter, failedImagePullK8sErrorCounter,
-	failedETCDWatchCounter metrics.Counter
+	failedETCDWatchCounter, maxDurationExceededCounter metrics.Counter
 }
 
 //JobMonitor ...
@@ -76,6 +76,9 @@ type JobMonitor struct {
 	numTerminalLearners   uint64
 	metrics               *jobMonitorMetrics
 	EtcdClient            coord.Coordinator
+	// MaxDuration is the time the job may run after it was deployed, 0 if it may run forever
+	MaxDuration         time.Duration
+	maxDurationExceeded int32
 }
 
 var failedTrainerConnectivityCounter metrics.Counter
@@ -100,6 +103,7 @@ func NewJobMonitor(trainingID string, userID string, numLearners int, jobName st
 		insufficientK8sResourcesErrorCounter: statsdClient.NewCounter("jobmonitor.k8s.insufficientResources.failed", 1),
 		failedImagePullK8sErrorCounter:       statsdClient.NewCounter("jobmonitor.k8s.imagePull.failed", 1),
 		failedETCDWatchCounter:               statsdClient.NewCounter("jobmonitor.etcd.watch.failed", 1),
+		maxDurationExceededCounter:           statsdClient.NewCounter("jobmonitor.maxduration.exceeded", 1),
 	}
 
 	k8sClient, err := kubernetes.NewForConfig(lcmconfig.GetKubernetesConfig())
@@ -184,6 +188,9 @@ func updateJobStatusOnError(trainingID string, userID string, errorCode string,
 func (jm *JobMonitor) ManageDistributedJob(logr *logger.LocLoggingEntry) {
 	go jm.checkIfJobStarted(logr)
 	go jm.monitorJob(logr)
+	if jm.MaxDuration > 0 {
+		go jm.enforceMaxDuration(logr)
+	}
 }
 
 //monitors the job at the path jobBasePath() generall /training_id/ under which there is /training_id/status/ indicating over all job status
@@ -240,6 +247,9 @@ func (jm *JobMonitor) processUpdateJobStatus(currStatus string, logr *logger.Loc
 	//Variable to notify whether the job needs further status monitoring
 	markComplete := false
 	statusUpdate := client.GetStatus(currStatus, logr)
+	if statusUpdate.Status == grpc_trainer_v2.Status_HALTED && atomic.LoadInt32(&jm.maxDurationExceeded) == 1 {
+		markMaxDurationExceeded(statusUpdate, jm.MaxDuration)
+	}
 
 	status := statusUpdate.Status
 	error := updateJobStatusInTrainer(jm.TrainingID, jm.UserID, statusUpdate, logr)
@@ -18,9 +18,12 @@ package jobmonitor
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
 )
 
 func init() {
@@ -56,3 +59,16 @@ func TestTransitions(t *testing.T) {
 	assert.EqualValues(t, false, jm.isTransitionAllowed("FAILED", "COMPLETED"))
 
 }
+
+func TestMaxDurationExceeded(t *testing.T) {
+	end, err := deadline("1500000000000", 2*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1500000000, 0).Add(2*time.Hour), end)
+	_, err = deadline("", time.Hour)
+	assert.Error(t, err)
+
+	statusUpdate := &client.TrainingStatusUpdate{Status: grpc_trainer_v2.Status_HALTED}
+	markMaxDurationExceeded(statusUpdate, 2*time.Hour)
+	assert.Equal(t, client.ErrMaxDurationExceeded, statusUpdate.ErrorCode)
+	assert.Equal(t, "Training exceeded its maximum duration of 2h0m0s", statusUpdate.StatusMessage)
+}
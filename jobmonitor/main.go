@@ -42,6 +42,7 @@ func main() {
 	trainingID := os.Getenv("TRAINING_ID")
 	userID := os.Getenv("USER_ID")
 	jobName := os.Getenv("JOB_NAME")
+	maxDurationSeconds, _ := strconv.ParseInt(os.Getenv("MAX_DURATION"), 10, 64)
 
 	logr := logger.LocLogger(jobM.InitLogger(trainingID, userID))
 	jm, err := jobM.NewJobMonitor(trainingID, userID, numLearners, jobName, useNativeDistribution, statsdClient, logr)
@@ -50,6 +51,7 @@ func main() {
 		logr.WithError(err).Errorf("failed to bring up job monitor for training %s, already must have signaled to kill the jm", trainingID)
 	} else {
 		logr.Infof("Job Monitor instantiated and ready to go. Starting to manage %s", jm.TrainingID)
+		jm.MaxDuration = time.Duration(maxDurationSeconds) * time.Second
 
 		go jm.ManageDistributedJob(logr)
 
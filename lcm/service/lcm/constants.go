@@ -103,6 +103,7 @@ const (
 	jmLaunchFailed                  = "jm_launch_failed"
 	psLaunchFailed                  = "ps_launch_failed"
 	learnerLaunchFailed             = "learner_launch_failed"
+	invalidSecretReference          = "invalid_secret_reference"
 	killed                          = "job_killed"
 	servicesDeletedPhaseComplete    = "servicesDeletedPhaseComplete"
 	deploymentsDeletedPhaseComplete = "deploymentsDeletedPhaseComplete"
@@ -110,4 +111,9 @@ const (
 	pvsDeletedPhaseComplete         = "pvsDeletedPhaseComplete"
 	secretsDeletedPhaseComplete     = "secretsDeletedPhaseComplete"
 	etcdKeysDeletedPhaseComplete    = "etcdKeysDeletedPhaseComplete"
+	action                          = "action"
+	orphanDeleted                   = "orphan_deleted"
+	stuckFailed                     = "stuck_failed"
+	stuckRedeployed                 = "stuck_redeployed"
+	statusUnknown                   = "status_unknown"
 )
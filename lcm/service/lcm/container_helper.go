@@ -316,7 +316,7 @@ func constructLoadTrainingDataContainer(sharedVolumeMount v1core.VolumeMount, jo
 	memInBytes := int64(config.GetTrainingDataMemInMB() * 1024 * 1024)
 	memCount := v1resource.NewQuantity(memInBytes, v1resource.DecimalSI)
 
-	command := fmt.Sprintf(`load.sh |tee -a %s/load-data.log`, PodLevelLogDir)
+	command := loadTrainingDataCommand("load.sh", sharedVolumeMount.MountPath, jobEnvVars)
 	cmd := wrapCommand(command, loadDataContainerName, sharedVolumeMount.MountPath, false)
 	container := v1core.Container{
 		Name:    loadDataContainerName,
@@ -421,6 +421,11 @@ func constructLearnerContainer(req *service.JobDeploymentRequest, envVars []v1co
 			cmd_exit=$? ;
 			echo "$(date): Training exit with exit code ${cmd_exit}." >> $JOB_STATE_DIR/latest-log`
 	}
+	// the rank of a learner in the process group of torch.distributed depends on its pod
+	rankCommand := ""
+	if req.Framework == pytorchFrameworkName {
+		rankCommand = learner.PyTorchRankCommand
+	}
 	//FIXME need to have the learner IDs start from 1 rather than 0
 	var cmd string
 	var doCondExitWrite = true
@@ -435,7 +440,7 @@ func constructLearnerContainer(req *service.JobDeploymentRequest, envVars []v1co
 			export LEARNER_ID=$((${DOWNWARD_API_POD_NAME##*-} + 1)) ;
 			mkdir -p $RESULT_DIR/learner-$LEARNER_ID ;
 			mkdir -p $CHECKPOINT_DIR ;`
-		learnerCommand += learnerBashCommand
+		learnerCommand += rankCommand + learnerBashCommand
 
 		storeLogsCommand := `
 			echo Calling copy logs.
@@ -449,7 +454,7 @@ func constructLearnerContainer(req *service.JobDeploymentRequest, envVars []v1co
 			{cmd: storeLogsCommand, container: storeLogsContainerName},
 		}, sharedVolumeMount.MountPath)
 	} else {
-		command = fmt.Sprintf(`%s mkdir -p $RESULT_DIR ; bash -c ' train.sh 2>&1 | tee -a %s/latest-log; exit ${PIPESTATUS[0]}'`, command, sharedVolumeMount.MountPath)
+		command = fmt.Sprintf(`%s%s mkdir -p $RESULT_DIR ; bash -c ' train.sh 2>&1 | tee -a %s/latest-log; exit ${PIPESTATUS[0]}'`, command, rankCommand, sharedVolumeMount.MountPath)
 		doCondExitWrite = false
 		cmd = wrapCommand(command, learnerContainerName, sharedVolumeMount.MountPath, doCondExitWrite)
 	}
@@ -495,7 +500,7 @@ func constructStoreLogsContainer(sharedVolumeMount v1core.VolumeMount, jobEnvVar
 func constructStoreResultsContainer(sharedVolumeMount v1core.VolumeMount, jobEnvVars []v1core.EnvVar) v1core.Container {
 
 	//FIXME how does this work in terms of split learner
-	command := "store.sh" // only store results from first learner
+	command := storeResultsCommand("store.sh", sharedVolumeMount.MountPath, jobEnvVars) // only store results from first learner
 	container := constructStoreContainer(storeResultsContainerName, command, sharedVolumeMount, jobEnvVars)
 	return container
 }
@@ -552,6 +557,40 @@ func constructStoreContainer(containerName, command string, sharedVolumeMount v1
 	return container
 }
 
+// loadTrainingDataCommand returns the command that runs the data broker script, which loads the training data of every
+// input data store below jobDir
+func loadTrainingDataCommand(script, jobDir string, jobEnvVars []v1core.EnvVar) string {
+	logDir := path.Join(jobDir, "logs")
+	command := fmt.Sprintf(`%s |tee -a %s/load-data.log`, script, logDir)
+	for _, id := range learner.AdditionalStoreIDs(getValue(jobEnvVars, learner.InputDataIDsEnvVar)) {
+		dataDir := path.Join(jobDir, learner.AdditionalStoreDir("data", id, getValue(jobEnvVars, "DATA_DIR_"+id)))
+		command += fmt.Sprintf(` && %s |tee -a %s/load-data.log`, additionalStoreCommand(script, id, dataDir), logDir)
+	}
+	return command
+}
+
+// storeResultsCommand returns the command that runs the data broker script, which stores the results below jobDir in
+// every output data store
+func storeResultsCommand(script, jobDir string, jobEnvVars []v1core.EnvVar) string {
+	command := script
+	for _, id := range learner.AdditionalStoreIDs(getValue(jobEnvVars, learner.OutputDataIDsEnvVar)) {
+		dataDir := path.Join(jobDir, learner.AdditionalStoreDir("results", id, getValue(jobEnvVars, "RESULT_DIR_"+id)))
+		command += " && " + additionalStoreCommand(script, id, dataDir)
+	}
+	return command
+}
+
+// additionalStoreCommand returns the command that runs a data broker script for a data store after the first one. The
+// script sees the DATA_STORE_<ID>_* variables of the store as the DATA_STORE_* variables it expects.
+func additionalStoreCommand(script, id, dataDir string) string {
+	command := ""
+	for _, field := range []string{"TYPE", "AUTHURL", "PATH", "PROJECTID", "USERNAME", "DOMAINNAME", "REGION"} {
+		command += fmt.Sprintf(`DATA_STORE_%s="$DATA_STORE_%s_%s" `, field, id, field)
+	}
+	command += fmt.Sprintf(`DATA_STORE_PASSWORD="$DATA_STORE_%s_APIKEY" DATA_STORE_BUCKET="$DATA_STORE_%s_OBJECTID" `, id, id)
+	return command + fmt.Sprintf(`DATA_DIR="%s" %s`, dataDir, script)
+}
+
 // Store relationship between a command and the "container" it's associated with.
 // The "container" determines the control files used to communicate with the controller.
 type containerCommands struct {
@@ -0,0 +1,337 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/lcm/coord"
+	"github.com/IBM/FfDL/lcm/service/lcm/learner"
+	"github.com/IBM/FfDL/lcm/trainingjob"
+
+	"github.com/cenkalti/backoff"
+	"github.com/go-kit/kit/metrics"
+	"golang.org/x/net/context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// executorKey selects where the LCM runs the learners of training jobs, kubernetesExecutorName or localExecutorName
+	executorKey = "lcm.executor"
+
+	kubernetesExecutorName = "kubernetes"
+	localExecutorName      = "local"
+
+	// trainingJobDeletionTimeout is how long a kill waits for the objects of a training job to be deleted, it is
+	// shorter than the timeout of the trainer's kill requests
+	trainingJobDeletionTimeout      = 45 * time.Second
+	trainingJobDeletionPollInterval = 2 * time.Second
+)
+
+// executor runs the job monitor, learners and helpers of training jobs. The etcd nodes of a job are created before it
+// is deployed and its learners record their status under them, whichever executor runs them.
+type executor interface {
+	// checkUserSecrets verifies the secrets the learner environment of a training refers to. Problems with the
+	// references are returned as a *learner.UserSecretError.
+	checkUserSecrets(req *service.JobDeploymentRequest) error
+	// renderSeed returns the objects a training job is rendered against, such as the pods of the training data
+	// service whose image tag the log collector takes
+	renderSeed() []runtime.Object
+	// deployJobMonitor starts the job monitor of a training job
+	deployJobMonitor(req *service.JobDeploymentRequest, numLearners int, useNativeDistribution bool, logr *logger.LocLoggingEntry) error
+	// deployLearners starts the learners and helpers of a training job
+	deployLearners(ctx context.Context, req *service.JobDeploymentRequest, logr *logger.LocLoggingEntry) error
+	// halt asks the learners of a training job to stop and store their results
+	halt(req *service.JobHaltRequest, logr *logger.LocLoggingEntry) error
+	// kill stops the deployment of a training job named req.Name and deletes everything that was deployed for it,
+	// except for its etcd nodes. A newer deployment of the same training is left alone. It returns an error if the
+	// deployment could not be confirmed to be gone, the progress is counted in counter.
+	kill(req *service.JobKillRequest, counter metrics.Counter, logr *logger.LocLoggingEntry) error
+}
+
+// putHaltNode creates the etcd node the learners of a training job watch for a halt request
+func putHaltNode(etcdClient coord.Coordinator, trainingID string, logr *logger.LocLoggingEntry) error {
+	path := trainingID + "/halt"
+	success, err := etcdClient.PutIfKeyMissing(path, "", logr)
+	if err != nil {
+		logr.WithError(err).Errorf("Failed to update the halt training job status on path %s for training job %s", path, trainingID)
+		return err
+	}
+	if !success {
+		logr.Warnf("While updating halt for training job %s at path %s , the path already exists", trainingID, path)
+	}
+	return nil
+}
+
+// kubernetesExecutor runs training jobs in the learner namespace of a Kubernetes cluster. The learners of a job are a
+// stateful set, the helpers run in the learner pods or in a deployment of their own and the job monitor is a deployment.
+// All of them are owned by the TrainingJob of the training job.
+type kubernetesExecutor struct {
+	k8sClient  kubernetes.Interface
+	tjClient   trainingjob.Interface
+	etcdClient coord.Coordinator
+}
+
+func (e *kubernetesExecutor) checkUserSecrets(req *service.JobDeploymentRequest) error {
+	return learner.CheckUserSecrets(e.k8sClient, req)
+}
+
+func (e *kubernetesExecutor) renderSeed() []runtime.Object {
+	var seed []runtime.Object
+	pods, err := e.k8sClient.Core().Pods(config.GetPodNamespace()).List(metav1.ListOptions{LabelSelector: "service==ffdl-trainingdata"})
+	if err == nil {
+		for i := range pods.Items {
+			seed = append(seed, &pods.Items[i])
+		}
+	}
+	return seed
+}
+
+// trainingJobOwners creates the TrainingJob of a training job unless it exists, and returns the owner references
+// of the objects of the training job. Without the TrainingJob custom resource definition, the objects have no owner
+// and are deleted by their training_id label.
+func (e *kubernetesExecutor) trainingJobOwners(req *service.JobDeploymentRequest, logr *logger.LocLoggingEntry) ([]metav1.OwnerReference, error) {
+	tj := newTrainingJob(req)
+	var owner *trainingjob.TrainingJob
+	if err := backoff.RetryNotify(func() error {
+		var err error
+		owner, err = e.tjClient.TrainingJobs(config.GetLearnerNamespace()).Create(tj)
+		if k8serrors.IsAlreadyExists(err) {
+			owner, err = e.tjClient.TrainingJobs(config.GetLearnerNamespace()).Get(tj.Name, metav1.GetOptions{})
+		}
+		if k8serrors.IsNotFound(err) {
+			logr.WithError(err).Warnf("The TrainingJob resource is not defined, the objects of training job %s have no owner", req.TrainingId)
+			owner = nil
+			return nil
+		}
+		return err
+	}, k8sInteractionBackoff(), func(err error, window time.Duration) {
+		logr.WithError(err).Errorf("Failed in creating training job resource %s while deploying for training", tj.Name)
+		k8sFailureCounter.With(component, "trainingjob").Add(1)
+	}); err != nil {
+		return nil, err
+	}
+	if owner == nil {
+		return nil, nil
+	}
+	return []metav1.OwnerReference{*metav1.NewControllerRef(owner, trainingjob.SchemeGroupVersion.WithKind(trainingjob.Kind))}, nil
+}
+
+// manages a DLaaS training job
+func (e *kubernetesExecutor) deployJobMonitor(req *service.JobDeploymentRequest, numLearners int, useNativeDistribution bool, logr *logger.LocLoggingEntry) error {
+
+	owners, err := e.trainingJobOwners(req, logr)
+	if err != nil {
+		return err
+	}
+
+	envVars, jmLabels := populateJobMonitorEnvVariablesAndLabels(req, req.TrainingId, req.Name, req.UserId, numLearners, useNativeDistribution)
+	deploySpec := defineJobMonitorDeployment(req, envVars, jmLabels, logr)
+	deploySpec.OwnerReferences = owners
+
+	return backoff.RetryNotify(func() error {
+		_, err := e.k8sClient.AppsV1beta1().Deployments(config.GetLearnerNamespace()).Create(deploySpec)
+		if k8serrors.IsAlreadyExists(err) {
+			logr.WithError(err).Warnf("deployment %s already exists", deploySpec.ObjectMeta.Name)
+			return nil
+		}
+		return err
+	}, k8sInteractionBackoff(), func(err error, window time.Duration) {
+		logr.WithError(err).Errorf("Could not connect to learner kube cluster and/or deploy job monitor for Training Job %s. Problem may either be in reaching the kubernetes API server or in creating a deployment", req.TrainingId)
+		k8sFailureCounter.With(component, "jobmonitor").Add(1)
+	})
+}
+
+func (e *kubernetesExecutor) deployLearners(ctx context.Context, req *service.JobDeploymentRequest, logr *logger.LocLoggingEntry) error {
+	owners, err := e.trainingJobOwners(req, logr)
+	if err != nil {
+		return err
+	}
+	return NewTraining(ctx, e.k8sClient, req, owners, logr).Start()
+}
+
+func (e *kubernetesExecutor) halt(req *service.JobHaltRequest, logr *logger.LocLoggingEntry) error {
+	return putHaltNode(e.etcdClient, req.TrainingId, logr)
+}
+
+func (e *kubernetesExecutor) kill(req *service.JobKillRequest, counter metrics.Counter, logr *logger.LocLoggingEntry) error {
+
+	selector := "training_id==" + req.TrainingId
+	backgroundPropagation := metav1.DeletePropagationBackground
+	backgroundDeleteOpts := &metav1.DeleteOptions{
+		PropagationPolicy: &backgroundPropagation,
+	}
+
+	// the garbage collector deletes the objects owned by the TrainingJob
+	owned, err := e.deleteTrainingJob(req, logr)
+	if err != nil {
+		return err
+	}
+	if owned {
+		counter.With(progress, servicesDeletedPhaseComplete).Add(1)
+		counter.With(progress, pvsDeletedPhaseComplete).Add(1)
+		counter.With(progress, secretsDeletedPhaseComplete).Add(1)
+		counter.With(progress, deploymentsDeletedPhaseComplete).Add(1)
+		return nil
+	}
+
+	// the objects of training jobs deployed without a TrainingJob resource have no owner and are deleted by label,
+	// which matches every deployment of the training. The trainer only deploys a training again after the kill of
+	// its previous deployment returned.
+
+	logr.Debugf(" Checking if there are kubernetes services associated with training job %s", req.TrainingId)
+	svcs, err := e.k8sClient.CoreV1().Services(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
+	if err == nil {
+		logr.Debugf(" Services for job with name '%s' found by querying kubernetes.", req.Name)
+		for _, svc := range svcs.Items {
+			logr.Infof(" Deleting service '%s'", svc.ObjectMeta.Name)
+			err := e.k8sClient.CoreV1().Services(config.GetLearnerNamespace()).Delete(svc.ObjectMeta.Name, backgroundDeleteOpts)
+			if err != nil {
+				logr.WithError(err).Errorf(" Deleting kubernetes service '%s' failed", svc.ObjectMeta.Name)
+			}
+		}
+	}
+	counter.With(progress, servicesDeletedPhaseComplete).Add(1)
+
+	logr.Debugf(" Checking if there are kubernetes statefulsets associated with training job %s", req.TrainingId)
+	sets, err := e.k8sClient.AppsV1beta1().StatefulSets(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
+	if err == nil {
+		logr.Debugf(" Stateful for job with name '%s' found by querying kubernetes.", req.Name)
+		for _, set := range sets.Items {
+			logr.Infof(" Deleting stateful '%s'", set.ObjectMeta.Name)
+			err := e.k8sClient.AppsV1beta1().StatefulSets(config.GetLearnerNamespace()).Delete(set.ObjectMeta.Name, backgroundDeleteOpts)
+			if err != nil {
+				logr.WithError(err).Errorf(" Deleting kubernetes stateful '%s' failed", set.ObjectMeta.Name)
+			}
+		}
+	}
+
+	logr.Debugf(" Checking if there are kubernetes learner persistent volume claims associated with training job %s", req.TrainingId)
+	claims, err := e.k8sClient.CoreV1().PersistentVolumeClaims(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
+	if err == nil {
+		for _, claim := range claims.Items {
+			logr.Infof(" Deleting persistent volume claim '%s'", claim.ObjectMeta.Name)
+			err := e.k8sClient.CoreV1().PersistentVolumeClaims(config.GetLearnerNamespace()).Delete(claim.ObjectMeta.Name, backgroundDeleteOpts)
+			if err != nil {
+				logr.WithError(err).Errorf(" Deleting kubernetes persistent volume '%s' failed", claim.ObjectMeta.Name)
+			}
+		}
+	}
+	counter.With(progress, pvsDeletedPhaseComplete).Add(1)
+
+	logr.Debugf(" Checking if there are kubernetes learner COS mount secrets associated with training job %s", req.TrainingId)
+	secrets, err := e.k8sClient.CoreV1().Secrets(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
+	if err == nil {
+		for _, secret := range secrets.Items {
+			logr.Infof(" Deleting Secret '%s'", secret.ObjectMeta.Name)
+			err := e.k8sClient.CoreV1().Secrets(config.GetLearnerNamespace()).Delete(secret.ObjectMeta.Name, backgroundDeleteOpts)
+			if err != nil {
+				logr.WithError(err).Errorf(" Deleting kubernetes Secret '%s' failed", secret.ObjectMeta.Name)
+			}
+		}
+	}
+	counter.With(progress, secretsDeletedPhaseComplete).Add(1)
+
+	logr.Debugf(" Checking if there are kubernetes deployments associated with training job %s", req.TrainingId)
+	deploys, err := e.k8sClient.AppsV1beta1().Deployments(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
+	if err == nil {
+		logr.Debugf(" Deployments for job with name '%s' found by querying kubernetes.", req.Name)
+		for _, deploy := range deploys.Items {
+			logr.Infof(" Deleting deployment '%s'", deploy.ObjectMeta.Name)
+			err := e.k8sClient.AppsV1beta1().Deployments(config.GetLearnerNamespace()).Delete(deploy.ObjectMeta.Name, backgroundDeleteOpts)
+			if err != nil {
+				logr.WithError(err).Errorf(" Deleting kubernetes deployment '%s' failed", deploy.ObjectMeta.Name)
+			}
+		}
+	}
+
+	counter.With(progress, deploymentsDeletedPhaseComplete).Add(1)
+	return nil
+}
+
+// deleteTrainingJob deletes the TrainingJob of the deployment named req.Name and waits until it is gone. The
+// TrainingJob is deleted in the foreground, so it is only gone once the garbage collector deleted the objects it owns.
+// Other TrainingJobs of the training belong to newer deployments and are not touched. It returns false if the
+// training has no TrainingJob at all, like training jobs deployed before the TrainingJob resource was defined.
+// Requests without a name, from trainers that did not record the name of the deployment, delete all TrainingJobs of
+// the training.
+func (e *kubernetesExecutor) deleteTrainingJob(req *service.JobKillRequest, logr *logger.LocLoggingEntry) (bool, error) {
+	tjs, err := e.tjClient.TrainingJobs(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: "training_id==" + req.TrainingId})
+	if k8serrors.IsNotFound(err) {
+		logr.Debugf(" The training job resource is not defined, deleting the objects of the training job by label")
+		return false, nil
+	}
+	if err != nil {
+		logr.WithError(err).Errorf(" Listing training job resources failed")
+		return true, err
+	}
+	if len(tjs.Items) == 0 {
+		logr.Debugf(" No training job resource found, deleting the objects of the training job by label")
+		return false, nil
+	}
+
+	foregroundPropagation := metav1.DeletePropagationForeground
+	opts := &metav1.DeleteOptions{PropagationPolicy: &foregroundPropagation}
+	var deleted []string
+	for _, tj := range tjs.Items {
+		if req.Name != "" && tj.Name != req.Name {
+			logr.Debugf(" Keeping training job resource '%s' of another deployment", tj.Name)
+			continue
+		}
+		logr.Infof(" Deleting training job resource '%s'", tj.Name)
+		err := e.tjClient.TrainingJobs(config.GetLearnerNamespace()).Delete(tj.Name, opts)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			logr.WithError(err).Errorf(" Deleting training job resource '%s' failed", tj.Name)
+			return true, err
+		}
+		deleted = append(deleted, tj.Name)
+	}
+
+	for _, name := range deleted {
+		if err := e.waitForTrainingJobDeletion(name, logr); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// waitForTrainingJobDeletion waits until the TrainingJob is gone, for at most trainingJobDeletionTimeout
+func (e *kubernetesExecutor) waitForTrainingJobDeletion(name string, logr *logger.LocLoggingEntry) error {
+	deadline := time.Now().Add(trainingJobDeletionTimeout)
+	for {
+		_, err := e.tjClient.TrainingJobs(config.GetLearnerNamespace()).Get(name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if err == nil {
+				err = fmt.Errorf("training job resource %s was not deleted within %s", name, trainingJobDeletionTimeout)
+			}
+			logr.WithError(err).Warnf(" Training job resource '%s' is not gone yet", name)
+			return err
+		}
+		time.Sleep(trainingJobDeletionPollInterval)
+	}
+}
@@ -98,6 +98,12 @@ func populateJobMonitorEnvVariablesAndLabels(req *service.JobDeploymentRequest,
 			Value: config.GetLearnerNamespace(),
 		},
 	}
+	if req.MaxDurationSeconds > 0 {
+		envVars = append(envVars, v1core.EnvVar{
+			Name:  "MAX_DURATION",
+			Value: strconv.FormatInt(req.MaxDurationSeconds, 10),
+		})
+	}
 
 	// add all labels passed from the user API
 	jobLabels := make(map[string]string)
@@ -132,9 +138,18 @@ func defineJobMonitorDeployment(req *service.JobDeploymentRequest, envVars []v1c
 
 	jmName := constructJMName(req.Name)
 
+	// the deployment carries the labels of its pod, so that it is found by training id
+	podLabels := map[string]string{
+		"app":         jmName,
+		"training_id": req.TrainingId,
+		"service":     "dlaas-jobmonitor",
+		"user_id":     req.UserId,
+	}
+
 	deploySpec := &v1beta1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: jmName,
+			Name:   jmName,
+			Labels: podLabels,
 		},
 		Spec: v1beta1.DeploymentSpec{
 			Strategy: v1beta1.DeploymentStrategy{
@@ -152,13 +167,8 @@ func defineJobMonitorDeployment(req *service.JobDeploymentRequest, envVars []v1c
 			},
 			Template: v1core.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: jmName,
-					Labels: map[string]string{
-						"app":         jmName,
-						"training_id": req.TrainingId,
-						"service":     "dlaas-jobmonitor",
-						"user_id":     req.UserId,
-					},
+					Name:   jmName,
+					Labels: podLabels,
 				},
 				Spec: v1core.PodSpec{
 					Volumes: []v1core.Volume{
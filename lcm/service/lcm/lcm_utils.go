@@ -55,6 +55,16 @@ func createEtcdNodes(lcm *lcmService, jobName string, userID string, trainingID
 		trainingID + "/" + zkGlobalCursor + "/" + zkGCState:    "0",
 	}
 
+	// the job name is created first, so a kill of an older deployment recognizes nodes of this one as soon as they
+	// exist and keeps them
+	jobNamePath := trainingID + "/" + zkJobName
+	if pathCreated, err := lcm.etcdClient.PutIfKeyMissing(jobNamePath, jobName, logr); err != nil {
+		return err
+	} else if !pathCreated {
+		return fmt.Errorf("Failed to create the path %v , since it was already present", jobNamePath)
+	}
+	delete(pathToValueMapping, jobNamePath)
+
 	for path, val := range pathToValueMapping {
 		pathCreated, error := lcm.etcdClient.PutIfKeyMissing(path, val, logr)
 		if error != nil {
@@ -178,6 +188,11 @@ func learnerNodeEtcdStatusPath(trainingID string, learnerID int) string {
 	return fmt.Sprintf("%s/learner_%d/status", learnerEtcdBasePath(trainingID), learnerID)
 }
 
+// Return the etcd path, relative to the prefix of the coordinator, of the sequence of status updates of a learner
+func learnerStatusSequencePath(trainingID string, learnerID int) string {
+	return fmt.Sprintf("%s/%s/%s%d/%s", trainingID, zkLearners, zkLearner, learnerID, zkStatus)
+}
+
 func learnerNodeEtcdStatusPathRelative(trainingID string, learnerID int) string {
 	return fmt.Sprintf("%s/learner_%d/status", trainingID, learnerID)
 }
@@ -256,6 +271,18 @@ func isJobDone(jobStatus string, logr *logger.LocLoggingEntry) bool {
 	return status == grpc_trainer_v2.Status_COMPLETED || status == grpc_trainer_v2.Status_FAILED || status == grpc_trainer_v2.Status_HALTED
 }
 
+// learnerPodLabels returns the labels of the learner pods: the labels passed from the trainer, which include the
+// user-defined labels of the training, and the training and user id
+func learnerPodLabels(req *service.JobDeploymentRequest) map[string]string {
+	labels := make(map[string]string)
+	for k, v := range req.Labels {
+		labels[k] = v
+	}
+	labels["training_id"] = req.TrainingId
+	labels["user_id"] = req.UserId
+	return labels
+}
+
 // Set the DLaaS service type label to an object.
 // This label is used to configure Calico network policy rules for the pod.
 func setServiceTypeLabel(spec *metav1.ObjectMeta, value string) {
@@ -19,9 +19,11 @@ package learner
 import (
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/IBM/FfDL/commons/service"
 	v1core "k8s.io/api/core/v1"
 )
 
@@ -54,6 +56,42 @@ func PopulateLearnerEnvVariablesAndLabels(existingEnvVars []v1core.EnvVar, train
 
 }
 
+// hyperParameterEnvVarPrefix is the prefix of the environment variables the trainer sets for the parameters of an experiment trial
+const hyperParameterEnvVarPrefix = "HP_"
+
+// The trainer lists the ids of the input and output data stores of a training in these variables, the first one of
+// each is the store DATA_DIR and RESULT_DIR point to. Every store has its own DATA_DIR_<ID> or RESULT_DIR_<ID>.
+const (
+	InputDataIDsEnvVar  = "INPUT_DATA_IDS"
+	OutputDataIDsEnvVar = "OUTPUT_DATA_IDS"
+	dataDirPrefix       = "DATA_DIR_"
+	resultDirPrefix     = "RESULT_DIR_"
+)
+
+// AdditionalStoreIDs returns the ids after the first one in a list of data store ids
+func AdditionalStoreIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")[1:]
+}
+
+// AdditionalStoreDir returns the directory, relative to the mount point, of a data store after the first one. kind
+// is either "data" or "results".
+func AdditionalStoreDir(kind, id, bucket string) string {
+	return path.Join(kind+"_"+strings.ToLower(id), bucket)
+}
+
+// firstStoreID returns the id of the store DATA_DIR or RESULT_DIR point to
+func firstStoreID(envVars []v1core.EnvVar, idsEnvVar string) string {
+	for _, ev := range envVars {
+		if ev.Name == idsEnvVar {
+			return strings.Split(ev.Value, ",")[0]
+		}
+	}
+	return ""
+}
+
 //FIXME for now not changing this much and just whitelisting rather than makign the list explicit
 //need to make this function more testable
 func generateLearnerContainerEnvVars(envVars []v1core.EnvVar, trainingID string, mountTrainingDataStoreInLearner, mountResultsStoreInLearner bool) []v1core.EnvVar {
@@ -75,6 +113,8 @@ func generateLearnerContainerEnvVars(envVars []v1core.EnvVar, trainingID string,
 		"LEARNER_NAME_PREFIX":        {},
 		"DOWNWARD_API_POD_NAME":      {},
 		"DOWNWARD_API_POD_NAMESPACE": {},
+		InputDataIDsEnvVar:           {},
+		OutputDataIDsEnvVar:          {},
 	}
 
 	// Given a set of environment variables, return the subset that should appear in the learner container.
@@ -83,6 +123,12 @@ func generateLearnerContainerEnvVars(envVars []v1core.EnvVar, trainingID string,
 		for _, ev := range allVars {
 			if _, exists := whitelisted[ev.Name]; exists {
 				vars = append(vars, ev)
+			} else if strings.HasPrefix(ev.Name, hyperParameterEnvVarPrefix) {
+				// parameter values of an experiment trial
+				vars = append(vars, ev)
+			} else if strings.HasPrefix(ev.Name, dataDirPrefix) || strings.HasPrefix(ev.Name, resultDirPrefix) {
+				// the directories of the data stores of a training with several inputs or outputs
+				vars = append(vars, ev)
 			} else {
 				// don't include this var.
 			}
@@ -96,8 +142,38 @@ func generateLearnerContainerEnvVars(envVars []v1core.EnvVar, trainingID string,
 	vars := make([]v1core.EnvVar, 0, len(filteredVars))
 	var checkpointDir string
 	var resultBucketDir string
+	firstInputID := firstStoreID(envVars, InputDataIDsEnvVar)
+	firstOutputID := firstStoreID(envVars, OutputDataIDsEnvVar)
 	for _, ev := range filteredVars {
-		if strings.HasSuffix(ev.Name, "_DIR") {
+		if id := strings.TrimPrefix(ev.Name, dataDirPrefix); id != ev.Name {
+			var dir string
+			if id == firstInputID {
+				// the same directory as DATA_DIR
+				dir = path.Join("/job", ev.Value)
+				if mountTrainingDataStoreInLearner {
+					dir = filepath.Join("/mnt/data", ev.Value)
+				}
+			} else if mountTrainingDataStoreInLearner {
+				dir = filepath.Join("/mnt", AdditionalStoreDir("data", id, ev.Value))
+			} else {
+				dir = path.Join("/job", AdditionalStoreDir("data", id, ev.Value))
+			}
+			vars = append(vars, v1core.EnvVar{Name: ev.Name, Value: dir})
+		} else if id := strings.TrimPrefix(ev.Name, resultDirPrefix); id != ev.Name {
+			var dir string
+			if id == firstOutputID {
+				// the same directory as RESULT_DIR
+				dir = path.Join("/job", ev.Value)
+				if mountResultsStoreInLearner {
+					dir = filepath.Join("/mnt/results", ev.Value, trainingID)
+				}
+			} else if mountResultsStoreInLearner {
+				dir = filepath.Join("/mnt", AdditionalStoreDir("results", id, ev.Value), trainingID)
+			} else {
+				dir = path.Join("/job", AdditionalStoreDir("results", id, ev.Value))
+			}
+			vars = append(vars, v1core.EnvVar{Name: ev.Name, Value: dir})
+		} else if strings.HasSuffix(ev.Name, "_DIR") && !strings.HasPrefix(ev.Name, hyperParameterEnvVarPrefix) {
 			var dir string
 			if ev.Name == "DATA_DIR" && mountTrainingDataStoreInLearner {
 				dir = filepath.Join("/mnt/data", ev.Value)
@@ -118,5 +194,39 @@ func generateLearnerContainerEnvVars(envVars []v1core.EnvVar, trainingID string,
 		v1core.EnvVar{Name: "JOB_STATE_DIR", Value: "/job"},
 		v1core.EnvVar{Name: "CHECKPOINT_DIR", Value: checkpointDir},
 		v1core.EnvVar{Name: "RESULT_BUCKET_DIR", Value: resultBucketDir})
+
+	// a resumed training should continue from the checkpoints written by the halted run
+	if checkpointDir != "" && isResumedTraining(envVars) {
+		vars = append(vars, v1core.EnvVar{Name: "RESUME_CHECKPOINT_DIR", Value: checkpointDir})
+	}
+	return vars
+}
+
+// isResumedTraining returns true if the trainer resubmitted a halted training job
+func isResumedTraining(envVars []v1core.EnvVar) bool {
+	for _, ev := range envVars {
+		if ev.Name == "RESUME_FROM_CHECKPOINT" {
+			return ev.Value == "true"
+		}
+	}
+	return false
+}
+
+// UserEnvVars returns the variables the user set for the learner container, sorted by name. They bypass the whitelist
+// of generateLearnerContainerEnvVars, the trainer already rejected the names FfDL uses itself.
+func UserEnvVars(env map[string]string, secretEnv map[string]*service.SecretKeyRef) []v1core.EnvVar {
+	vars := make([]v1core.EnvVar, 0, len(env)+len(secretEnv))
+	for name, value := range env {
+		vars = append(vars, v1core.EnvVar{Name: name, Value: value})
+	}
+	for name, ref := range secretEnv {
+		vars = append(vars, v1core.EnvVar{Name: name, ValueFrom: &v1core.EnvVarSource{
+			SecretKeyRef: &v1core.SecretKeySelector{
+				LocalObjectReference: v1core.LocalObjectReference{Name: ref.Secret},
+				Key:                  ref.Key,
+			},
+		}})
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
 	return vars
 }
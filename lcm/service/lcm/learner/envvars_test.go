@@ -0,0 +1,126 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package learner
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/stretchr/testify/assert"
+	v1core "k8s.io/api/core/v1"
+)
+
+func findEnvVar(vars []v1core.EnvVar, name string) (string, bool) {
+	for _, ev := range vars {
+		if ev.Name == name {
+			return ev.Value, true
+		}
+	}
+	return "", false
+}
+
+func TestResumeCheckpointDir(t *testing.T) {
+	envVars := []v1core.EnvVar{
+		{Name: "RESULT_DIR", Value: "results-bucket"},
+	}
+
+	vars := generateLearnerContainerEnvVars(envVars, "training-1", true, true)
+	_, ok := findEnvVar(vars, "RESUME_CHECKPOINT_DIR")
+	assert.False(t, ok)
+
+	envVars = append(envVars, v1core.EnvVar{Name: "RESUME_FROM_CHECKPOINT", Value: "true"})
+	vars = generateLearnerContainerEnvVars(envVars, "training-1", true, true)
+	dir, ok := findEnvVar(vars, "RESUME_CHECKPOINT_DIR")
+	assert.True(t, ok)
+	assert.Equal(t, "/mnt/results/results-bucket/_wml_checkpoints", dir)
+	checkpointDir, _ := findEnvVar(vars, "CHECKPOINT_DIR")
+	assert.Equal(t, checkpointDir, dir)
+
+	// the flag itself is not passed to the learner
+	_, ok = findEnvVar(vars, "RESUME_FROM_CHECKPOINT")
+	assert.False(t, ok)
+}
+
+func TestHyperParameterEnvVars(t *testing.T) {
+	envVars := []v1core.EnvVar{
+		{Name: "HP_LEARNING_RATE", Value: "0.01"},
+		{Name: "HP_OUTPUT_DIR", Value: "run-3"},
+		{Name: "MODEL_STORE_APIKEY", Value: "secret"},
+	}
+
+	vars := generateLearnerContainerEnvVars(envVars, "training-1", true, true)
+	value, ok := findEnvVar(vars, "HP_LEARNING_RATE")
+	assert.True(t, ok)
+	assert.Equal(t, "0.01", value)
+
+	// parameter values are passed as they are, even if they look like directories
+	value, _ = findEnvVar(vars, "HP_OUTPUT_DIR")
+	assert.Equal(t, "run-3", value)
+
+	_, ok = findEnvVar(vars, "MODEL_STORE_APIKEY")
+	assert.False(t, ok)
+}
+
+func TestMultipleDataStoreEnvVars(t *testing.T) {
+	envVars := []v1core.EnvVar{
+		{Name: "DATA_DIR", Value: "data-bucket"},
+		{Name: "RESULT_DIR", Value: "results-bucket"},
+		{Name: "INPUT_DATA_IDS", Value: "DATA,WEIGHTS"},
+		{Name: "OUTPUT_DATA_IDS", Value: "RESULTS,EXPORTED"},
+		{Name: "DATA_DIR_DATA", Value: "data-bucket"},
+		{Name: "DATA_DIR_WEIGHTS", Value: "weights-bucket"},
+		{Name: "RESULT_DIR_RESULTS", Value: "results-bucket"},
+		{Name: "RESULT_DIR_EXPORTED", Value: "exported-bucket"},
+		{Name: "DATA_STORE_WEIGHTS_APIKEY", Value: "secret"},
+	}
+
+	vars := generateLearnerContainerEnvVars(envVars, "training-1", true, true)
+	for name, dir := range map[string]string{
+		"DATA_DIR":            "/mnt/data/data-bucket",
+		"DATA_DIR_DATA":       "/mnt/data/data-bucket",
+		"DATA_DIR_WEIGHTS":    "/mnt/data_weights/weights-bucket",
+		"RESULT_DIR_RESULTS":  "/mnt/results/results-bucket/training-1",
+		"RESULT_DIR_EXPORTED": "/mnt/results_exported/exported-bucket/training-1",
+	} {
+		value, _ := findEnvVar(vars, name)
+		assert.Equal(t, dir, value, name)
+	}
+	value, _ := findEnvVar(vars, "INPUT_DATA_IDS")
+	assert.Equal(t, "DATA,WEIGHTS", value)
+	_, ok := findEnvVar(vars, "DATA_STORE_WEIGHTS_APIKEY")
+	assert.False(t, ok)
+
+	// stores that are not mounted are loaded into the job directory
+	vars = generateLearnerContainerEnvVars(envVars, "training-1", false, false)
+	value, _ = findEnvVar(vars, "DATA_DIR_WEIGHTS")
+	assert.Equal(t, "/job/data_weights/weights-bucket", value)
+	value, _ = findEnvVar(vars, "RESULT_DIR_RESULTS")
+	assert.Equal(t, "/job/results-bucket", value)
+}
+
+func TestUserEnvVars(t *testing.T) {
+	vars := UserEnvVars(map[string]string{"WANDB_MODE": "offline", "NCCL_DEBUG": "INFO"},
+		map[string]*service.SecretKeyRef{"API_TOKEN": {Secret: "tokens", Key: "api"}})
+	if assert.Len(t, vars, 3) {
+		assert.Equal(t, "API_TOKEN", vars[0].Name)
+		assert.Equal(t, "tokens", vars[0].ValueFrom.SecretKeyRef.Name)
+		assert.Equal(t, "api", vars[0].ValueFrom.SecretKeyRef.Key)
+		assert.Equal(t, v1core.EnvVar{Name: "NCCL_DEBUG", Value: "INFO"}, vars[1])
+		assert.Equal(t, v1core.EnvVar{Name: "WANDB_MODE", Value: "offline"}, vars[2])
+	}
+	assert.Empty(t, UserEnvVars(nil, nil))
+}
@@ -20,8 +20,8 @@ type dockerConfigEntry struct {
 	Auth     string `json:"auth,omitempty"`
 }
 
-// GenerateImagePullSecret ... creates secret only for custom images; otherwise returns default secret name
-func GenerateImagePullSecret(k8sClient kubernetes.Interface, req *service.JobDeploymentRequest) (string, error) {
+// GenerateImagePullSecret ... creates secret only for custom images, owned by owners; otherwise returns default secret name
+func GenerateImagePullSecret(k8sClient kubernetes.Interface, req *service.JobDeploymentRequest, owners []metav1.OwnerReference) (string, error) {
 
 	imagePullSecret := viper.GetString(config.LearnerImagePullSecretKey)
 
@@ -53,9 +53,10 @@ func GenerateImagePullSecret(k8sClient kubernetes.Interface, req *service.JobDep
 	// create Secret object
 	secret := v1core.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      imagePullSecret,
-			Namespace: config.GetLearnerNamespace(),
-			Labels:    map[string]string{"training_id": trainingID}, // this makes sure the secret is deleted with the other learner components
+			Name:            imagePullSecret,
+			Namespace:       config.GetLearnerNamespace(),
+			Labels:          map[string]string{"training_id": trainingID}, // this makes sure the secret is deleted with the other learner components
+			OwnerReferences: owners,
 		},
 		Type: v1core.SecretTypeDockercfg, // kubernetes.io/dockercfg
 		Data: map[string][]byte{},
@@ -85,8 +85,15 @@ func CreateServiceSpec(name string, trainingID string) *v1core.Service {
 					Protocol: v1core.ProtocolTCP,
 					Port:     2222,
 				},
+				v1core.ServicePort{
+					Name:     "pytorch-dist",
+					Protocol: v1core.ProtocolTCP,
+					Port:     PyTorchMasterPort,
+				},
 			},
 			ClusterIP: "None",
+			// learners look each other up before all of them are ready
+			PublishNotReadyAddresses: true,
 		},
 	}
 }
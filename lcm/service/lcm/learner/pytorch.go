@@ -0,0 +1,44 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package learner
+
+import (
+	"fmt"
+	"strconv"
+
+	v1core "k8s.io/api/core/v1"
+)
+
+// PyTorchMasterPort is the port the first learner of a distributed PyTorch training listens on for the others to join
+// the process group. The service governing the learners exposes it.
+const PyTorchMasterPort = 23456
+
+// PyTorchRankCommand exports the rank of the learner for torch.distributed, which is the ordinal of its pod in the
+// stateful set. Kubernetes cannot set it in the pod template, so the learner command computes it like LEARNER_ID.
+const PyTorchRankCommand = `
+			export RANK=$((${DOWNWARD_API_POD_NAME##*-})) ;`
+
+// PyTorchEnvVars returns the variables torch.distributed reads to initialize the process group with the env://
+// method: the first pod of the learner stateful set is the master, which the other learners reach through the
+// headless service governing the stateful set. RANK differs between the learners and is set by PyTorchRankCommand.
+func PyTorchEnvVars(statefulsetName string, serviceName string, numLearners int) []v1core.EnvVar {
+	return []v1core.EnvVar{
+		{Name: "MASTER_ADDR", Value: fmt.Sprintf("%s-0.%s", statefulsetName, serviceName)},
+		{Name: "MASTER_PORT", Value: strconv.Itoa(PyTorchMasterPort)},
+		{Name: "WORLD_SIZE", Value: strconv.Itoa(numLearners)},
+	}
+}
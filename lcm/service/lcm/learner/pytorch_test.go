@@ -0,0 +1,39 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package learner
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPyTorchRankCommand(t *testing.T) {
+	for podName, rank := range map[string]string{
+		"learner-job-1-0":  "0",
+		"learner-job-1-3":  "3",
+		"learner-job-1-12": "12",
+	} {
+		cmd := exec.Command("bash", "-c", PyTorchRankCommand+` echo $RANK`)
+		cmd.Env = []string{"DOWNWARD_API_POD_NAME=" + podName}
+		out, err := cmd.Output()
+		assert.NoError(t, err, podName)
+		assert.Equal(t, rank, strings.TrimSpace(string(out)), podName)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package learner
+
+import (
+	"strings"
+
+	"github.com/IBM/FfDL/commons/service"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// archNodeLabel is the node label with the CPU architecture, which the architecture of a training selects
+	archNodeLabel = "kubernetes.io/arch"
+	// the topology the scheduling policies spread or pack the learners of a training in
+	hostnameTopologyKey = "kubernetes.io/hostname"
+)
+
+// SetSchedulingConstraints adds the node selector and the tolerations of a training to the pod template of its
+// learners, and the affinity between the learners that follows from its scheduling policy
+func SetSchedulingConstraints(podTemplateSpec *v1core.PodTemplateSpec, req *service.JobDeploymentRequest) {
+	resources := req.GetResources()
+
+	nodeSelector := make(map[string]string)
+	for k, v := range resources.GetNodeSelector() {
+		nodeSelector[k] = v
+	}
+	if resources.GetArchitecture() != "" {
+		nodeSelector[archNodeLabel] = resources.GetArchitecture()
+	}
+	if len(nodeSelector) > 0 {
+		if podTemplateSpec.Spec.NodeSelector == nil {
+			podTemplateSpec.Spec.NodeSelector = make(map[string]string)
+		}
+		for k, v := range nodeSelector {
+			podTemplateSpec.Spec.NodeSelector[k] = v
+		}
+	}
+
+	for _, t := range resources.GetTolerations() {
+		operator := v1core.TolerationOperator(t.Operator)
+		if operator == "" {
+			operator = v1core.TolerationOpEqual
+		}
+		podTemplateSpec.Spec.Tolerations = append(podTemplateSpec.Spec.Tolerations, v1core.Toleration{
+			Key:      t.Key,
+			Operator: operator,
+			Value:    t.Value,
+			Effect:   v1core.TaintEffect(t.Effect),
+		})
+	}
+
+	podTemplateSpec.Spec.Affinity = learnerAffinity(req)
+}
+
+// learnerAffinity returns the affinity of the learners of a training to each other: with the spread policy they
+// prefer different nodes, with the pack policy they prefer the same node. Neither keeps learners from being scheduled.
+func learnerAffinity(req *service.JobDeploymentRequest) *v1core.Affinity {
+	if req.GetResources().GetLearners() < 2 {
+		return nil
+	}
+	terms := []v1core.WeightedPodAffinityTerm{
+		{
+			Weight: 100,
+			PodAffinityTerm: v1core.PodAffinityTerm{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"training_id": req.TrainingId, "service": "dlaas-learner"},
+				},
+				TopologyKey: hostnameTopologyKey,
+			},
+		},
+	}
+	switch strings.ToLower(req.GetResources().GetSchedpolicy()) {
+	case "spread":
+		return &v1core.Affinity{PodAntiAffinity: &v1core.PodAntiAffinity{PreferredDuringSchedulingIgnoredDuringExecution: terms}}
+	case "pack", "dense":
+		return &v1core.Affinity{PodAffinity: &v1core.PodAffinity{PreferredDuringSchedulingIgnoredDuringExecution: terms}}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package learner
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/stretchr/testify/assert"
+	v1core "k8s.io/api/core/v1"
+)
+
+func TestSetSchedulingConstraints(t *testing.T) {
+	req := &service.JobDeploymentRequest{
+		TrainingId: "training-1",
+		Resources: &service.ResourceRequirements{
+			Learners:     2,
+			Schedpolicy:  "spread",
+			Architecture: "ppc64le",
+			NodeSelector: map[string]string{"pool": "training"},
+			Tolerations: []*service.Toleration{
+				{Key: "dedicated", Value: "ffdl", Effect: "NoSchedule"},
+				{Key: "preemptible", Operator: "Exists"},
+			},
+		},
+	}
+	podSpec := createPodSpecForTesting()
+	SetSchedulingConstraints(&podSpec, req)
+
+	assert.Equal(t, map[string]string{"pool": "training", "kubernetes.io/arch": "ppc64le"}, podSpec.Spec.NodeSelector)
+	// the tolerations of the user come after the built-in ones
+	assert.Equal(t, []v1core.Toleration{
+		{Key: "dedicated", Operator: v1core.TolerationOpEqual, Value: "gpu-task", Effect: v1core.TaintEffectNoSchedule},
+		{Key: "dedicated", Operator: v1core.TolerationOpEqual, Value: "ffdl", Effect: v1core.TaintEffectNoSchedule},
+		{Key: "preemptible", Operator: v1core.TolerationOpExists},
+	}, podSpec.Spec.Tolerations)
+
+	if assert.NotNil(t, podSpec.Spec.Affinity) && assert.NotNil(t, podSpec.Spec.Affinity.PodAntiAffinity) {
+		assert.Nil(t, podSpec.Spec.Affinity.PodAffinity)
+		terms := podSpec.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+		if assert.Len(t, terms, 1) {
+			assert.Equal(t, "kubernetes.io/hostname", terms[0].PodAffinityTerm.TopologyKey)
+			assert.Equal(t, map[string]string{"training_id": "training-1", "service": "dlaas-learner"},
+				terms[0].PodAffinityTerm.LabelSelector.MatchLabels)
+		}
+	}
+
+	req.Resources.Schedpolicy = "dense"
+	podSpec = createPodSpecForTesting()
+	SetSchedulingConstraints(&podSpec, req)
+	if assert.NotNil(t, podSpec.Spec.Affinity) {
+		assert.Nil(t, podSpec.Spec.Affinity.PodAntiAffinity)
+		assert.NotNil(t, podSpec.Spec.Affinity.PodAffinity)
+	}
+
+	// a single learner has no affinity, and nothing is selected without constraints
+	podSpec = createPodSpecForTesting()
+	SetSchedulingConstraints(&podSpec, &service.JobDeploymentRequest{
+		Resources: &service.ResourceRequirements{Learners: 1, Schedpolicy: "spread"},
+	})
+	assert.Nil(t, podSpec.Spec.Affinity)
+	assert.Empty(t, podSpec.Spec.NodeSelector)
+	assert.Len(t, podSpec.Spec.Tolerations, 1)
+}
@@ -17,9 +17,15 @@
 package learner
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/service"
 	v1core "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 //COSVolumeSecret ...
@@ -36,6 +42,8 @@ type SSHVolumeSecret struct {
 type Secrets struct {
 	TrainingDataSecret *COSVolumeSecret
 	ResultsDirSecret   *COSVolumeSecret
+	// the secrets of the stores after the first one of a training with several inputs or outputs
+	AdditionalSecrets []*COSVolumeSecret
 }
 
 //CreateVolumeSecretsSpec ...
@@ -52,6 +60,10 @@ func CreateVolumeSecretsSpec(secrets Secrets) []*v1core.Secret {
 		secretSpecs = append(secretSpecs, generateCOSVolumeSecret(cosResultDirVolumeSecretParams.ID, cosResultDirVolumeSecretParams.TrainingID, cosResultDirVolumeSecretParams.Username, cosResultDirVolumeSecretParams.APIKey))
 	}
 
+	for _, secret := range secrets.AdditionalSecrets {
+		secretSpecs = append(secretSpecs, generateCOSVolumeSecret(secret.ID, secret.TrainingID, secret.Username, secret.APIKey))
+	}
+
 	return secretSpecs
 }
 
@@ -72,3 +84,55 @@ func generateCOSVolumeSecret(id, trainingID, username, apikey string) *v1core.Se
 
 	return &spec
 }
+
+// SecretOwnerLabel is the label with the id of the user who may reference a secret in the environment of a learner
+const SecretOwnerLabel = "user_id"
+
+// UserSecretError is returned by CheckUserSecrets when a training references a secret the user cannot use
+type UserSecretError struct {
+	msg string
+}
+
+func (e *UserSecretError) Error() string {
+	return e.msg
+}
+
+// NewUserSecretError returns a *UserSecretError with a formatted message
+func NewUserSecretError(format string, args ...interface{}) *UserSecretError {
+	return &UserSecretError{fmt.Sprintf(format, args...)}
+}
+
+// CheckUserSecrets verifies that the secrets the learner environment of a training refers to exist in the learner
+// namespace, belong to the user of the training and have the referenced keys. Problems with the references are
+// returned as a *UserSecretError.
+func CheckUserSecrets(k8sClient kubernetes.Interface, req *service.JobDeploymentRequest) error {
+	var names []string
+	for name := range req.LearnerSecretEnvVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	secrets := make(map[string]*v1core.Secret)
+	for _, name := range names {
+		ref := req.LearnerSecretEnvVars[name]
+		secret, ok := secrets[ref.Secret]
+		if !ok {
+			var err error
+			secret, err = k8sClient.CoreV1().Secrets(config.GetLearnerNamespace()).Get(ref.Secret, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				secret = nil
+			} else if err != nil {
+				return err
+			}
+			secrets[ref.Secret] = secret
+		}
+		// a missing secret is reported like one of another user, not to reveal which secrets exist
+		if secret == nil || secret.Labels[SecretOwnerLabel] != req.UserId {
+			return &UserSecretError{fmt.Sprintf("environment variable %s references secret %s, which is not a secret of user %s", name, ref.Secret, req.UserId)}
+		}
+		if _, ok := secret.Data[ref.Key]; !ok {
+			return &UserSecretError{fmt.Sprintf("environment variable %s references key %s, which secret %s does not have", name, ref.Key, ref.Secret)}
+		}
+	}
+	return nil
+}
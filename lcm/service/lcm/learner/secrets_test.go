@@ -0,0 +1,63 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package learner
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/stretchr/testify/assert"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCheckUserSecrets(t *testing.T) {
+	secret := func(name, owner string) *v1core.Secret {
+		return &v1core.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: config.GetLearnerNamespace(),
+				Labels:    map[string]string{SecretOwnerLabel: owner},
+			},
+			Data: map[string][]byte{"api_key": []byte("secret")},
+		}
+	}
+	k8sClient := fake.NewSimpleClientset(secret("wandb-alice", "alice"), secret("wandb-bob", "bob"))
+	check := func(userID string, secretName string, key string) error {
+		return CheckUserSecrets(k8sClient, &service.JobDeploymentRequest{
+			UserId: userID,
+			LearnerSecretEnvVars: map[string]*service.SecretKeyRef{
+				"WANDB_API_KEY": {Secret: secretName, Key: key},
+			},
+		})
+	}
+
+	assert.NoError(t, CheckUserSecrets(k8sClient, &service.JobDeploymentRequest{UserId: "alice"}))
+	assert.NoError(t, check("alice", "wandb-alice", "api_key"))
+	assert.NoError(t, check("bob", "wandb-bob", "api_key"))
+
+	for _, err := range []error{
+		check("alice", "wandb-bob", "api_key"),
+		check("alice", "missing", "api_key"),
+		check("alice", "wandb-alice", "password"),
+	} {
+		_, ok := err.(*UserSecretError)
+		assert.True(t, ok, "%v", err)
+	}
+}
@@ -45,6 +45,9 @@ type COSVolume struct {
 type Volumes struct {
 	TrainingData *COSVolume
 	ResultsDir   *COSVolume
+	// the stores after the first one of a training with several inputs or outputs
+	AdditionalTrainingData []*COSVolume
+	AdditionalResultsDirs  []*COSVolume
 }
 
 //VolumeMountSpec ...
@@ -57,8 +60,10 @@ func (volumes Volumes) CreateVolumeForLearner() []v1core.Volume {
 
 	var volumeSpecs []v1core.Volume
 
-	if volumes.TrainingData != nil {
-		trainingDataParams := volumes.TrainingData
+	for _, trainingDataParams := range append([]*COSVolume{volumes.TrainingData}, volumes.AdditionalTrainingData...) {
+		if trainingDataParams == nil {
+			continue
+		}
 		if trainingDataParams.VolumeType == DataStoreTypeMountCOSS3 {
 			volumeSpecs = append(volumeSpecs, generateCOSTrainingDataVolume(trainingDataParams.ID, trainingDataParams.Region, trainingDataParams.Bucket,
 				trainingDataParams.Endpoint, trainingDataParams.SecretRef, trainingDataParams.CacheSize, trainingDataParams.DiskFree))
@@ -70,8 +75,10 @@ func (volumes Volumes) CreateVolumeForLearner() []v1core.Volume {
 		}
 	}
 
-	if volumes.ResultsDir != nil {
-		resultDirParams := volumes.ResultsDir
+	for _, resultDirParams := range append([]*COSVolume{volumes.ResultsDir}, volumes.AdditionalResultsDirs...) {
+		if resultDirParams == nil {
+			continue
+		}
 		if resultDirParams.VolumeType == DataStoreTypeMountCOSS3 {
 			volumeSpecs = append(volumeSpecs, generateCOSResultsVolume(resultDirParams.ID, resultDirParams.Region, resultDirParams.Bucket,
 				resultDirParams.Endpoint, resultDirParams.SecretRef, resultDirParams.CacheSize, resultDirParams.DiskFree))
@@ -100,6 +107,14 @@ func (volumes Volumes) CreateVolumeMountsForLearner() []v1core.VolumeMount {
 			volumes.ResultsDir.MountSpec.SubPath))
 	}
 
+	for _, v := range volumes.AdditionalTrainingData {
+		mounts = append(mounts, generateDataDirVolumeMount(v.ID, v.MountSpec.MountPath, v.MountSpec.SubPath))
+	}
+
+	for _, v := range volumes.AdditionalResultsDirs {
+		mounts = append(mounts, generateResultDirVolumeMount(v.ID, v.MountSpec.MountPath, v.MountSpec.SubPath))
+	}
+
 	return mounts
 }
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"github.com/IBM/FfDL/lcm/service/lcm/helper"
@@ -34,6 +35,7 @@ import (
 
 	"k8s.io/api/apps/v1beta1"
 	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -59,6 +61,7 @@ type training struct {
 	trainingID string
 	learner    learnerDefinition
 	helper     helperDefinition
+	owners     []metav1.OwnerReference
 	logr       *logger.LocLoggingEntry
 }
 
@@ -106,8 +109,8 @@ type helperDefinition struct {
 	name              string
 }
 
-//NewTraining ...
-func NewTraining(ctx context.Context, k8sClient kubernetes.Interface, req *service.JobDeploymentRequest, log *logger.LocLoggingEntry) Training {
+//NewTraining ... the objects of the training are created with the owner references owners
+func NewTraining(ctx context.Context, k8sClient kubernetes.Interface, req *service.JobDeploymentRequest, owners []metav1.OwnerReference, log *logger.LocLoggingEntry) Training {
 	const cosMountDriverName = "ibm/ibmc-s3fs"
 	const cosMountType = "mount_cos"
 	learnerName := fmt.Sprintf("learner-%s", req.Name)
@@ -132,6 +135,13 @@ func NewTraining(ctx context.Context, k8sClient kubernetes.Interface, req *servi
 	envVarsFromDeploymentRequest := extractEnvVarsFromDeploymentRequest(req) //shared across all containers of training
 	envvarsForLearner := envVarsForDeployingLearner(envVarsFromDeploymentRequest, req.TrainingId,
 		numLearners, learnerName, mountTrainingDataStoreInLearner, mountResultsStoreInLearner) //only for learner
+	if req.Framework == pytorchFrameworkName {
+		// the process group of torch.distributed meets at the first learner, which is reached through the service
+		// named like the stateful set
+		envvarsForLearner = append(envvarsForLearner, learner.PyTorchEnvVars(learnerName, learnerName, numLearners)...)
+	}
+	// the variables of the user are not shared with the helpers
+	envvarsForLearner = append(envvarsForLearner, learner.UserEnvVars(req.LearnerEnvVars, req.LearnerSecretEnvVars)...)
 
 	learnerVolumes := volumesForLearner(req, envvarsForLearner, mountTrainingDataStoreInLearner, mountResultsStoreInLearner, logr)
 	learnerVolumeSpecs := learnerVolumes.CreateVolumeForLearner()
@@ -161,10 +171,10 @@ func NewTraining(ctx context.Context, k8sClient kubernetes.Interface, req *servi
 	if helperVolumes.SharedNonSplitLearnerHelperVolume != nil {
 		//this should not be the default case, we should be running in split mode by default
 		logr.Warnf("starting deploying learner infra for non split learning, this is not expected")
-		return nonSplitTraining{&training{ctx, k8sClient, req, req.TrainingId, learnerDefn, helperDefn, logr}}
+		return nonSplitTraining{&training{ctx, k8sClient, req, req.TrainingId, learnerDefn, helperDefn, owners, logr}}
 	}
 	logr.Infof("starting deploying learner infra for split learning")
-	return splitTraining{&training{ctx, k8sClient, req, req.TrainingId, learnerDefn, helperDefn, logr}}
+	return splitTraining{&training{ctx, k8sClient, req, req.TrainingId, learnerDefn, helperDefn, owners, logr}}
 }
 
 ///-------
@@ -184,6 +194,20 @@ func secretsForDeployingLearner(req *service.JobDeploymentRequest, mountTraining
 		secretsStruct.ResultsDirSecret = &learner.COSVolumeSecret{ID: resultsMountSecretName, TrainingID: req.TrainingId, Username: req.EnvVars["RESULT_STORE_USERNAME"], APIKey: req.EnvVars["RESULT_STORE_APIKEY"]}
 	}
 
+	if req.EnvVars["DATA_STORE_TYPE"] == learner.DataStoreTypeMountCOSS3 {
+		for _, id := range learner.AdditionalStoreIDs(req.EnvVars[learner.InputDataIDsEnvVar]) {
+			prefix := "DATA_STORE_" + id + "_"
+			secretsStruct.AdditionalSecrets = append(secretsStruct.AdditionalSecrets, &learner.COSVolumeSecret{ID: "cossecretdata-" + storeName(id, req), TrainingID: req.TrainingId, Username: req.EnvVars[prefix+"USERNAME"], APIKey: req.EnvVars[prefix+"APIKEY"]})
+		}
+	}
+
+	if req.EnvVars["RESULT_STORE_TYPE"] == learner.DataStoreTypeMountCOSS3 {
+		for _, id := range learner.AdditionalStoreIDs(req.EnvVars[learner.OutputDataIDsEnvVar]) {
+			prefix := "RESULT_STORE_" + id + "_"
+			secretsStruct.AdditionalSecrets = append(secretsStruct.AdditionalSecrets, &learner.COSVolumeSecret{ID: "cossecretresults-" + storeName(id, req), TrainingID: req.TrainingId, Username: req.EnvVars[prefix+"USERNAME"], APIKey: req.EnvVars[prefix+"APIKEY"]})
+		}
+	}
+
 	secretSpecs := learner.CreateVolumeSecretsSpec(secretsStruct)
 
 	return secretSpecs
@@ -201,18 +225,7 @@ func volumesForLearner(req *service.JobDeploymentRequest, learnerEnvVars []v1cor
 			if region == "" {
 				region = "us-standard"
 			}
-			configValStr := config.GetString("MOUNTCOS_GB_CACHE_PER_GPU")
-			cacheSize, err := strconv.Atoi(configValStr)
-			if err != nil {
-				cacheSize = 6
-				logr.Warnf("DLAAS_MOUNTCOS_GB_CACHE_PER_GPU value %s is not an integer.  Defaulting to %dGB/GPU", configValStr, cacheSize)
-			}
-			cacheSize = cacheSize * int(req.Resources.Gpus)
-			// reserve 1/3 of cache for prefetching, up to a limit (diskFree is specified in MB, cache in GB)
-			diskFree := (cacheSize * 1024) / 3
-			if diskFree > 10000 {
-				diskFree = 10000
-			}
+			cacheSize, diskFree := cosMountCacheSize(req, logr)
 
 			volumesStruct.TrainingData = &learner.COSVolume{
 				VolumeType: dataStoreType,
@@ -297,9 +310,103 @@ func volumesForLearner(req *service.JobDeploymentRequest, learnerEnvVars []v1cor
 		}
 	}
 
+	if mountTrainingDataStoreInLearner {
+		volumesStruct.AdditionalTrainingData = additionalVolumesForLearner(req, learnerEnvVars, "DATA_STORE_", learner.InputDataIDsEnvVar, logr)
+	}
+	if mountResultsStoreInLearner {
+		volumesStruct.AdditionalResultsDirs = additionalVolumesForLearner(req, learnerEnvVars, "RESULT_STORE_", learner.OutputDataIDsEnvVar, logr)
+	}
+
 	return volumesStruct
 }
 
+// additionalVolumesForLearner returns the volumes of the input or output data stores after the first one, they are
+// of the same type as the first one
+func additionalVolumesForLearner(req *service.JobDeploymentRequest, learnerEnvVars []v1core.EnvVar, storePrefix, idsEnvVar string, logr *logger.LocLoggingEntry) []*learner.COSVolume {
+	isInput := storePrefix == "DATA_STORE_"
+	dirPrefix := "RESULT_DIR_"
+	if isInput {
+		dirPrefix = "DATA_DIR_"
+	}
+	storeType := req.EnvVars[storePrefix+"TYPE"]
+
+	var volumes []*learner.COSVolume
+	for _, id := range learner.AdditionalStoreIDs(req.EnvVars[idsEnvVar]) {
+		prefix := storePrefix + id + "_"
+		// the directory in the learner, and the unadulterated value
+		dir := getValue(learnerEnvVars, dirPrefix+id)
+		bucket := req.EnvVars[dirPrefix+id]
+
+		if storeType == learner.DataStoreTypeMountCOSS3 {
+			region := req.EnvVars[prefix+"REGION"]
+			if region == "" {
+				region = "us-standard"
+			}
+			v := &learner.COSVolume{
+				VolumeType: storeType,
+				Region:     region,
+				Bucket:     bucket,
+				Endpoint:   req.EnvVars[prefix+"AUTHURL"],
+			}
+			if isInput {
+				cacheSize, diskFree := cosMountCacheSize(req, logr)
+				v.ID = "cosinputmount-" + storeName(id, req)
+				v.SecretRef = "cossecretdata-" + storeName(id, req)
+				v.MountSpec = learner.VolumeMountSpec{MountPath: dir}
+				v.CacheSize = strconv.Itoa(cacheSize)
+				v.DiskFree = strconv.Itoa(diskFree)
+			} else {
+				v.ID = "cosoutputmount-" + storeName(id, req)
+				v.SecretRef = "cossecretresults-" + storeName(id, req)
+				// the learner writes its results below the training id in the bucket
+				v.MountSpec = learner.VolumeMountSpec{MountPath: path.Dir(dir)}
+				v.CacheSize = "0"
+				v.DiskFree = "2048"
+			}
+			volumes = append(volumes, v)
+		} else if storeType == learner.DataStoreHostMountVolume {
+			name := "outputmount-" + storeName(id, req)
+			if isInput {
+				name = "inputmount-" + storeName(id, req)
+			}
+			volumes = append(volumes, &learner.COSVolume{
+				VolumeType: storeType,
+				ID:         name,
+				HostPath:   req.EnvVars[prefix+"PATH"],
+				MountSpec: learner.VolumeMountSpec{
+					Name:      name,
+					MountPath: dir,
+					SubPath:   bucket,
+				},
+			})
+		}
+	}
+	logr.Debugf("Additional volume requests for %s: %v+", idsEnvVar, volumes)
+	return volumes
+}
+
+// storeName returns the part of the names of the volume and secret of a data store that is unique in the cluster
+func storeName(id string, req *service.JobDeploymentRequest) string {
+	return strings.Replace(strings.ToLower(id), "_", "-", -1) + "-" + req.Name
+}
+
+// cosMountCacheSize returns the cache size in GB, and the disk space in MB to keep free, of a mounted input data store
+func cosMountCacheSize(req *service.JobDeploymentRequest, logr *logger.LocLoggingEntry) (int, int) {
+	configValStr := config.GetString("MOUNTCOS_GB_CACHE_PER_GPU")
+	cacheSize, err := strconv.Atoi(configValStr)
+	if err != nil {
+		cacheSize = 6
+		logr.Warnf("DLAAS_MOUNTCOS_GB_CACHE_PER_GPU value %s is not an integer.  Defaulting to %dGB/GPU", configValStr, cacheSize)
+	}
+	cacheSize = cacheSize * int(req.Resources.Gpus)
+	// reserve 1/3 of cache for prefetching, up to a limit (diskFree is specified in MB, cache in GB)
+	diskFree := (cacheSize * 1024) / 3
+	if diskFree > 10000 {
+		diskFree = 10000
+	}
+	return cacheSize, diskFree
+}
+
 func volumesForHelper(req *service.JobDeploymentRequest, logr *logger.LocLoggingEntry) helper.Volumes {
 	volumesStruct := helper.Volumes{}
 
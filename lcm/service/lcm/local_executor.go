@@ -0,0 +1,462 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/lcm/coord"
+	"github.com/IBM/FfDL/lcm/service/lcm/learner"
+	"github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+
+	v1core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// localDirKey is the scratch directory of the local executor, every learner gets a directory below it
+	localDirKey = "lcm.local.dir"
+	// localJobMonitorKey is the command that runs the job monitor of a training, none is run if it is empty
+	localJobMonitorKey = "lcm.local.jobmonitor"
+	// the data broker scripts the helper steps run
+	localLoadModelKey = "lcm.local.loadmodel"
+	localLoadDataKey  = "lcm.local.loaddata"
+	localStoreKey     = "lcm.local.store"
+)
+
+// localLearnerCommand runs the training command in the model directory, like the learner container of a custom image
+const localLearnerCommand = `mkdir -p "$RESULT_DIR" ; cd "$MODEL_DIR" && export PYTHONPATH="$PWD" && eval "$TRAINING_COMMAND"`
+
+// how long killing a training job waits for its processes to exit
+const localKillTimeout = 30 * time.Second
+
+// the variables of the LCM environment that are not passed to learners and helpers, like the learner container unsets
+// them
+var localUnsetEnvVarPrefixes = []string{"ALERTMANAGER", "DLAAS", "ETCD", "GRAFANA", "HOSTNAME", "KUBERNETES", "MONGO", "PUSHGATEWAY"}
+
+// localExecutor runs training jobs as processes on the host of the LCM, with a scratch directory per learner. A learner
+// takes the steps the controller of a learner pod takes: load the model and the training data, run the training
+// command, store the results and the logs. It records the same status updates in etcd, so that the job monitor follows
+// the job as usual. This lets the full training path run on a workstation without a cluster.
+type localExecutor struct {
+	etcdClient        coord.Coordinator
+	dir               string
+	jobMonitorCommand string
+	loadModelCommand  string
+	loadDataCommand   string
+	storeCommand      string
+
+	mu   sync.Mutex
+	jobs map[string]*localJob
+}
+
+// localJob is a training job the local executor runs
+type localJob struct {
+	name     string // the name of the deployment
+	ctx      context.Context
+	cancel   context.CancelFunc // kills the processes of the job
+	halted   chan struct{}      // closed when the job is halted
+	haltOnce sync.Once
+	running  sync.WaitGroup
+}
+
+// localLearnerStatus is a status update as the controller of a learner pod records it in etcd
+type localLearnerStatus struct {
+	Timestamp     string `json:"timestamp"`
+	Status        string `json:"status"`
+	ErrorCode     string `json:"error_code"`
+	StatusMessage string `json:"status_message"`
+}
+
+func newLocalExecutor(etcdClient coord.Coordinator) *localExecutor {
+	return &localExecutor{
+		etcdClient:        etcdClient,
+		dir:               viper.GetString(localDirKey),
+		jobMonitorCommand: viper.GetString(localJobMonitorKey),
+		loadModelCommand:  viper.GetString(localLoadModelKey),
+		loadDataCommand:   viper.GetString(localLoadDataKey),
+		storeCommand:      viper.GetString(localStoreKey),
+	}
+}
+
+func (e *localExecutor) trainingDir(trainingID string) string {
+	return filepath.Join(e.dir, trainingID)
+}
+
+func (e *localExecutor) learnerDir(trainingID string, learnerID int) string {
+	return filepath.Join(e.trainingDir(trainingID), fmt.Sprintf("learner-%d", learnerID))
+}
+
+// job returns the running job of a training, which is created if it does not exist
+func (e *localExecutor) job(trainingID string, name string) *localJob {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.jobs == nil {
+		e.jobs = make(map[string]*localJob)
+	}
+	job := e.jobs[trainingID]
+	if job == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		job = &localJob{name: name, ctx: ctx, cancel: cancel, halted: make(chan struct{})}
+		e.jobs[trainingID] = job
+	}
+	return job
+}
+
+func (job *localJob) isHalted() bool {
+	select {
+	case <-job.halted:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e *localExecutor) checkUserSecrets(req *service.JobDeploymentRequest) error {
+	var names []string
+	for name := range req.LearnerSecretEnvVars {
+		names = append(names, name)
+	}
+	if len(names) > 0 {
+		sort.Strings(names)
+		return learner.NewUserSecretError("environment variable %s references a secret, which learners running as local processes cannot read", names[0])
+	}
+	return nil
+}
+
+// renderSeed returns nothing, there is no training data service to take the tag of the log collector from
+func (e *localExecutor) renderSeed() []runtime.Object {
+	return nil
+}
+
+func (e *localExecutor) deployJobMonitor(req *service.JobDeploymentRequest, numLearners int, useNativeDistribution bool, logr *logger.LocLoggingEntry) error {
+	job := e.job(req.TrainingId, req.Name)
+	if e.jobMonitorCommand == "" {
+		logr.Warnf("No job monitor command is configured, the status of training %s is only recorded in etcd", req.TrainingId)
+		return nil
+	}
+	dir := e.trainingDir(req.TrainingId)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	// the etcd settings the job monitor deployment reads from the secrets of the LCM are in the environment of the LCM
+	envVars, _ := populateJobMonitorEnvVariablesAndLabels(req, req.TrainingId, req.Name, req.UserId, numLearners, useNativeDistribution)
+	env := os.Environ()
+	for _, ev := range envVars {
+		if ev.ValueFrom == nil {
+			env = append(env, ev.Name+"="+ev.Value)
+		}
+	}
+
+	job.running.Add(1)
+	go func() {
+		defer job.running.Done()
+		code, err := runProcess(job.ctx, dir, e.jobMonitorCommand, env, filepath.Join(dir, "jobmonitor.log"))
+		if err != nil {
+			logr.WithError(err).Errorf("Failed to run the job monitor of training %s", req.TrainingId)
+		} else if job.ctx.Err() == nil {
+			logr.Infof("The job monitor of training %s exited with %d", req.TrainingId, code)
+		}
+	}()
+	return nil
+}
+
+// deployLearners starts the learners of a training job. Their processes outlive the request that deploys them.
+func (e *localExecutor) deployLearners(ctx context.Context, req *service.JobDeploymentRequest, logr *logger.LocLoggingEntry) error {
+	job := e.job(req.TrainingId, req.Name)
+	numLearners := int(req.GetResources().Learners)
+	if numLearners < 1 {
+		numLearners = 1
+	}
+
+	for learnerID := 1; learnerID <= numLearners; learnerID++ {
+		dir := e.learnerDir(req.TrainingId, learnerID)
+		if err := os.MkdirAll(filepath.Join(dir, "logs"), 0755); err != nil {
+			logr.WithError(err).Errorf("Failed to create the directory of learner %d", learnerID)
+			return err
+		}
+	}
+	for learnerID := 1; learnerID <= numLearners; learnerID++ {
+		job.running.Add(1)
+		go func(learnerID int) {
+			defer job.running.Done()
+			e.runLearner(job, req, learnerID, numLearners, logr.WithField("learner_id", learnerID))
+		}(learnerID)
+	}
+	return nil
+}
+
+// runLearner takes the steps of a learner pod and records the status of the learner, DOWNLOADING while the model and
+// the training data are loaded, PROCESSING while the training command runs and STORING while the results and logs are
+// stored, then COMPLETED, FAILED or HALTED. Failures have the error codes the controller of a learner pod records.
+func (e *localExecutor) runLearner(job *localJob, req *service.JobDeploymentRequest, learnerID int, numLearners int, logr *logger.LocLoggingEntry) {
+	dir := e.learnerDir(req.TrainingId, learnerID)
+	jobEnvVars := extractEnvVarsFromDeploymentRequest(req)
+	mount := v1core.VolumeMount{Name: "jobdata", MountPath: dir}
+
+	record := func(status grpc_trainer_v2.Status, errorCode string, message string) {
+		e.recordStatus(job, req.TrainingId, learnerID, status.String(), errorCode, message, logr)
+	}
+	run := func(ctx context.Context, name string, command string, vars []v1core.EnvVar, logFile string) int {
+		code, err := runProcess(ctx, dir, command, localEnv(vars), logFile)
+		if err != nil {
+			logr.WithError(err).Errorf("Failed to run %s", name)
+		}
+		logr.Infof("%s exited with %d", name, code)
+		return code
+	}
+	helperLog := func(name string) string {
+		return filepath.Join(dir, name+".log")
+	}
+
+	record(grpc_trainer_v2.Status_DOWNLOADING, "", "")
+	var loadModelCode, loadDataCode int
+	var loading sync.WaitGroup
+	loading.Add(2)
+	go func() {
+		defer loading.Done()
+		loadModelCode = run(job.ctx, loadModelContainerName, e.loadModelCommand,
+			constructLoadModelContainer(mount, jobEnvVars).Env, helperLog(loadModelContainerName))
+	}()
+	go func() {
+		defer loading.Done()
+		loadDataCode = run(job.ctx, loadDataContainerName, loadTrainingDataCommand(e.loadDataCommand, dir, jobEnvVars),
+			constructLoadTrainingDataContainer(mount, jobEnvVars).Env, helperLog(loadDataContainerName))
+	}()
+	loading.Wait()
+	if job.ctx.Err() != nil {
+		return
+	}
+	switch {
+	case loadModelCode != 0:
+		record(grpc_trainer_v2.Status_FAILED, client.ErrCodeFailLoadModel, strconv.Itoa(loadModelCode))
+		return
+	case loadDataCode != 0:
+		record(grpc_trainer_v2.Status_FAILED, client.ErrCodeFailLoadData, strconv.Itoa(loadDataCode))
+		return
+	case job.isHalted():
+		record(grpc_trainer_v2.Status_HALTED, "", "")
+		return
+	}
+
+	record(grpc_trainer_v2.Status_PROCESSING, "", "")
+	learnerCtx, stopLearner := context.WithCancel(job.ctx)
+	go func() {
+		select {
+		case <-job.halted:
+			stopLearner()
+		case <-learnerCtx.Done():
+		}
+	}()
+	trainingLog := filepath.Join(dir, "logs", "training-log.txt")
+	os.Symlink(trainingLog, filepath.Join(dir, "latest-log"))
+	learnerCode := run(learnerCtx, learnerContainerName, localLearnerCommand,
+		e.learnerEnvVars(req, dir, learnerID, numLearners), trainingLog)
+	stopLearner()
+	if job.ctx.Err() != nil {
+		return
+	}
+	halted := learnerCode != 0 && job.isHalted()
+	if !halted {
+		record(grpc_trainer_v2.Status_STORING, "", "")
+	}
+
+	storeResultsCode := run(job.ctx, storeResultsContainerName, storeResultsCommand(e.storeCommand, dir, jobEnvVars),
+		constructStoreResultsContainer(mount, jobEnvVars).Env, helperLog(storeResultsContainerName))
+	storeLogsCode := run(job.ctx, storeLogsContainerName, e.storeCommand,
+		constructStoreLogsContainer(mount, jobEnvVars).Env, helperLog(storeLogsContainerName))
+	stored := storeResultsCode == 0 && storeLogsCode == 0
+	switch {
+	case halted && stored:
+		record(grpc_trainer_v2.Status_HALTED, "", "")
+	case halted:
+		record(grpc_trainer_v2.Status_FAILED, client.ErrCodeFailStoreResultsOnHalt, strconv.Itoa(storeResultsCode))
+	case learnerCode != 0:
+		record(grpc_trainer_v2.Status_FAILED, client.ErrLearnerProcessCrash, strconv.Itoa(learnerCode))
+	case stored:
+		record(grpc_trainer_v2.Status_COMPLETED, "", "")
+	default:
+		record(grpc_trainer_v2.Status_FAILED, client.ErrCodeFailStoreResults, strconv.Itoa(storeResultsCode))
+	}
+}
+
+// learnerEnvVars returns the variables of the learner container, with the directories below the job directory of the
+// pod moved to the scratch directory of the learner
+func (e *localExecutor) learnerEnvVars(req *service.JobDeploymentRequest, dir string, learnerID int, numLearners int) []v1core.EnvVar {
+	learnerName := fmt.Sprintf("learner-%s", req.Name)
+	vars := envVarsForDeployingLearner(extractEnvVarsFromDeploymentRequest(req), req.TrainingId, numLearners, learnerName, false, false)
+	vars = append(vars, learner.UserEnvVars(req.LearnerEnvVars, nil)...)
+	// the learner container derives its id from the ordinal of its pod
+	vars = append(vars,
+		v1core.EnvVar{Name: "LEARNER_ID", Value: strconv.Itoa(learnerID)},
+		v1core.EnvVar{Name: "DOWNWARD_API_POD_NAME", Value: fmt.Sprintf("%s-%d", learnerName, learnerID-1)})
+	for i := range vars {
+		if v := vars[i].Value; v == PodLevelJobDir || strings.HasPrefix(v, PodLevelJobDir+"/") {
+			vars[i].Value = dir + strings.TrimPrefix(v, PodLevelJobDir)
+		}
+	}
+	return vars
+}
+
+// recordStatus adds a status update to the status sequence of a learner in etcd, unless the job was killed
+func (e *localExecutor) recordStatus(job *localJob, trainingID string, learnerID int, status string, errorCode string, message string, logr *logger.LocLoggingEntry) {
+	if job.ctx.Err() != nil {
+		return
+	}
+	now := time.Now()
+	value, _ := json.Marshal(localLearnerStatus{
+		Timestamp:     strconv.FormatInt(now.UnixNano()/int64(time.Millisecond), 10),
+		Status:        status,
+		ErrorCode:     errorCode,
+		StatusMessage: message,
+	})
+	path := fmt.Sprintf("%s/%d", learnerStatusSequencePath(trainingID, learnerID), now.UnixNano())
+	logr.Infof("Recording status %s of learner %d", status, learnerID)
+	if _, err := e.etcdClient.PutIfKeyMissing(path, string(value), logr); err != nil {
+		logr.WithError(err).Errorf("Failed to record status %s of learner %d", status, learnerID)
+	}
+}
+
+func (e *localExecutor) halt(req *service.JobHaltRequest, logr *logger.LocLoggingEntry) error {
+	if err := putHaltNode(e.etcdClient, req.TrainingId, logr); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	job := e.jobs[req.TrainingId]
+	e.mu.Unlock()
+	if job != nil {
+		job.haltOnce.Do(func() { close(job.halted) })
+	}
+	return nil
+}
+
+func (e *localExecutor) kill(req *service.JobKillRequest, counter metrics.Counter, logr *logger.LocLoggingEntry) error {
+	e.mu.Lock()
+	job := e.jobs[req.TrainingId]
+	if job != nil && req.Name != "" && job.name != req.Name {
+		// the processes belong to a newer deployment of the training
+		e.mu.Unlock()
+		logr.Debugf("Training job %s is not running as %s, nothing to kill", req.TrainingId, req.Name)
+		return nil
+	}
+	delete(e.jobs, req.TrainingId)
+	e.mu.Unlock()
+
+	if job != nil {
+		logr.Infof("Killing the processes of training job %s", req.TrainingId)
+		job.cancel()
+		stopped := make(chan struct{})
+		go func() {
+			job.running.Wait()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(localKillTimeout):
+			logr.Warnf("The processes of training job %s did not exit within %s", req.TrainingId, localKillTimeout)
+			return fmt.Errorf("the processes of training job %s did not exit within %s", req.TrainingId, localKillTimeout)
+		}
+	}
+	counter.With(progress, deploymentsDeletedPhaseComplete).Add(1)
+
+	if err := os.RemoveAll(e.trainingDir(req.TrainingId)); err != nil {
+		logr.WithError(err).Errorf("Deleting the directory of training job %s failed", req.TrainingId)
+	}
+	counter.With(progress, pvsDeletedPhaseComplete).Add(1)
+	return nil
+}
+
+// localEnv returns the environment of a learner or helper process: the environment of the LCM without the variables
+// the learner container unsets, and the variables of the container that have a value
+func localEnv(vars []v1core.EnvVar) []string {
+	var env []string
+	for _, kv := range os.Environ() {
+		unset := false
+		for _, prefix := range localUnsetEnvVarPrefixes {
+			if strings.HasPrefix(kv, prefix) {
+				unset = true
+				break
+			}
+		}
+		if !unset {
+			env = append(env, kv)
+		}
+	}
+	for _, ev := range vars {
+		if ev.ValueFrom == nil {
+			env = append(env, ev.Name+"="+ev.Value)
+		}
+	}
+	return env
+}
+
+// runProcess runs a shell command in dir and returns its exit code, with its output appended to logFile. The command
+// and the processes it starts are killed when ctx is done.
+func runProcess(ctx context.Context, dir string, command string, env []string, logFile string) (int, error) {
+	out, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return -1, err
+	}
+	defer out.Close()
+
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+	// in a process group of its own, which is killed as a whole
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return -1, err
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		case <-exited:
+		}
+	}()
+	err = cmd.Wait()
+	close(exited)
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return exitErr.Sys().(syscall.WaitStatus).ExitStatus(), nil
+	}
+	if err != nil {
+		return -1, err
+	}
+	return 0, nil
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/lcm/coord"
+	"github.com/IBM/FfDL/lcm/service/lcm/learner"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/stretchr/testify/assert"
+)
+
+// memCoordinator keeps the etcd nodes the local executor writes in memory
+type memCoordinator struct {
+	coord.Coordinator
+	mu    sync.Mutex
+	nodes map[string]string
+}
+
+func (c *memCoordinator) PutIfKeyMissing(path string, value string, log *logger.LocLoggingEntry, opts ...clientv3.OpOption) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.nodes[path]; ok {
+		return false, nil
+	}
+	c.nodes[path] = value
+	return true, nil
+}
+
+// Get returns the nodes below path sorted by key, as if it was called with clientv3.WithPrefix()
+func (c *memCoordinator) Get(path string, log *logger.LocLoggingEntry, opts ...clientv3.OpOption) ([]coord.EtcdKVGetResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var keys []string
+	for key := range c.nodes {
+		if strings.HasPrefix(key, path) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var values []coord.EtcdKVGetResponse
+	for _, key := range keys {
+		values = append(values, coord.EtcdKVGetResponse{Key: key, Value: c.nodes[key]})
+	}
+	return values, nil
+}
+
+// DeleteKeyWithOpts deletes the nodes below path, as if it was called with clientv3.WithPrefix()
+func (c *memCoordinator) DeleteKeyWithOpts(path string, log *logger.LocLoggingEntry, opts ...clientv3.OpOption) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.nodes {
+		if strings.HasPrefix(key, path) {
+			delete(c.nodes, key)
+		}
+	}
+	return nil
+}
+
+// statuses returns the status sequence of a learner
+func (c *memCoordinator) statuses(trainingID string, learnerID int) []localLearnerStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	prefix := learnerStatusSequencePath(trainingID, learnerID) + "/"
+	var keys []string
+	for key := range c.nodes {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var statuses []localLearnerStatus
+	for _, key := range keys {
+		var status localLearnerStatus
+		json.Unmarshal([]byte(c.nodes[key]), &status)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// waitForStatus waits until the last status of a learner is status
+func (c *memCoordinator) waitForStatus(t *testing.T, trainingID string, learnerID int, status string) []localLearnerStatus {
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		statuses := c.statuses(trainingID, learnerID)
+		if len(statuses) > 0 && statuses[len(statuses)-1].Status == status {
+			return statuses
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("learner %d did not reach status %s: %v", learnerID, status, statuses)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func statusNames(statuses []localLearnerStatus) []string {
+	var names []string
+	for _, s := range statuses {
+		names = append(names, s.Status)
+	}
+	return names
+}
+
+func newTestLocalExecutor(t *testing.T) (*localExecutor, *memCoordinator, func()) {
+	dir, err := ioutil.TempDir("", "local-executor-test-")
+	assert.NoError(t, err)
+	etcd := &memCoordinator{nodes: make(map[string]string)}
+	e := &localExecutor{
+		etcdClient:       etcd,
+		dir:              dir,
+		loadModelCommand: `mkdir -p "$DATA_DIR" && echo "print('hello')" > "$DATA_DIR/train.py"`,
+		loadDataCommand:  `mkdir -p "$DATA_DIR"`,
+		storeCommand:     `cp -r "$DATA_DIR" "$DATA_DIR.stored"`,
+	}
+	return e, etcd, func() { os.RemoveAll(dir) }
+}
+
+func localDeploymentRequest(trainingID string, learners int32, command string) *service.JobDeploymentRequest {
+	return &service.JobDeploymentRequest{
+		Name:       "job-" + trainingID,
+		TrainingId: trainingID,
+		UserId:     "user-1",
+		Resources:  &service.ResourceRequirements{Learners: learners},
+		EnvVars: map[string]string{
+			"MODEL_DIR":        "/model-code",
+			"DATA_DIR":         "data",
+			"RESULT_DIR":       "results",
+			"TRAINING_COMMAND": command,
+		},
+		LearnerEnvVars: map[string]string{"EPOCHS": "3"},
+	}
+}
+
+func TestLocalExecutorRunsLearners(t *testing.T) {
+	e, etcd, cleanup := newTestLocalExecutor(t)
+	defer cleanup()
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+
+	req := localDeploymentRequest("training-1", 2,
+		`test -f train.py && echo "$LEARNER_ID/$NUM_LEARNERS $EPOCHS" > "$RESULT_DIR/out.txt" && test -z "$DLAAS_ETCD_ADDRESS"`)
+	os.Setenv("DLAAS_ETCD_ADDRESS", "http://etcd:2379")
+	defer os.Unsetenv("DLAAS_ETCD_ADDRESS")
+	e.jobMonitorCommand = `echo "$TRAINING_ID $NUM_LEARNERS $DLAAS_ETCD_ADDRESS" > jobmonitor.out; sleep 60`
+	assert.NoError(t, e.deployJobMonitor(req, 2, false, logr))
+	assert.NoError(t, e.deployLearners(nil, req, logr))
+
+	for learnerID, out := range map[int]string{1: "1/2 3\n", 2: "2/2 3\n"} {
+		statuses := etcd.waitForStatus(t, "training-1", learnerID, "COMPLETED")
+		assert.Equal(t, []string{"DOWNLOADING", "PROCESSING", "STORING", "COMPLETED"}, statusNames(statuses))
+		// the results are stored by the store-results step
+		content, err := ioutil.ReadFile(filepath.Join(e.learnerDir("training-1", learnerID), "results.stored", "out.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, out, string(content))
+	}
+
+	// the job monitor sees the etcd settings of the LCM
+	content, err := ioutil.ReadFile(filepath.Join(e.trainingDir("training-1"), "jobmonitor.out"))
+	assert.NoError(t, err)
+	assert.Equal(t, "training-1 2 http://etcd:2379\n", string(content))
+
+	e.kill(&service.JobKillRequest{TrainingId: "training-1"}, discard.NewCounter(), logr)
+	_, err = os.Stat(e.trainingDir("training-1"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLocalExecutorFailures(t *testing.T) {
+	e, etcd, cleanup := newTestLocalExecutor(t)
+	defer cleanup()
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+
+	assert.NoError(t, e.deployLearners(nil, localDeploymentRequest("training-1", 1, "exit 3"), logr))
+	statuses := etcd.waitForStatus(t, "training-1", 1, "FAILED")
+	assert.Equal(t, []string{"DOWNLOADING", "PROCESSING", "STORING", "FAILED"}, statusNames(statuses))
+	assert.Equal(t, "C201", statuses[3].ErrorCode)
+	assert.Equal(t, "3", statuses[3].StatusMessage)
+
+	e.loadModelCommand = "exit 2"
+	assert.NoError(t, e.deployLearners(nil, localDeploymentRequest("training-2", 1, "true"), logr))
+	statuses = etcd.waitForStatus(t, "training-2", 1, "FAILED")
+	assert.Equal(t, []string{"DOWNLOADING", "FAILED"}, statusNames(statuses))
+	assert.Equal(t, "S301", statuses[1].ErrorCode)
+
+	err := e.checkUserSecrets(&service.JobDeploymentRequest{
+		LearnerSecretEnvVars: map[string]*service.SecretKeyRef{"TOKEN": {Secret: "s", Key: "k"}},
+	})
+	assert.IsType(t, &learner.UserSecretError{}, err)
+}
+
+func TestLocalExecutorHaltAndKill(t *testing.T) {
+	e, etcd, cleanup := newTestLocalExecutor(t)
+	defer cleanup()
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+
+	assert.NoError(t, e.deployLearners(nil, localDeploymentRequest("training-1", 1, "sleep 60"), logr))
+	etcd.waitForStatus(t, "training-1", 1, "PROCESSING")
+	assert.NoError(t, e.halt(&service.JobHaltRequest{TrainingId: "training-1"}, logr))
+	statuses := etcd.waitForStatus(t, "training-1", 1, "HALTED")
+	assert.Equal(t, []string{"DOWNLOADING", "PROCESSING", "HALTED"}, statusNames(statuses))
+	halted, _ := etcd.PutIfKeyMissing("training-1/halt", "", logr)
+	assert.False(t, halted)
+
+	assert.NoError(t, e.deployLearners(nil, localDeploymentRequest("training-2", 1, "sleep 60"), logr))
+	etcd.waitForStatus(t, "training-2", 1, "PROCESSING")
+	// a kill of an earlier deployment of the training leaves the processes alone
+	assert.NoError(t, e.kill(&service.JobKillRequest{Name: "job-old", TrainingId: "training-2"}, discard.NewCounter(), logr))
+	assert.NotNil(t, e.jobs["training-2"])
+	start := time.Now()
+	e.kill(&service.JobKillRequest{TrainingId: "training-2"}, discard.NewCounter(), logr)
+	assert.True(t, time.Since(start) < 10*time.Second)
+	// nothing is recorded after the job was killed
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, etcd.statuses("training-2", 1), 2)
+}
@@ -38,14 +38,15 @@ func (t nonSplitTraining) Start() error {
 	learnerContainer := constructLearnerContainer(t.req, learnerDefn.envVars, learnerDefn.volumeMounts, helperDefn.sharedVolumeMount, learnerDefn.mountTrainingDataStoreInLearner, learnerDefn.mountResultsStoreInLearner, t.logr)
 	helperContainers = append(helperContainers, learnerContainer)
 
-	imagePullSecret, err := learner.GenerateImagePullSecret(t.k8sClient, t.req)
+	imagePullSecret, err := learner.GenerateImagePullSecret(t.k8sClient, t.req, t.owners)
 	if err != nil {
 		t.logr.WithError(err).Errorf("Could not create pull secret for %s", t.learner.name)
 		return err
 	}
 
 	//create pod, service, statefuleset spec
-	nonSplitLearnerPodSpec := learner.CreatePodSpec(helperContainers, helperAndLearnerVolumes, map[string]string{"training_id": t.req.TrainingId, "user_id": t.req.UserId}, gpus, imagePullSecret)
+	nonSplitLearnerPodSpec := learner.CreatePodSpec(helperContainers, helperAndLearnerVolumes, learnerPodLabels(t.req), gpus, imagePullSecret)
+	learner.SetSchedulingConstraints(&nonSplitLearnerPodSpec, t.req)
 	serviceSpec := learner.CreateServiceSpec(learnerDefn.name, t.req.TrainingId)
 	statefulSetSpec := learner.CreateStatefulSetSpecForLearner(learnerDefn.name, serviceSpec.Name, learnerDefn.numberOfLearners, nonSplitLearnerPodSpec)
 
@@ -65,6 +66,12 @@ func (t nonSplitTraining) CreateFromBOM(bom *nonSplitTrainingBOM) error {
 	logr := t.logr
 	namespace := config.GetLearnerNamespace()
 
+	for _, secret := range bom.secrets {
+		secret.OwnerReferences = t.owners
+	}
+	bom.service.OwnerReferences = t.owners
+	bom.learnerBOM.OwnerReferences = t.owners
+
 	for _, secret := range bom.secrets {
 		//create the secrets
 		if _, err := t.k8sClient.CoreV1().Secrets(namespace).Create(secret); err != nil {
@@ -0,0 +1,336 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/lcm/trainingjob"
+	"github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+
+	"github.com/coreos/etcd/clientv3"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// reconcilerIntervalKey is the number of seconds between two runs of the reconciler, which also runs at startup
+	reconcilerIntervalKey = "lcm.reconciler.interval"
+	// reconcilerThresholdKey is the number of seconds a training job has to be orphaned or stuck before the
+	// reconciler cleans it up
+	reconcilerThresholdKey = "lcm.reconciler.threshold"
+	// reconcilerRedeployKey selects the error code of stuck training jobs: S105, which the trainer retries when
+	// retries are enabled with retry.attempts.default or the retry policy of the job, or S101
+	reconcilerRedeployKey = "lcm.reconciler.redeploy"
+)
+
+// the statuses of the trainer for which a training job is deployed
+var deployedStatuses = map[grpc_trainer_v2.Status]bool{
+	grpc_trainer_v2.Status_PENDING:     true,
+	grpc_trainer_v2.Status_DEPLOY:      true,
+	grpc_trainer_v2.Status_DOWNLOADING: true,
+	grpc_trainer_v2.Status_PROCESSING:  true,
+	grpc_trainer_v2.Status_STORING:     true,
+}
+
+// deployedTraining is what the LCM finds of a training job in the learner namespace and in etcd
+type deployedTraining struct {
+	trainingID string
+	jobName    string
+	userID     string
+	// the Kubernetes objects labelled with the training id, as kind/name
+	objects []string
+	// the creation time of the oldest object
+	created time.Time
+	// whether the stateful set of the learners exists
+	learners bool
+	// whether the training has nodes in etcd
+	etcd bool
+}
+
+// reconciler cleans up training jobs that the LCM lost track of, for example because it restarted while deploying or
+// killing them. Training jobs the trainer does not know or no longer considers deployed are orphans and are killed.
+// Training jobs whose deployment stopped before the learners were created are stuck; they are killed and failed.
+type reconciler struct {
+	s         *lcmService
+	tjClient  trainingjob.Interface
+	threshold time.Duration
+	redeploy  bool
+	// trainingStatus returns the status of a training job in the trainer, found is false if the trainer does not
+	// know it
+	trainingStatus func(trainingID, userID string, logr *logger.LocLoggingEntry) (status grpc_trainer_v2.Status, found bool, err error)
+	// updateStatus reports the status of a training job to the trainer
+	updateStatus func(trainingID string, status grpc_trainer_v2.Status, userID string, statusMessage string, errorCode string, logr *logger.LocLoggingEntry) error
+	// suspects are the training jobs that were found orphaned or stuck and only exist in etcd, with the time they
+	// were first found
+	suspects map[string]time.Time
+	stop     chan struct{}
+}
+
+func newReconciler(s *lcmService, tjClient trainingjob.Interface, threshold time.Duration, redeploy bool) *reconciler {
+	return &reconciler{
+		s:              s,
+		tjClient:       tjClient,
+		threshold:      threshold,
+		redeploy:       redeploy,
+		trainingStatus: trainerTrainingStatus,
+		updateStatus:   updateJobStatus,
+		suspects:       make(map[string]time.Time),
+		stop:           make(chan struct{}),
+	}
+}
+
+// run reconciles the training jobs right away and then every interval, until the reconciler is stopped
+func (r *reconciler) run(interval time.Duration) {
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	logr.Infof("Cleaning up orphaned and stuck training jobs every %v, after %v", interval, r.threshold)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		r.reconcile(logr)
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reconcile checks all training jobs deployed in the learner namespace or present in etcd
+func (r *reconciler) reconcile(logr *logger.LocLoggingEntry) {
+	trainings, err := r.deployedTrainings(logr)
+	if err != nil {
+		// with a partial picture, trainings could look stuck that are not
+		logr.WithError(err).Warnf("Failed to find the deployed training jobs, skipping the reconciliation")
+		return
+	}
+	for trainingID := range r.suspects {
+		if _, ok := trainings[trainingID]; !ok {
+			delete(r.suspects, trainingID)
+		}
+	}
+	ids := make([]string, 0, len(trainings))
+	for trainingID := range trainings {
+		ids = append(ids, trainingID)
+	}
+	sort.Strings(ids)
+	for _, trainingID := range ids {
+		r.check(trainings[trainingID], time.Now(), logr)
+	}
+}
+
+// check kills a training job if it has been orphaned or stuck for longer than the threshold, and fails it if it is
+// stuck
+func (r *reconciler) check(t *deployedTraining, now time.Time, logr *logger.LocLoggingEntry) {
+	logr = logr.WithField(logger.LogkeyTrainingID, t.trainingID)
+
+	status, found, err := r.trainingStatus(t.trainingID, t.userID, logr)
+	if err != nil {
+		reconcilerCounter.With(action, statusUnknown).Add(1)
+		logr.WithError(err).Warnf("Failed to get the status of training job %s from the trainer", t.trainingID)
+		return
+	}
+	var problem string
+	stuck := false
+	switch {
+	case !found:
+		problem = "unknown to the trainer"
+	case !deployedStatuses[status]:
+		problem = fmt.Sprintf("%s in the trainer", status)
+	case (status == grpc_trainer_v2.Status_PENDING || status == grpc_trainer_v2.Status_DEPLOY) && !t.learners:
+		problem = fmt.Sprintf("%s in the trainer without learners", status)
+		stuck = true
+	default:
+		delete(r.suspects, t.trainingID)
+		return
+	}
+
+	since := t.created
+	if len(t.objects) == 0 {
+		// nodes in etcd have no creation time, so the training is given the threshold from when it was first found
+		if _, ok := r.suspects[t.trainingID]; !ok {
+			r.suspects[t.trainingID] = now
+		}
+		since = r.suspects[t.trainingID]
+	}
+	if now.Sub(since) < r.threshold {
+		logr.Debugf("Training job %s is %s, waiting for the threshold", t.trainingID, problem)
+		return
+	}
+	delete(r.suspects, t.trainingID)
+
+	logr.Infof("Training job %s is %s, deleting its objects %v and etcd nodes", t.trainingID, problem, t.objects)
+	req := &service.JobKillRequest{Name: t.jobName, TrainingId: t.trainingID, UserId: t.userID}
+	if _, err := r.s.KillTrainingJob(context.Background(), req); err != nil {
+		logr.WithError(err).Errorf("Failed to delete training job %s", t.trainingID)
+		return
+	}
+	if !stuck {
+		reconcilerCounter.With(action, orphanDeleted).Add(1)
+		return
+	}
+
+	errorCode, counted := client.ErrCodeFailedDeploy, stuckFailed
+	if r.redeploy {
+		errorCode, counted = client.ErrCodeDeployInterrupted, stuckRedeployed
+	}
+	msg := fmt.Sprintf("the deployment of the training job did not complete within %v", r.threshold)
+	if err := r.updateStatus(t.trainingID, grpc_trainer_v2.Status_FAILED, t.userID, msg, errorCode, logr); err != nil {
+		logr.WithError(err).Errorf("Failed to report stuck training job %s as failed", t.trainingID)
+		return
+	}
+	reconcilerCounter.With(action, counted).Add(1)
+}
+
+// deployedTrainings returns the training jobs with objects in the learner namespace or nodes in etcd, by training id
+func (r *reconciler) deployedTrainings(logr *logger.LocLoggingEntry) (map[string]*deployedTraining, error) {
+	namespace := config.GetLearnerNamespace()
+	selector := metav1.ListOptions{LabelSelector: "training_id"}
+	trainings := make(map[string]*deployedTraining)
+	add := func(kind string, meta metav1.ObjectMeta) *deployedTraining {
+		trainingID := meta.Labels["training_id"]
+		t, ok := trainings[trainingID]
+		if !ok {
+			t = &deployedTraining{trainingID: trainingID}
+			trainings[trainingID] = t
+		}
+		t.objects = append(t.objects, kind+"/"+meta.Name)
+		if t.created.IsZero() || meta.CreationTimestamp.Time.Before(t.created) {
+			t.created = meta.CreationTimestamp.Time
+		}
+		if t.userID == "" {
+			t.userID = meta.Labels["user_id"]
+		}
+		return t
+	}
+
+	services, err := r.s.k8sClient.CoreV1().Services(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range services.Items {
+		add("service", svc.ObjectMeta)
+	}
+	sets, err := r.s.k8sClient.AppsV1beta1().StatefulSets(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, set := range sets.Items {
+		add("statefulset", set.ObjectMeta).learners = true
+	}
+	deployments, err := r.s.k8sClient.AppsV1beta1().Deployments(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		add("deployment", deployment.ObjectMeta)
+	}
+	claims, err := r.s.k8sClient.CoreV1().PersistentVolumeClaims(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range claims.Items {
+		add("persistentvolumeclaim", claim.ObjectMeta)
+	}
+	secrets, err := r.s.k8sClient.CoreV1().Secrets(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets.Items {
+		add("secret", secret.ObjectMeta)
+	}
+	if r.tjClient != nil {
+		tjs, err := r.tjClient.TrainingJobs(namespace).List(selector)
+		if err != nil {
+			// without the custom resource definition there are no TrainingJobs
+			logr.WithError(err).Debugf("Failed to list the training job resources")
+		} else {
+			for _, tj := range tjs.Items {
+				add(strings.ToLower(trainingjob.Kind), tj.ObjectMeta).jobName = tj.Name
+			}
+		}
+	}
+
+	// every training job in etcd has a user id node below its training id
+	keys, err := r.s.etcdClient.Get("", logr, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range keys {
+		parts := strings.Split(kv.Key, "/")
+		if len(parts) != 2 || parts[1] != zkUserID {
+			continue
+		}
+		t, ok := trainings[parts[0]]
+		if !ok {
+			t = &deployedTraining{trainingID: parts[0]}
+			trainings[parts[0]] = t
+		}
+		t.etcd = true
+	}
+
+	for _, t := range trainings {
+		if t.etcd && (t.userID == "" || t.jobName == "") {
+			if t.userID == "" {
+				t.userID = r.etcdValue(t.trainingID+"/"+zkUserID, logr)
+			}
+			if t.jobName == "" {
+				t.jobName = r.etcdValue(t.trainingID+"/"+zkJobName, logr)
+			}
+		}
+	}
+	return trainings, nil
+}
+
+// etcdValue returns the value of an etcd node, or an empty string if it cannot be read
+func (r *reconciler) etcdValue(path string, logr *logger.LocLoggingEntry) string {
+	values, err := r.s.etcdClient.Get(path, logr)
+	if err != nil || len(values) == 0 {
+		return ""
+	}
+	return values[0].Value
+}
+
+// trainerTrainingStatus asks the trainer for the status of a training job
+func trainerTrainingStatus(trainingID, userID string, logr *logger.LocLoggingEntry) (grpc_trainer_v2.Status, bool, error) {
+	trainer, err := client.NewTrainer()
+	if err != nil {
+		return grpc_trainer_v2.Status_NOT_STARTED, false, err
+	}
+	defer trainer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := trainer.Client().GetTrainingStatusID(ctx, &grpc_trainer_v2.GetRequest{TrainingId: trainingID, UserId: userID})
+	if grpc.Code(err) == codes.NotFound {
+		return grpc_trainer_v2.Status_NOT_STARTED, false, nil
+	}
+	if err != nil {
+		return grpc_trainer_v2.Status_NOT_STARTED, false, err
+	}
+	return resp.Status, true, nil
+}
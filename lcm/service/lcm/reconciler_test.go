@@ -0,0 +1,203 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	tjfake "github.com/IBM/FfDL/lcm/trainingjob/fake"
+	"github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/apps/v1beta1"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// labelCounter counts by label values
+type labelCounter struct {
+	counts map[string]float64
+	lvs    []string
+}
+
+func (c *labelCounter) With(labelValues ...string) metrics.Counter {
+	return &labelCounter{counts: c.counts, lvs: append(append([]string{}, c.lvs...), labelValues...)}
+}
+
+func (c *labelCounter) Add(delta float64) {
+	c.counts[strings.Join(c.lvs, "=")] += delta
+}
+
+// trainingMeta returns the metadata of an object of a training job created at the given time
+func trainingMeta(name, trainingID string, created time.Time) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              name,
+		Namespace:         config.GetLearnerNamespace(),
+		Labels:            map[string]string{"training_id": trainingID, "user_id": "alice"},
+		CreationTimestamp: metav1.NewTime(created),
+	}
+}
+
+type statusUpdate struct {
+	trainingID string
+	status     grpc_trainer_v2.Status
+	errorCode  string
+}
+
+func TestReconciler(t *testing.T) {
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	namespace := config.GetLearnerNamespace()
+	counts := make(map[string]float64)
+	reconcilerCounter = &labelCounter{counts: counts}
+	finishedTrainingCounter = discard.NewCounter()
+
+	old := time.Now().Add(-2 * time.Hour)
+	k8sClient := fake.NewSimpleClientset(
+		// the trainer considers it completed
+		&v1core.Service{ObjectMeta: trainingMeta("learner-job-done", "training-done", old)},
+		&v1beta1.StatefulSet{ObjectMeta: trainingMeta("learner-job-done", "training-done", old)},
+		// the lcm restarted before it deployed the learners
+		&v1beta1.Deployment{ObjectMeta: trainingMeta(constructJMName("job-stuck"), "training-stuck", old)},
+		// the lcm is still deploying it
+		&v1beta1.Deployment{ObjectMeta: trainingMeta(constructJMName("job-new"), "training-new", time.Now())},
+		&v1beta1.StatefulSet{ObjectMeta: trainingMeta("learner-job-running", "training-running", old)},
+		&v1beta1.StatefulSet{ObjectMeta: trainingMeta("learner-job-unreachable", "training-unreachable", old)},
+	)
+	etcd := &memCoordinator{nodes: map[string]string{
+		"training-done/userid":      "alice",
+		"training-stuck/userid":     "alice",
+		"training-stuck/jobname":    "job-stuck",
+		"training-gone/userid":      "bob",
+		"training-gone/jobname":     "job-gone",
+		"training-gone/learners/x":  "1",
+		"training-running/userid":   "alice",
+		"training-running/jobname":  "job-running",
+		"training-running/notes/id": "1",
+	}}
+	s := &lcmService{
+		k8sClient:  k8sClient,
+		etcdClient: etcd,
+		executor:   &kubernetesExecutor{k8sClient: k8sClient, tjClient: tjfake.NewSimpleClientset(), etcdClient: etcd},
+	}
+	r := newReconciler(s, nil, time.Hour, true)
+	r.trainingStatus = func(trainingID, userID string, logr *logger.LocLoggingEntry) (grpc_trainer_v2.Status, bool, error) {
+		switch trainingID {
+		case "training-done":
+			return grpc_trainer_v2.Status_COMPLETED, true, nil
+		case "training-stuck", "training-new":
+			return grpc_trainer_v2.Status_PENDING, true, nil
+		case "training-running":
+			return grpc_trainer_v2.Status_PROCESSING, true, nil
+		case "training-unreachable":
+			return grpc_trainer_v2.Status_NOT_STARTED, false, errors.New("connection refused")
+		}
+		return grpc_trainer_v2.Status_NOT_STARTED, false, nil
+	}
+	var updates []statusUpdate
+	r.updateStatus = func(trainingID string, status grpc_trainer_v2.Status, userID string, statusMessage string, errorCode string, logr *logger.LocLoggingEntry) error {
+		assert.Equal(t, "alice", userID)
+		updates = append(updates, statusUpdate{trainingID, status, errorCode})
+		return nil
+	}
+
+	trainings, err := r.deployedTrainings(logr)
+	assert.NoError(t, err)
+	assert.Len(t, trainings, 6)
+	assert.Equal(t, "job-gone", trainings["training-gone"].jobName)
+	assert.Equal(t, "bob", trainings["training-gone"].userID)
+	assert.True(t, trainings["training-gone"].etcd)
+	assert.Empty(t, trainings["training-gone"].objects)
+	assert.True(t, trainings["training-done"].learners)
+	assert.False(t, trainings["training-stuck"].learners)
+
+	r.reconcile(logr)
+
+	sets, _ := k8sClient.AppsV1beta1().StatefulSets(namespace).List(metav1.ListOptions{})
+	var setNames []string
+	for _, set := range sets.Items {
+		setNames = append(setNames, set.Name)
+	}
+	sort.Strings(setNames)
+	assert.Equal(t, []string{"learner-job-running", "learner-job-unreachable"}, setNames)
+	services, _ := k8sClient.CoreV1().Services(namespace).List(metav1.ListOptions{})
+	assert.Empty(t, services.Items)
+	deployments, _ := k8sClient.AppsV1beta1().Deployments(namespace).List(metav1.ListOptions{})
+	assert.Len(t, deployments.Items, 1)
+	assert.Equal(t, constructJMName("job-new"), deployments.Items[0].Name)
+
+	assert.Equal(t, []statusUpdate{{"training-stuck", grpc_trainer_v2.Status_FAILED, client.ErrCodeDeployInterrupted}}, updates)
+	assert.Equal(t, float64(1), counts[action+"="+orphanDeleted])
+	assert.Equal(t, float64(1), counts[action+"="+stuckRedeployed])
+	assert.Equal(t, float64(1), counts[action+"="+statusUnknown])
+
+	// only etcd nodes are left of the training unknown to the trainer, it is given the threshold from now
+	values, _ := etcd.Get("", logr)
+	var keys []string
+	for _, kv := range values {
+		keys = append(keys, kv.Key)
+	}
+	assert.Equal(t, []string{"training-gone/jobname", "training-gone/learners/x", "training-gone/userid",
+		"training-running/jobname", "training-running/notes/id", "training-running/userid"}, keys)
+	assert.Contains(t, r.suspects, "training-gone")
+
+	r.suspects["training-gone"] = time.Now().Add(-2 * time.Hour)
+	r.reconcile(logr)
+	values, _ = etcd.Get("training-gone/", logr)
+	assert.Empty(t, values)
+	assert.Empty(t, r.suspects)
+	assert.Equal(t, float64(2), counts[action+"="+orphanDeleted])
+}
+
+func TestReconcilerFailsStuckTrainings(t *testing.T) {
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	reconcilerCounter = discard.NewCounter()
+	finishedTrainingCounter = discard.NewCounter()
+
+	k8sClient := fake.NewSimpleClientset(
+		&v1beta1.Deployment{ObjectMeta: trainingMeta(constructJMName("job-stuck"), "training-stuck", time.Now().Add(-time.Hour))},
+	)
+	etcd := &memCoordinator{nodes: make(map[string]string)}
+	s := &lcmService{
+		k8sClient:  k8sClient,
+		etcdClient: etcd,
+		executor:   &kubernetesExecutor{k8sClient: k8sClient, tjClient: tjfake.NewSimpleClientset(), etcdClient: etcd},
+	}
+	r := newReconciler(s, nil, time.Minute, false)
+	r.trainingStatus = func(trainingID, userID string, logr *logger.LocLoggingEntry) (grpc_trainer_v2.Status, bool, error) {
+		return grpc_trainer_v2.Status_DEPLOY, true, nil
+	}
+	var updates []statusUpdate
+	r.updateStatus = func(trainingID string, status grpc_trainer_v2.Status, userID string, statusMessage string, errorCode string, logr *logger.LocLoggingEntry) error {
+		updates = append(updates, statusUpdate{trainingID, status, errorCode})
+		return nil
+	}
+
+	r.reconcile(logr)
+	assert.Equal(t, []statusUpdate{{"training-stuck", grpc_trainer_v2.Status_FAILED, client.ErrCodeFailedDeploy}}, updates)
+	deployments, _ := k8sClient.AppsV1beta1().Deployments(config.GetLearnerNamespace()).List(metav1.ListOptions{})
+	assert.Empty(t, deployments.Items)
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"regexp"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/lcm/service/lcm/learner"
+	tjfake "github.com/IBM/FfDL/lcm/trainingjob/fake"
+	"github.com/ghodss/yaml"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const redacted = "<redacted>"
+
+// the environment variables whose values are credentials
+var sensitiveEnvVar = regexp.MustCompile(`APIKEY|PASSWORD|SECRET|TOKEN`)
+
+// RenderTrainingJob returns the Kubernetes objects DeployTrainingJob would create for a training. They are created in
+// a fake clientset, nothing is deployed and the trainer is not told about the training.
+func (s *lcmService) RenderTrainingJob(ctx context.Context, req *service.JobDeploymentRequest) (*service.JobRenderResponse, error) {
+	logr := logger.LocLogger(InitLogger(req.TrainingId, req.UserId))
+	logr.Infof("Rendering training job %s", req.TrainingId)
+
+	if err := s.executor.checkUserSecrets(req); err != nil {
+		logr.WithError(err).Errorf("Failed to check the secrets referenced by the training")
+		if _, ok := err.(*learner.UserSecretError); ok {
+			return nil, gerrf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, gerrf(codes.Internal, "Cannot check the secrets referenced by the training: %s", err.Error())
+	}
+
+	objects, err := renderTrainingJob(ctx, fake.NewSimpleClientset(s.executor.renderSeed()...), req, logr)
+	if err != nil {
+		logr.WithError(err).Errorf("Failed to render training job")
+		return nil, gerrf(codes.Internal, "Cannot render training job: %s", err.Error())
+	}
+	return &service.JobRenderResponse{Objects: objects}, nil
+}
+
+// renderTrainingJob deploys a training into k8sClient, which must not be connected to a cluster, and returns the
+// objects that were created
+func renderTrainingJob(ctx context.Context, k8sClient kubernetes.Interface, req *service.JobDeploymentRequest, logr *logger.LocLoggingEntry) ([]*service.RenderedObject, error) {
+	numLearners := int(req.GetResources().Learners)
+	if numLearners < 1 {
+		numLearners = 1
+	}
+	tjClient := tjfake.NewSimpleClientset()
+	executor := &kubernetesExecutor{k8sClient: k8sClient, tjClient: tjClient}
+	if err := executor.deployJobMonitor(req, numLearners, false, logr); err != nil {
+		return nil, err
+	}
+	if err := executor.deployLearners(ctx, req, logr); err != nil {
+		return nil, err
+	}
+
+	namespace := config.GetLearnerNamespace()
+	var objects []*service.RenderedObject
+	add := func(kind, name string, obj interface{}) error {
+		manifest, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, &service.RenderedObject{Kind: kind, Name: name, Manifest: string(manifest)})
+		return nil
+	}
+
+	tjs, err := tjClient.TrainingJobs(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, tj := range tjs.Items {
+		if err := add(tj.Kind, tj.Name, tj); err != nil {
+			return nil, err
+		}
+	}
+
+	claims, err := k8sClient.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, claim := range claims.Items {
+		claim.TypeMeta = metav1.TypeMeta{Kind: "PersistentVolumeClaim", APIVersion: "v1"}
+		if err := add(claim.Kind, claim.Name, claim); err != nil {
+			return nil, err
+		}
+	}
+
+	secrets, err := k8sClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets.Items {
+		secret.TypeMeta = metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"}
+		for k := range secret.Data {
+			secret.Data[k] = []byte(redacted)
+		}
+		for k := range secret.StringData {
+			secret.StringData[k] = redacted
+		}
+		if err := add(secret.Kind, secret.Name, secret); err != nil {
+			return nil, err
+		}
+	}
+
+	services, err := k8sClient.CoreV1().Services(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range services.Items {
+		svc.TypeMeta = metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}
+		if err := add(svc.Kind, svc.Name, svc); err != nil {
+			return nil, err
+		}
+	}
+
+	deployments, err := k8sClient.AppsV1beta1().Deployments(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments.Items {
+		deployment.TypeMeta = metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1beta1"}
+		redactEnvVars(&deployment.Spec.Template.Spec)
+		if err := add(deployment.Kind, deployment.Name, deployment); err != nil {
+			return nil, err
+		}
+	}
+
+	statefulSets, err := k8sClient.AppsV1beta1().StatefulSets(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, statefulSet := range statefulSets.Items {
+		statefulSet.TypeMeta = metav1.TypeMeta{Kind: "StatefulSet", APIVersion: "apps/v1beta1"}
+		redactEnvVars(&statefulSet.Spec.Template.Spec)
+		if err := add(statefulSet.Kind, statefulSet.Name, statefulSet); err != nil {
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
+
+// redactEnvVars hides the credentials the containers of a pod get in their environment
+func redactEnvVars(pod *v1core.PodSpec) {
+	for _, containers := range [][]v1core.Container{pod.InitContainers, pod.Containers} {
+		for i := range containers {
+			for j, ev := range containers[i].Env {
+				if ev.Value != "" && sensitiveEnvVar.MatchString(ev.Name) {
+					containers[i].Env[j].Value = redacted
+				}
+			}
+		}
+	}
+}
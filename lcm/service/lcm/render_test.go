@@ -0,0 +1,230 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRenderTrainingJob(t *testing.T) {
+	// split learning, the helper and the learners share a dynamically provisioned volume
+	viper.Set(config.SharedVolumeStorageClassKey, "standard")
+	defer viper.Set(config.SharedVolumeStorageClassKey, "")
+
+	req := &service.JobDeploymentRequest{
+		Name:       "job-1",
+		TrainingId: "training-1",
+		UserId:     "alice",
+		Framework:  "tensorflow",
+		Version:    "1.5",
+		Resources:  &service.ResourceRequirements{Cpus: 1, Gpus: 1, Memory: 512, MemoryUnit: service.ResourceRequirements_MB, Learners: 1},
+		EnvVars: map[string]string{
+			"DATA_STORE_TYPE":       "mount_cos",
+			"DATA_STORE_USERNAME":   "user",
+			"DATA_STORE_APIKEY":     "data-secret",
+			"DATA_STORE_OBJECTID":   "data-bucket",
+			"DATA_DIR":              "data-bucket",
+			"RESULT_STORE_TYPE":     "mount_cos",
+			"RESULT_STORE_USERNAME": "user",
+			"RESULT_STORE_APIKEY":   "results-secret",
+			"RESULT_DIR":            "results-bucket",
+			"MODEL_STORE_APIKEY":    "model-secret",
+		},
+	}
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	k8sClient := fake.NewSimpleClientset()
+
+	objects, err := renderTrainingJob(context.Background(), k8sClient, req, logr)
+	assert.NoError(t, err)
+
+	kinds := make(map[string]int)
+	for _, obj := range objects {
+		kinds[obj.Kind]++
+		// no credential leaves the LCM
+		for _, secret := range []string{"data-secret", "results-secret", "model-secret"} {
+			assert.NotContains(t, obj.Manifest, secret, obj.Name)
+		}
+		assert.Contains(t, obj.Manifest, "kind: "+obj.Kind)
+	}
+	// the owner, the shared volume, the data and results secrets, the helper and job monitor deployments and the learners
+	assert.Equal(t, 1, kinds["TrainingJob"])
+	assert.Equal(t, 1, kinds["PersistentVolumeClaim"])
+	assert.Equal(t, 2, kinds["Secret"])
+	assert.Equal(t, 2, kinds["Deployment"])
+	assert.Equal(t, 1, kinds["StatefulSet"])
+
+	// the objects were created in the fake clientset only
+	_, err = k8sClient.AppsV1beta1().StatefulSets(config.GetLearnerNamespace()).Get("learner-job-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestRenderTrainingJobUserEnvVars(t *testing.T) {
+	viper.Set(config.SharedVolumeStorageClassKey, "standard")
+	defer viper.Set(config.SharedVolumeStorageClassKey, "")
+
+	req := &service.JobDeploymentRequest{
+		Name:       "job-2",
+		TrainingId: "training-2",
+		UserId:     "alice",
+		Framework:  "tensorflow",
+		Version:    "1.5",
+		Resources:  &service.ResourceRequirements{Cpus: 1, Memory: 512, MemoryUnit: service.ResourceRequirements_MB, Learners: 1},
+		EnvVars: map[string]string{
+			"DATA_STORE_TYPE":   "mount_cos",
+			"DATA_DIR":          "data-bucket",
+			"RESULT_STORE_TYPE": "mount_cos",
+			"RESULT_DIR":        "results-bucket",
+		},
+		LearnerEnvVars: map[string]string{"NCCL_DEBUG": "INFO"},
+		LearnerSecretEnvVars: map[string]*service.SecretKeyRef{
+			"WANDB_API_KEY": {Secret: "wandb", Key: "api_key"},
+		},
+	}
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	k8sClient := fake.NewSimpleClientset()
+
+	_, err := renderTrainingJob(context.Background(), k8sClient, req, logr)
+	assert.NoError(t, err)
+
+	namespace := config.GetLearnerNamespace()
+	statefulSet, err := k8sClient.AppsV1beta1().StatefulSets(namespace).Get("learner-job-2", metav1.GetOptions{})
+	assert.NoError(t, err)
+	env := make(map[string]v1core.EnvVar)
+	for _, ev := range statefulSet.Spec.Template.Spec.Containers[0].Env {
+		env[ev.Name] = ev
+	}
+	assert.Equal(t, "INFO", env["NCCL_DEBUG"].Value)
+	if assert.NotNil(t, env["WANDB_API_KEY"].ValueFrom) {
+		assert.Equal(t, "wandb", env["WANDB_API_KEY"].ValueFrom.SecretKeyRef.Name)
+		assert.Equal(t, "api_key", env["WANDB_API_KEY"].ValueFrom.SecretKeyRef.Key)
+	}
+
+	// the helpers do not get the variables of the user
+	helper, err := k8sClient.AppsV1beta1().Deployments(namespace).Get("lhelper-job-2", metav1.GetOptions{})
+	assert.NoError(t, err)
+	for _, c := range helper.Spec.Template.Spec.Containers {
+		for _, ev := range c.Env {
+			assert.NotContains(t, []string{"NCCL_DEBUG", "WANDB_API_KEY"}, ev.Name, c.Name)
+		}
+	}
+}
+
+func TestRenderTrainingJobScheduling(t *testing.T) {
+	viper.Set(config.SharedVolumeStorageClassKey, "standard")
+	defer viper.Set(config.SharedVolumeStorageClassKey, "")
+
+	req := &service.JobDeploymentRequest{
+		Name:       "job-3",
+		TrainingId: "training-3",
+		UserId:     "alice",
+		Framework:  "tensorflow",
+		Version:    "1.5",
+		Resources: &service.ResourceRequirements{Cpus: 1, Memory: 512, MemoryUnit: service.ResourceRequirements_MB, Learners: 2,
+			Schedpolicy: "spread", Architecture: "amd64", NodeSelector: map[string]string{"pool": "training"},
+			Tolerations: []*service.Toleration{{Key: "preemptible", Operator: "Exists"}}},
+		EnvVars: map[string]string{
+			"DATA_STORE_TYPE":   "mount_cos",
+			"DATA_DIR":          "data-bucket",
+			"RESULT_STORE_TYPE": "mount_cos",
+			"RESULT_DIR":        "results-bucket",
+		},
+	}
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	k8sClient := fake.NewSimpleClientset()
+
+	_, err := renderTrainingJob(context.Background(), k8sClient, req, logr)
+	assert.NoError(t, err)
+
+	statefulSet, err := k8sClient.AppsV1beta1().StatefulSets(config.GetLearnerNamespace()).Get("learner-job-3", metav1.GetOptions{})
+	assert.NoError(t, err)
+	podSpec := statefulSet.Spec.Template.Spec
+	assert.Equal(t, map[string]string{"pool": "training", "kubernetes.io/arch": "amd64"}, podSpec.NodeSelector)
+	assert.Equal(t, "preemptible", podSpec.Tolerations[len(podSpec.Tolerations)-1].Key)
+	if assert.NotNil(t, podSpec.Affinity) {
+		assert.NotNil(t, podSpec.Affinity.PodAntiAffinity)
+	}
+}
+
+func TestRenderTrainingJobPyTorch(t *testing.T) {
+	viper.Set(config.SharedVolumeStorageClassKey, "standard")
+	defer viper.Set(config.SharedVolumeStorageClassKey, "")
+
+	req := &service.JobDeploymentRequest{
+		Name:       "job-4",
+		TrainingId: "training-4",
+		UserId:     "alice",
+		Framework:  "pytorch",
+		Version:    "1.0",
+		Resources:  &service.ResourceRequirements{Cpus: 1, Memory: 512, MemoryUnit: service.ResourceRequirements_MB, Learners: 3},
+		EnvVars: map[string]string{
+			"DATA_STORE_TYPE":   "mount_cos",
+			"DATA_DIR":          "data-bucket",
+			"RESULT_STORE_TYPE": "mount_cos",
+			"RESULT_DIR":        "results-bucket",
+		},
+	}
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	k8sClient := fake.NewSimpleClientset()
+
+	_, err := renderTrainingJob(context.Background(), k8sClient, req, logr)
+	assert.NoError(t, err)
+
+	namespace := config.GetLearnerNamespace()
+	statefulSet, err := k8sClient.AppsV1beta1().StatefulSets(namespace).Get("learner-job-4", metav1.GetOptions{})
+	assert.NoError(t, err)
+	learnerContainer := statefulSet.Spec.Template.Spec.Containers[0]
+	env := make(map[string]string)
+	for _, ev := range learnerContainer.Env {
+		env[ev.Name] = ev.Value
+	}
+	// the first learner is reached through the service governing the stateful set
+	assert.Equal(t, "learner-job-4", statefulSet.Spec.ServiceName)
+	assert.Equal(t, "learner-job-4-0.learner-job-4", env["MASTER_ADDR"])
+	assert.Equal(t, "23456", env["MASTER_PORT"])
+	assert.Equal(t, "3", env["WORLD_SIZE"])
+	assert.Contains(t, learnerContainer.Command[2], "export RANK=")
+
+	svc, err := k8sClient.CoreV1().Services(namespace).Get("learner-job-4", metav1.GetOptions{})
+	assert.NoError(t, err)
+	var ports []int32
+	for _, p := range svc.Spec.Ports {
+		ports = append(ports, p.Port)
+	}
+	assert.Contains(t, ports, int32(23456))
+
+	// other frameworks do their own rendezvous
+	req.Name, req.TrainingId, req.Framework = "job-5", "training-5", "tensorflow"
+	_, err = renderTrainingJob(context.Background(), k8sClient, req, logr)
+	assert.NoError(t, err)
+	statefulSet, err = k8sClient.AppsV1beta1().StatefulSets(namespace).Get("learner-job-5", metav1.GetOptions{})
+	assert.NoError(t, err)
+	learnerContainer = statefulSet.Spec.Template.Spec.Containers[0]
+	for _, ev := range learnerContainer.Env {
+		assert.NotContains(t, []string{"MASTER_ADDR", "MASTER_PORT", "WORLD_SIZE"}, ev.Name)
+	}
+	assert.NotContains(t, learnerContainer.Command[2], "export RANK=")
+}
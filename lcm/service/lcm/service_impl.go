@@ -18,17 +18,23 @@
 package lcm
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/IBM/FfDL/lcm/coord"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	"github.com/IBM/FfDL/commons/config"
 	"github.com/IBM/FfDL/commons/logger"
 	"github.com/IBM/FfDL/commons/metricsmon"
 	"github.com/IBM/FfDL/commons/service"
 	"github.com/IBM/FfDL/lcm/lcmconfig"
+	"github.com/IBM/FfDL/lcm/service/lcm/learner"
+	"github.com/IBM/FfDL/lcm/trainingjob"
 	"github.com/IBM/FfDL/trainer/client"
 	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
 
@@ -39,8 +45,6 @@ import (
 	"github.com/spf13/viper"
 	"golang.org/x/net/context"
 
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -50,9 +54,9 @@ var gerrf = grpc.Errorf
 
 var (
 	//NativeFrameworks which support native distribution
-	NativeFrameworks = []string{"tensorflow", "caffe2", "mxnet", "horovod"}
+	NativeFrameworks = []string{"tensorflow", "caffe2", "mxnet", "horovod", "pytorch"}
 	totalTrainingCounter, finishedTrainingCounter,
-	failedToLaunchTrainingsCounter, k8sFailureCounter metrics.Counter
+	failedToLaunchTrainingsCounter, k8sFailureCounter, reconcilerCounter metrics.Counter
 )
 
 //Service LCM manages the lifecycle of the entire distributed deep learning job
@@ -64,8 +68,12 @@ type Service interface {
 
 type lcmService struct {
 	service.Lifecycle
+	// k8sClient is nil with the local executor
 	k8sClient  kubernetes.Interface
 	etcdClient coord.Coordinator
+	executor   executor
+	controller *trainingJobController
+	reconciler *reconciler
 }
 
 //NewService is a constructor to initialize LCM
@@ -77,6 +85,12 @@ func NewService() (Service, error) {
 func (s *lcmService) StopLCM() {
 	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
 	logr.Debugf(" ###### shutting down lcm ###### ")
+	if s.controller != nil {
+		close(s.controller.stop)
+	}
+	if s.reconciler != nil {
+		close(s.reconciler.stop)
+	}
 	s.etcdClient.Close(logr)
 	s.Stop() // stop Service
 }
@@ -92,22 +106,52 @@ func newService() (*lcmService, error) {
 	finishedTrainingCounter = metricsmon.NewCounter("lcm_trainings_killed", "Metrics for lcm trainings that were killed ", []string{outcome, progress})
 	failedToLaunchTrainingsCounter = metricsmon.NewCounter("lcm_trainings_launch_failed", "Metrics for lcm trainings that failed to launch", []string{reason})
 	k8sFailureCounter = metricsmon.NewCounter("k8s_deploy_failures", "metrics for tracking k8s failures when starting trainings", []string{component})
+	reconcilerCounter = metricsmon.NewCounter("lcm_reconciler_actions_total", "Metrics for orphaned and stuck trainings cleaned up by the lcm", []string{action})
 	lcmRestartCounter := metricsmon.NewCounter("lcm_restart_total", "Metrics for lcm restarts because of failures", []string{reason})
 
 	// assert necessary config keys
 	config.FatalOnAbsentKey(config.ETCDEndpoints)
 
+	config.SetDefault(executorKey, kubernetesExecutorName)
+	config.SetDefault(localDirKey, filepath.Join(os.TempDir(), "ffdl-lcm"))
+	config.SetDefault(localJobMonitorKey, "jobmonitor")
+	config.SetDefault(localLoadModelKey, "loadmodel.sh")
+	config.SetDefault(localLoadDataKey, "load.sh")
+	config.SetDefault(localStoreKey, "store.sh")
+	config.SetDefault(trainingJobResyncKey, 30)
+	config.SetDefault(reconcilerIntervalKey, 300)
+	config.SetDefault(reconcilerThresholdKey, 900)
+	config.SetDefault(reconcilerRedeployKey, true)
+
 	defaultBackoff := backoff.NewExponentialBackOff()
 	defaultBackoff.MaxElapsedTime = 1 * time.Minute
 
-	k8sClient, err := kubernetes.NewForConfig(lcmconfig.GetKubernetesConfig())
-
-	if err != nil {
-		logr.WithError(err).Errorf("Failed to create a kubernetes client: %v", lcmconfig.GetKubernetesConfig())
-		lcmRestartCounter.With(reason, "k8s").Add(1)
+	executorName := viper.GetString(executorKey)
+	if executorName != kubernetesExecutorName && executorName != localExecutorName {
+		err := fmt.Errorf("unknown executor %s, must be %s or %s", executorName, kubernetesExecutorName, localExecutorName)
+		logr.WithError(err).Errorf("Failed to create the executor")
 		return nil, err
 	}
 
+	var k8sClient kubernetes.Interface
+	var tjClient trainingjob.Interface
+	// nothing runs in Kubernetes with the local executor, so there are no clients for the cluster
+	if executorName == kubernetesExecutorName {
+		var err error
+		k8sClient, err = kubernetes.NewForConfig(lcmconfig.GetKubernetesConfig())
+		if err != nil {
+			logr.WithError(err).Errorf("Failed to create a kubernetes client: %v", lcmconfig.GetKubernetesConfig())
+			lcmRestartCounter.With(reason, "k8s").Add(1)
+			return nil, err
+		}
+		tjClient, err = trainingjob.NewForConfig(lcmconfig.GetKubernetesConfig())
+		if err != nil {
+			logr.WithError(err).Errorf("Failed to create a training job client: %v", lcmconfig.GetKubernetesConfig())
+			lcmRestartCounter.With(reason, "k8s").Add(1)
+			return nil, err
+		}
+	}
+
 	client, connectivityErr := coordinator(logr)
 	if connectivityErr != nil {
 		logr.WithError(connectivityErr).Errorln("failed to connect to etcd when starting, this should trigger restart of lcm")
@@ -119,6 +163,17 @@ func newService() (*lcmService, error) {
 		k8sClient:  k8sClient,
 		etcdClient: client,
 	}
+	if executorName == localExecutorName {
+		logr.Infof("Running the learners of training jobs as local processes in %s", viper.GetString(localDirKey))
+		s.executor = newLocalExecutor(client)
+	} else {
+		s.executor = &kubernetesExecutor{k8sClient: k8sClient, tjClient: tjClient, etcdClient: client}
+		s.controller = newTrainingJobController(k8sClient, tjClient, client)
+		go s.controller.run(time.Duration(viper.GetInt(trainingJobResyncKey)) * time.Second)
+		// local processes die with the lcm, so only training jobs in Kubernetes can be orphaned
+		s.reconciler = newReconciler(s, tjClient, time.Duration(viper.GetInt(reconcilerThresholdKey))*time.Second, viper.GetBool(reconcilerRedeployKey))
+		go s.reconciler.run(time.Duration(viper.GetInt(reconcilerIntervalKey)) * time.Second)
+	}
 
 	s.RegisterService = func() {
 		service.RegisterLifecycleManagerServer(s.Server, s)
@@ -156,14 +211,8 @@ func (s *lcmService) HaltTrainingJob(ctx context.Context, req *service.JobHaltRe
 	logr := logger.LocLogger(InitLogger(req.TrainingId, req.UserId))
 	logr.Infof("Halting training job: %s", req.TrainingId)
 
-	path := req.TrainingId + "/halt"
-	success, error := s.etcdClient.PutIfKeyMissing(path, "", logr)
-	if error != nil {
-		logr.WithError(error).Errorf("Failed to update the halt training job status on path %s for training job %s", path, req.TrainingId)
-		return nil, error
-	}
-	if !success {
-		logr.Warnf("While updating halt for training job %s at path %s , the path already exists", req.TrainingId, path)
+	if err := s.executor.halt(req, logr); err != nil {
+		return nil, err
 	}
 	counter.With(progress, "etcdKeysDeleted").Add(1)
 
@@ -182,6 +231,20 @@ func (s *lcmService) deployDistributedTrainingJob(ctx context.Context, req *serv
 
 	logr.WithField("learners", numLearners).Infof("starting deployment of training job in lcm")
 
+	if err := s.executor.checkUserSecrets(req); err != nil {
+		failedToLaunchTrainingsCounter.With(reason, invalidSecretReference).Add(1)
+		logr.WithError(err).Errorf("Failed to check the secrets referenced by the training")
+		if _, ok := err.(*learner.UserSecretError); ok {
+			// nothing was deployed yet
+			if errUpd := updateJobStatus(req.TrainingId, grpc_trainer_v2.Status_FAILED, req.UserId, err.Error(), client.ErrInvalidSecretReference, logr); errUpd != nil {
+				logr.WithError(errUpd).Errorf("after invalid secret reference, error while calling Trainer service client update")
+			}
+		} else {
+			handleDeploymentFailure(s, req.Name, req.TrainingId, req.UserId, "secret check", logr)
+		}
+		return
+	}
+
 	// Initialize distributed training information in Zookeeper
 	if err := createEtcdNodes(s, req.Name, req.UserId, req.TrainingId, numLearners, req.Framework, logr); err != nil {
 		failedToLaunchTrainingsCounter.With(reason, client.ErrCodeEtcdConnection).Add(1)
@@ -191,7 +254,7 @@ func (s *lcmService) deployDistributedTrainingJob(ctx context.Context, req *serv
 	}
 
 	logr.Infof("now starting to deploy job monitor to monitor training job")
-	if err := deployJobMonitor(s, req, req.TrainingId, numLearners, req.Name, req.UserId, useNativeDistribution, logr); err != nil {
+	if err := s.executor.deployJobMonitor(req, numLearners, useNativeDistribution, logr); err != nil {
 		failedToLaunchTrainingsCounter.With(reason, jmLaunchFailed).Add(1)
 		logr.WithError(err).Errorf("Failed to create job monitor for training job")
 		handleDeploymentFailure(s, req.Name, req.TrainingId, req.UserId, "job monitor", logr)
@@ -199,7 +262,7 @@ func (s *lcmService) deployDistributedTrainingJob(ctx context.Context, req *serv
 	}
 
 	logr.Infof("now starting to deploy learners for training job")
-	if err := NewTraining(ctx, s.k8sClient, req, logr).Start(); err != nil {
+	if err := s.executor.deployLearners(ctx, req, logr); err != nil {
 		//Deploying learner helpers has failed. So update status
 		failedToLaunchTrainingsCounter.With(reason, learnerLaunchFailed).Add(1)
 		handleDeploymentFailure(s, req.Name, req.TrainingId, req.UserId, "learner deployment", logr)
@@ -214,87 +277,31 @@ func (s *lcmService) KillTrainingJob(ctx context.Context, req *service.JobKillRe
 	logr := logger.LocLogger(InitLogger(req.TrainingId, req.UserId))
 
 	logr.Infof("Killing training job: %s", req.Name)
-
-	selector := "training_id==" + req.TrainingId
-	backgroundPropagation := metav1.DeletePropagationBackground
-	backgroundDeleteOpts := &metav1.DeleteOptions{
-		PropagationPolicy: &backgroundPropagation,
-	}
-
-	logr.Debugf(" Checking if there are kubernetes services associated with training job %s", req.TrainingId)
-	svcs, err := s.k8sClient.CoreV1().Services(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
-	if err == nil {
-		logr.Debugf(" Services for job with name '%s' found by querying kubernetes.", req.Name)
-		for _, svc := range svcs.Items {
-			logr.Infof(" Deleting service '%s'", svc.ObjectMeta.Name)
-			err := s.k8sClient.CoreV1().Services(config.GetLearnerNamespace()).Delete(svc.ObjectMeta.Name, backgroundDeleteOpts)
-			if err != nil {
-				logr.WithError(err).Errorf(" Deleting kubernetes service '%s' failed", svc.ObjectMeta.Name)
-			}
-		}
+	if err := s.executor.kill(req, counter, logr); err != nil {
+		// the etcd nodes are kept while the learners may still use them, the caller tries again
+		logr.WithError(err).Errorf("Failed to kill training job %s", req.Name)
+		return nil, gerrf(codes.Unavailable, "Training job %s is still being deleted: %s", req.Name, err.Error())
 	}
-	counter.With(progress, servicesDeletedPhaseComplete).Add(1)
-
-	logr.Debugf(" Checking if there are kubernetes statefulsets associated with training job %s", req.TrainingId)
-	sets, err := s.k8sClient.AppsV1beta1().StatefulSets(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
-	if err == nil {
-		logr.Debugf(" Stateful for job with name '%s' found by querying kubernetes.", req.Name)
-		for _, set := range sets.Items {
-			logr.Infof(" Deleting stateful '%s'", set.ObjectMeta.Name)
-			err := s.k8sClient.AppsV1beta1().StatefulSets(config.GetLearnerNamespace()).Delete(set.ObjectMeta.Name, backgroundDeleteOpts)
-			if err != nil {
-				logr.WithError(err).Errorf(" Deleting kubernetes stateful '%s' failed", set.ObjectMeta.Name)
-			}
-		}
-	}
-
-	logr.Debugf(" Checking if there are kubernetes learner persistent volume claims associated with training job %s", req.TrainingId)
-	claims, err := s.k8sClient.CoreV1().PersistentVolumeClaims(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
-	if err == nil {
-		for _, claim := range claims.Items {
-			logr.Infof(" Deleting persistent volume claim '%s'", claim.ObjectMeta.Name)
-			err := s.k8sClient.CoreV1().PersistentVolumeClaims(config.GetLearnerNamespace()).Delete(claim.ObjectMeta.Name, backgroundDeleteOpts)
-			if err != nil {
-				logr.WithError(err).Errorf(" Deleting kubernetes persistent volume '%s' failed", claim.ObjectMeta.Name)
-			}
-		}
-	}
-	counter.With(progress, pvsDeletedPhaseComplete).Add(1)
-
-	logr.Debugf(" Checking if there are kubernetes learner COS mount secrets associated with training job %s", req.TrainingId)
-	secrets, err := s.k8sClient.CoreV1().Secrets(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
-	if err == nil {
-		for _, secret := range secrets.Items {
-			logr.Infof(" Deleting Secret '%s'", secret.ObjectMeta.Name)
-			err := s.k8sClient.CoreV1().Secrets(config.GetLearnerNamespace()).Delete(secret.ObjectMeta.Name, backgroundDeleteOpts)
-			if err != nil {
-				logr.WithError(err).Errorf(" Deleting kubernetes Secret '%s' failed", secret.ObjectMeta.Name)
-			}
-		}
-	}
-	counter.With(progress, secretsDeletedPhaseComplete).Add(1)
-
-	logr.Debugf(" Checking if there are kubernetes deployments associated with training job %s", req.TrainingId)
-	deploys, err := s.k8sClient.AppsV1beta1().Deployments(config.GetLearnerNamespace()).List(metav1.ListOptions{LabelSelector: selector})
-	if err == nil {
-		logr.Debugf(" Deployments for job with name '%s' found by querying kubernetes.", req.Name)
-		for _, deploy := range deploys.Items {
-			logr.Infof(" Deleting deployment '%s'", deploy.ObjectMeta.Name)
-			err := s.k8sClient.AppsV1beta1().Deployments(config.GetLearnerNamespace()).Delete(deploy.ObjectMeta.Name, backgroundDeleteOpts)
-			if err != nil {
-				logr.WithError(err).Errorf(" Deleting kubernetes deployment '%s' failed", deploy.ObjectMeta.Name)
-			}
-		}
-	}
-
-	counter.With(progress, deploymentsDeletedPhaseComplete).Add(1)
 
 	//After Deleting the application, delete the etcd directory.
-	s.etcdClient.DeleteKeyWithOpts(req.TrainingId, logr, clientv3.WithPrefix())
+	s.deleteEtcdNodes(req.TrainingId, req.Name, logr)
 	counter.With(progress, etcdKeysDeletedPhaseComplete).Add(1)
 	return &service.JobKillResponse{}, nil
 }
 
+// deleteEtcdNodes deletes the etcd nodes of the deployment named jobName. The nodes are shared by all deployments
+// of a training, so they are kept if a newer deployment of the training created them.
+func (s *lcmService) deleteEtcdNodes(trainingID string, jobName string, logr *logger.LocLoggingEntry) {
+	if jobName != "" {
+		values, err := s.etcdClient.Get(trainingID+"/"+zkJobName, logr)
+		if err == nil && len(values) > 0 && values[0].Value != "" && values[0].Value != jobName {
+			logr.Infof("Keeping the etcd nodes of training %s, they belong to deployment %s", trainingID, values[0].Value)
+			return
+		}
+	}
+	s.etcdClient.DeleteKeyWithOpts(trainingID, logr, clientv3.WithPrefix())
+}
+
 //Wrapper function for LCM's KillTrainingJob
 func (s *lcmService) killDeployedJob(jobName string, trainingID string, userID string) error {
 	job := &service.JobKillRequest{Name: string(jobName), TrainingId: trainingID, UserId: userID}
@@ -302,21 +309,3 @@ func (s *lcmService) killDeployedJob(jobName string, trainingID string, userID s
 	return err
 }
 
-//manages a DLaaS training job
-func deployJobMonitor(s *lcmService, req *service.JobDeploymentRequest, trainingID string, numLearners int, jobName string, userID string, useNativeDistribution bool, logr *logger.LocLoggingEntry) error {
-
-	envVars, jmLabels := populateJobMonitorEnvVariablesAndLabels(req, trainingID, jobName, userID, numLearners, useNativeDistribution)
-	deploySpec := defineJobMonitorDeployment(req, envVars, jmLabels, logr)
-
-	return backoff.RetryNotify(func() error {
-		_, err := s.k8sClient.AppsV1beta1().Deployments(config.GetLearnerNamespace()).Create(deploySpec)
-		if k8serrors.IsAlreadyExists(err) {
-			logr.WithError(err).Warnf("deployment %s already exists", deploySpec.ObjectMeta.Name)
-			return nil
-		}
-		return err
-	}, k8sInteractionBackoff(), func(err error, window time.Duration) {
-		logr.WithError(err).Errorf("Could not connect to learner kube cluster and/or deploy job monitor for Training Job %s. Problem may either be in reaching the kubernetes API server or in creating a deployment", req.TrainingId)
-		k8sFailureCounter.With(component, "jobmonitor").Add(1)
-	})
-}
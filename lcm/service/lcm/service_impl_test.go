@@ -21,8 +21,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
 
 	"github.com/IBM/FfDL/commons/service"
+	v1core "k8s.io/api/core/v1"
 	// "github.com/coreos/etcd/clientv3"
 	// "github.com/IBM/FfDL/lcm/coord"
 )
@@ -57,3 +59,68 @@ func TestCalcMemory(t *testing.T) {
 	assert.EqualValues(t, 8804.68, calcMemory(r))
 
 }
+
+func TestLearnerPodLabels(t *testing.T) {
+	labels := learnerPodLabels(&service.JobDeploymentRequest{
+		TrainingId: "training-1",
+		UserId:     "user-1",
+		Labels:     map[string]string{"team": "vision", "training_id": "other"},
+	})
+	assert.Equal(t, map[string]string{"team": "vision", "training_id": "training-1", "user_id": "user-1"}, labels)
+}
+
+func TestAdditionalDataStores(t *testing.T) {
+	req := &service.JobDeploymentRequest{
+		Name:       "job-1",
+		TrainingId: "training-1",
+		Resources:  &service.ResourceRequirements{Gpus: 1},
+		EnvVars: map[string]string{
+			"DATA_STORE_TYPE":                "mount_cos",
+			"DATA_DIR":                       "data-bucket",
+			"INPUT_DATA_IDS":                 "DATA,WEIGHTS",
+			"DATA_DIR_DATA":                  "data-bucket",
+			"DATA_DIR_WEIGHTS":               "weights-bucket",
+			"DATA_STORE_WEIGHTS_USERNAME":    "user",
+			"DATA_STORE_WEIGHTS_APIKEY":      "secret",
+			"DATA_STORE_WEIGHTS_AUTHURL":     "http://s3",
+			"DATA_STORE_WEIGHTS_OBJECTID":    "weights-bucket",
+			"RESULT_STORE_TYPE":              "s3_datastore",
+			"RESULT_DIR":                     "results-bucket",
+			"OUTPUT_DATA_IDS":                "RESULTS,EXPORTED",
+			"RESULT_DIR_EXPORTED":            "exported-bucket",
+			"RESULT_STORE_EXPORTED_OBJECTID": "exported-bucket/training-1",
+		},
+	}
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	envVars := envVarsForDeployingLearner(extractEnvVarsFromDeploymentRequest(req), req.TrainingId, 1, "learner", true, false)
+
+	volumes := volumesForLearner(req, envVars, true, false, logr)
+	if assert.Len(t, volumes.AdditionalTrainingData, 1) {
+		v := volumes.AdditionalTrainingData[0]
+		assert.Equal(t, "cosinputmount-weights-job-1", v.ID)
+		assert.Equal(t, "weights-bucket", v.Bucket)
+		assert.Equal(t, "/mnt/data_weights/weights-bucket", v.MountSpec.MountPath)
+	}
+	assert.Empty(t, volumes.AdditionalResultsDirs)
+	secrets := secretsForDeployingLearner(req, true, false)
+	if assert.Len(t, secrets, 2) {
+		assert.Equal(t, "cossecretdata-weights-job-1", secrets[1].Name)
+		assert.Equal(t, "secret", secrets[1].StringData["secret-key"])
+	}
+
+	// results that are not mounted are uploaded by the store-results container
+	mount := v1core.VolumeMount{Name: "jobdata", MountPath: PodLevelJobDir}
+	container := constructStoreResultsContainer(mount, extractEnvVarsFromDeploymentRequest(req))
+	assert.Contains(t, container.Command[2], `DATA_STORE_BUCKET="$DATA_STORE_EXPORTED_OBJECTID" DATA_DIR="/job/results_exported/exported-bucket" store.sh`)
+	bucket, _ := findEnv(container.Env, "DATA_STORE_EXPORTED_OBJECTID")
+	assert.Equal(t, "exported-bucket/training-1", bucket)
+}
+
+func findEnv(vars []v1core.EnvVar, name string) (string, bool) {
+	for _, ev := range vars {
+		if ev.Name == name {
+			return ev.Value, true
+		}
+	}
+	return "", false
+}
@@ -57,6 +57,8 @@ func (t splitTraining) deploymentSpecForHelper() *v1beta1.Deployment {
 
 	podSpec := helper.CreatePodSpec(helperContainers, []v1core.Volume{helperDefn.etcdVolume, helperDefn.sharedVolume}, map[string]string{"training_id": t.req.TrainingId, "user_id": t.req.UserId})
 	deploymentSpec := helper.CreateDeploymentForHelper(helperDefn.name, podSpec)
+	// the deployment carries the labels of its pod, so that it is found by training id
+	deploymentSpec.Labels = podSpec.Labels
 	return deploymentSpec
 
 }
@@ -74,14 +76,15 @@ func (t splitTraining) statefulSetSpecForLearner(serviceName string) (*v1beta1.S
 
 	helperAndLearnerVolumes := append(learnerDefn.volumes, helperDefn.sharedVolume)
 
-	imagePullSecret, err := learner.GenerateImagePullSecret(t.k8sClient, t.req)
+	imagePullSecret, err := learner.GenerateImagePullSecret(t.k8sClient, t.req, t.owners)
 	if err != nil {
 		return nil, err
 	}
 
 	//now create the learner container
 	learnerContainer := constructLearnerContainer(t.req, learnerDefn.envVars, learnerDefn.volumeMounts, helperDefn.sharedVolumeMount, learnerDefn.mountTrainingDataStoreInLearner, learnerDefn.mountResultsStoreInLearner, t.logr) // nil for mounting shared NFS volume since non split mode
-	splitLearnerPodSpec := learner.CreatePodSpec([]v1core.Container{learnerContainer}, helperAndLearnerVolumes, map[string]string{"training_id": t.req.TrainingId, "user_id": t.req.UserId}, gpus, imagePullSecret)
+	splitLearnerPodSpec := learner.CreatePodSpec([]v1core.Container{learnerContainer}, helperAndLearnerVolumes, learnerPodLabels(t.req), gpus, imagePullSecret)
+	learner.SetSchedulingConstraints(&splitLearnerPodSpec, t.req)
 	statefulSetSpec := learner.CreateStatefulSetSpecForLearner(learnerDefn.name, serviceName, learnerDefn.numberOfLearners, splitLearnerPodSpec)
 
 	return statefulSetSpec, nil
@@ -93,6 +96,16 @@ func (t *splitTraining) CreateFromBOM(bom *splitTrainingBOM) error {
 
 	namespace := config.GetLearnerNamespace()
 
+	for _, secret := range bom.secrets {
+		secret.OwnerReferences = t.owners
+	}
+	bom.service.OwnerReferences = t.owners
+	bom.learnerBOM.OwnerReferences = t.owners
+	bom.helperBOM.OwnerReferences = t.owners
+	if bom.sharedVolumeClaimBOM != nil {
+		bom.sharedVolumeClaimBOM.OwnerReferences = t.owners
+	}
+
 	//create shared volume
 	if bom.sharedVolumeClaimBOM != nil { //if nil then must be static volume claim and does not need to be dynamically bound
 		logr.Infof("Split training with shared volume claim %s not nil, creating shared PVC for training", bom.sharedVolumeClaimBOM.Name)
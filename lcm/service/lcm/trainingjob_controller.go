@@ -0,0 +1,188 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"time"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/lcm/coord"
+	"github.com/IBM/FfDL/lcm/trainingjob"
+	"github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+
+	"github.com/coreos/etcd/clientv3"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// trainingJobResyncKey is the number of seconds between two updates of the status of the TrainingJobs
+const trainingJobResyncKey = "lcm.trainingjob.resync"
+
+// the order in which the learners go through their statuses
+var learnerStatusProgress = map[grpc_trainer_v2.Status]int{
+	grpc_trainer_v2.Status_PENDING:     0,
+	grpc_trainer_v2.Status_DOWNLOADING: 1,
+	grpc_trainer_v2.Status_PROCESSING:  2,
+	grpc_trainer_v2.Status_STORING:     3,
+	grpc_trainer_v2.Status_HALTED:      4,
+	grpc_trainer_v2.Status_COMPLETED:   5,
+}
+
+// newTrainingJob returns the TrainingJob owning the objects of a training job
+func newTrainingJob(req *service.JobDeploymentRequest) *trainingjob.TrainingJob {
+	learners := req.GetResources().GetLearners()
+	if learners < 1 {
+		learners = 1
+	}
+	return &trainingjob.TrainingJob{
+		TypeMeta: metav1.TypeMeta{Kind: trainingjob.Kind, APIVersion: trainingjob.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   req.Name,
+			Labels: map[string]string{"training_id": req.TrainingId, "user_id": req.UserId},
+		},
+		Spec: trainingjob.TrainingJobSpec{
+			TrainingID: req.TrainingId,
+			UserID:     req.UserId,
+			Framework:  req.Framework,
+			Learners:   learners,
+		},
+	}
+}
+
+// trainingJobController keeps the status of the TrainingJobs in the learner namespace up to date
+type trainingJobController struct {
+	k8sClient  kubernetes.Interface
+	tjClient   trainingjob.Interface
+	etcdClient coord.Coordinator
+	stop       chan struct{}
+}
+
+func newTrainingJobController(k8sClient kubernetes.Interface, tjClient trainingjob.Interface, etcdClient coord.Coordinator) *trainingJobController {
+	return &trainingJobController{
+		k8sClient:  k8sClient,
+		tjClient:   tjClient,
+		etcdClient: etcdClient,
+		stop:       make(chan struct{}),
+	}
+}
+
+// run updates the status of all TrainingJobs every interval, until the controller is stopped
+func (c *trainingJobController) run(interval time.Duration) {
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	logr.Infof("Updating the status of the training job resources every %v", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.resync(logr)
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *trainingJobController) resync(logr *logger.LocLoggingEntry) {
+	tjs, err := c.tjClient.TrainingJobs(config.GetLearnerNamespace()).List(metav1.ListOptions{})
+	if err != nil {
+		logr.WithError(err).Warnf("Failed to list the training job resources")
+		return
+	}
+	for i := range tjs.Items {
+		if err := c.reconcile(&tjs.Items[i], logr); err != nil {
+			logr.WithError(err).Warnf("Failed to update the status of training job resource %s", tjs.Items[i].Name)
+		}
+	}
+}
+
+// reconcile updates the status of a TrainingJob if it changed
+func (c *trainingJobController) reconcile(tj *trainingjob.TrainingJob, logr *logger.LocLoggingEntry) error {
+	status := c.observe(tj, logr)
+	if status.Phase == tj.Status.Phase && status.ReadyLearners == tj.Status.ReadyLearners &&
+		status.JobMonitorAvailable == tj.Status.JobMonitorAvailable {
+		return nil
+	}
+	now := metav1.Now()
+	status.LastUpdateTime = &now
+
+	updated := tj.DeepCopy()
+	updated.Status = status
+	_, err := c.tjClient.TrainingJobs(tj.Namespace).UpdateStatus(updated)
+	return err
+}
+
+// observe returns the status of a TrainingJob from its stateful set, its job monitor and the statuses its learners
+// recorded in etcd. What cannot be observed keeps its last value.
+func (c *trainingJobController) observe(tj *trainingjob.TrainingJob, logr *logger.LocLoggingEntry) trainingjob.TrainingJobStatus {
+	status := tj.Status
+	namespace := config.GetLearnerNamespace()
+
+	sets, err := c.k8sClient.AppsV1beta1().StatefulSets(namespace).List(metav1.ListOptions{LabelSelector: "training_id==" + tj.Spec.TrainingID})
+	if err == nil {
+		status.ReadyLearners = 0
+		for _, set := range sets.Items {
+			status.ReadyLearners += set.Status.ReadyReplicas
+		}
+	} else {
+		logr.WithError(err).Warnf("Failed to get the learners of training job resource %s", tj.Name)
+	}
+
+	jm, err := c.k8sClient.AppsV1beta1().Deployments(namespace).Get(constructJMName(tj.Name), metav1.GetOptions{})
+	if err == nil {
+		status.JobMonitorAvailable = jm.Status.AvailableReplicas > 0
+	} else if k8serrors.IsNotFound(err) {
+		status.JobMonitorAvailable = false
+	} else {
+		logr.WithError(err).Warnf("Failed to get the job monitor of training job resource %s", tj.Name)
+	}
+
+	if phase, err := c.learnersPhase(tj, logr); err == nil {
+		status.Phase = phase
+	} else {
+		logr.WithError(err).Warnf("Failed to get the learner statuses of training job resource %s", tj.Name)
+	}
+	return status
+}
+
+// learnersPhase returns FAILED if a learner failed, or else the least advanced status of the learners. Learners that
+// did not record a status yet are PENDING.
+func (c *trainingJobController) learnersPhase(tj *trainingjob.TrainingJob, logr *logger.LocLoggingEntry) (string, error) {
+	phase := grpc_trainer_v2.Status_COMPLETED
+	for learnerID := 1; learnerID <= int(tj.Spec.Learners); learnerID++ {
+		values, err := c.etcdClient.Get(learnerStatusSequencePath(tj.Spec.TrainingID, learnerID)+"/", logr, clientv3.WithPrefix())
+		if err != nil {
+			return "", err
+		}
+		learnerStatus := grpc_trainer_v2.Status_PENDING
+		if len(values) > 0 {
+			// the keys of the sequence are the times of the updates
+			learnerStatus = client.GetStatus(values[len(values)-1].Value, logr).Status
+		}
+		if learnerStatus == grpc_trainer_v2.Status_FAILED {
+			return learnerStatus.String(), nil
+		}
+		if progress, ok := learnerStatusProgress[learnerStatus]; ok && progress < learnerStatusProgress[phase] {
+			phase = learnerStatus
+		}
+	}
+	return phase.String(), nil
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcm
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/lcm/trainingjob"
+	tjfake "github.com/IBM/FfDL/lcm/trainingjob/fake"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"k8s.io/api/apps/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func ownedDeploymentRequest() *service.JobDeploymentRequest {
+	return &service.JobDeploymentRequest{
+		Name:       "job-1",
+		TrainingId: "training-1",
+		UserId:     "alice",
+		Framework:  "tensorflow",
+		Version:    "1.5",
+		Resources:  &service.ResourceRequirements{Cpus: 1, Memory: 512, MemoryUnit: service.ResourceRequirements_MB, Learners: 2},
+		EnvVars: map[string]string{
+			"DATA_STORE_TYPE":     "mount_cos",
+			"DATA_STORE_USERNAME": "user",
+			"DATA_STORE_APIKEY":   "data-secret",
+			"DATA_DIR":            "data-bucket",
+			"RESULT_STORE_TYPE":   "mount_cos",
+			"RESULT_DIR":          "results-bucket",
+		},
+	}
+}
+
+// ownerNames returns the names of the TrainingJobs controlling an object
+func ownerNames(meta metav1.ObjectMeta) []string {
+	var names []string
+	for _, ref := range meta.OwnerReferences {
+		if ref.Kind == trainingjob.Kind && ref.Controller != nil && *ref.Controller {
+			names = append(names, ref.Name)
+		}
+	}
+	return names
+}
+
+func TestKubernetesExecutorOwnsObjects(t *testing.T) {
+	viper.Set(config.SharedVolumeStorageClassKey, "standard")
+	defer viper.Set(config.SharedVolumeStorageClassKey, "")
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	namespace := config.GetLearnerNamespace()
+
+	k8sClient := fake.NewSimpleClientset()
+	tjClient := tjfake.NewSimpleClientset()
+	e := &kubernetesExecutor{k8sClient: k8sClient, tjClient: tjClient}
+	req := ownedDeploymentRequest()
+	assert.NoError(t, e.deployJobMonitor(req, 2, false, logr))
+	assert.NoError(t, e.deployLearners(context.Background(), req, logr))
+
+	tj, err := tjClient.TrainingJobs(namespace).Get("job-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, trainingjob.TrainingJobSpec{TrainingID: "training-1", UserID: "alice", Framework: "tensorflow", Learners: 2}, tj.Spec)
+	assert.Equal(t, "training-1", tj.Labels["training_id"])
+
+	owned := []string{"job-1"}
+	services, _ := k8sClient.CoreV1().Services(namespace).List(metav1.ListOptions{})
+	assert.Len(t, services.Items, 1)
+	for _, svc := range services.Items {
+		assert.Equal(t, owned, ownerNames(svc.ObjectMeta), svc.Name)
+	}
+	sets, _ := k8sClient.AppsV1beta1().StatefulSets(namespace).List(metav1.ListOptions{})
+	assert.Len(t, sets.Items, 1)
+	for _, set := range sets.Items {
+		assert.Equal(t, owned, ownerNames(set.ObjectMeta), set.Name)
+	}
+	deployments, _ := k8sClient.AppsV1beta1().Deployments(namespace).List(metav1.ListOptions{})
+	assert.Len(t, deployments.Items, 2)
+	for _, deployment := range deployments.Items {
+		assert.Equal(t, owned, ownerNames(deployment.ObjectMeta), deployment.Name)
+	}
+	claims, _ := k8sClient.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{})
+	assert.Len(t, claims.Items, 1)
+	for _, claim := range claims.Items {
+		assert.Equal(t, owned, ownerNames(claim.ObjectMeta), claim.Name)
+	}
+	secrets, _ := k8sClient.CoreV1().Secrets(namespace).List(metav1.ListOptions{})
+	assert.Len(t, secrets.Items, 2)
+	for _, secret := range secrets.Items {
+		assert.Equal(t, owned, ownerNames(secret.ObjectMeta), secret.Name)
+	}
+
+	// killing the training only deletes the TrainingJob, the garbage collector deletes the rest
+	e.kill(&service.JobKillRequest{Name: "job-1", TrainingId: "training-1"}, discard.NewCounter(), logr)
+	_, err = tjClient.TrainingJobs(namespace).Get("job-1", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	sets, _ = k8sClient.AppsV1beta1().StatefulSets(namespace).List(metav1.ListOptions{})
+	assert.Len(t, sets.Items, 1)
+}
+
+func TestKillTrainingJobKeepsNewerDeployment(t *testing.T) {
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	namespace := config.GetLearnerNamespace()
+
+	k8sClient := fake.NewSimpleClientset()
+	tjClient := tjfake.NewSimpleClientset()
+	etcd := &memCoordinator{nodes: make(map[string]string)}
+	s := &lcmService{
+		k8sClient:  k8sClient,
+		etcdClient: etcd,
+		executor:   &kubernetesExecutor{k8sClient: k8sClient, tjClient: tjClient, etcdClient: etcd},
+	}
+
+	// the training was deployed again as job-2 while the kill of job-1 was pending
+	old := ownedDeploymentRequest()
+	_, err := tjClient.TrainingJobs(namespace).Create(newTrainingJob(old))
+	assert.NoError(t, err)
+	newer := ownedDeploymentRequest()
+	newer.Name = "job-2"
+	_, err = tjClient.TrainingJobs(namespace).Create(newTrainingJob(newer))
+	assert.NoError(t, err)
+	assert.NoError(t, createEtcdNodes(s, "job-2", "alice", "training-1", 2, "tensorflow", logr))
+
+	_, err = s.KillTrainingJob(context.Background(), &service.JobKillRequest{Name: "job-1", TrainingId: "training-1", UserId: "alice"})
+	assert.NoError(t, err)
+	_, err = tjClient.TrainingJobs(namespace).Get("job-1", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	_, err = tjClient.TrainingJobs(namespace).Get("job-2", metav1.GetOptions{})
+	assert.NoError(t, err, "the newer deployment is kept")
+	values, _ := etcd.Get("training-1/jobname", logr)
+	assert.Len(t, values, 1, "the etcd nodes of the newer deployment are kept")
+
+	// a late kill of the old deployment changes nothing
+	_, err = s.KillTrainingJob(context.Background(), &service.JobKillRequest{Name: "job-1", TrainingId: "training-1", UserId: "alice"})
+	assert.NoError(t, err)
+	_, err = tjClient.TrainingJobs(namespace).Get("job-2", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	_, err = s.KillTrainingJob(context.Background(), &service.JobKillRequest{Name: "job-2", TrainingId: "training-1", UserId: "alice"})
+	assert.NoError(t, err)
+	_, err = tjClient.TrainingJobs(namespace).Get("job-2", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+	values, _ = etcd.Get("training-1/", logr)
+	assert.Empty(t, values)
+}
+
+func TestKubernetesExecutorWithoutTrainingJobResource(t *testing.T) {
+	viper.Set(config.SharedVolumeStorageClassKey, "standard")
+	defer viper.Set(config.SharedVolumeStorageClassKey, "")
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	namespace := config.GetLearnerNamespace()
+
+	k8sClient := fake.NewSimpleClientset()
+	tjClient := tjfake.NewSimpleClientset()
+	// the custom resource definition is missing
+	tjClient.PrependReactor("create", trainingjob.Resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8serrors.NewNotFound(trainingjob.SchemeGroupVersion.WithResource(trainingjob.Resource).GroupResource(), "")
+	})
+	e := &kubernetesExecutor{k8sClient: k8sClient, tjClient: tjClient}
+	req := ownedDeploymentRequest()
+	assert.NoError(t, e.deployJobMonitor(req, 2, false, logr))
+	assert.NoError(t, e.deployLearners(context.Background(), req, logr))
+
+	set, err := k8sClient.AppsV1beta1().StatefulSets(namespace).Get("learner-job-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, set.OwnerReferences)
+
+	// the objects are deleted by label
+	e.kill(&service.JobKillRequest{Name: "job-1", TrainingId: "training-1"}, discard.NewCounter(), logr)
+	sets, _ := k8sClient.AppsV1beta1().StatefulSets(namespace).List(metav1.ListOptions{})
+	assert.Empty(t, sets.Items)
+	services, _ := k8sClient.CoreV1().Services(namespace).List(metav1.ListOptions{})
+	assert.Empty(t, services.Items)
+}
+
+// learnerStatusValue returns a status update of a learner like the controller of a learner pod records it
+func learnerStatusValue(status string) string {
+	return `{"timestamp":"1","status":"` + status + `","error_code":"","status_message":""}`
+}
+
+func TestTrainingJobControllerReconcile(t *testing.T) {
+	logr := logger.LocLogger(logger.LogServiceBasic(logger.LogkeyLcmService))
+	namespace := config.GetLearnerNamespace()
+
+	tj := newTrainingJob(ownedDeploymentRequest())
+	tj.Namespace = namespace
+	tjClient := tjfake.NewSimpleClientset(tj)
+	k8sClient := fake.NewSimpleClientset(
+		&v1beta1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "learner-job-1", Namespace: namespace, Labels: map[string]string{"training_id": "training-1"}},
+			Status:     v1beta1.StatefulSetStatus{ReadyReplicas: 2},
+		},
+		&v1beta1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: constructJMName("job-1"), Namespace: namespace},
+			Status:     v1beta1.DeploymentStatus{AvailableReplicas: 1},
+		},
+	)
+	etcd := &memCoordinator{nodes: map[string]string{
+		learnerStatusSequencePath("training-1", 1) + "/1": learnerStatusValue("DOWNLOADING"),
+		learnerStatusSequencePath("training-1", 1) + "/2": learnerStatusValue("PROCESSING"),
+		learnerStatusSequencePath("training-1", 2) + "/1": learnerStatusValue("DOWNLOADING"),
+	}}
+	c := newTrainingJobController(k8sClient, tjClient, etcd)
+
+	status := func() trainingjob.TrainingJobStatus {
+		tj, err := tjClient.TrainingJobs(namespace).Get("job-1", metav1.GetOptions{})
+		assert.NoError(t, err)
+		return tj.Status
+	}
+
+	c.resync(logr)
+	current := status()
+	assert.Equal(t, "DOWNLOADING", current.Phase)
+	assert.Equal(t, int32(2), current.ReadyLearners)
+	assert.True(t, current.JobMonitorAvailable)
+	assert.NotNil(t, current.LastUpdateTime)
+
+	// nothing changed, the status is not updated
+	tjClient.ClearActions()
+	c.resync(logr)
+	for _, action := range tjClient.Actions() {
+		assert.NotEqual(t, "update", action.GetVerb())
+	}
+
+	etcd.PutIfKeyMissing(learnerStatusSequencePath("training-1", 2)+"/2", learnerStatusValue("FAILED"), logr)
+	c.resync(logr)
+	assert.Equal(t, "FAILED", status().Phase)
+
+	// learners without a status are pending
+	tj.Spec.TrainingID = "training-2"
+	phase, err := c.learnersPhase(tj, logr)
+	assert.NoError(t, err)
+	assert.Equal(t, "PENDING", phase)
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainingjob
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/rest"
+)
+
+// Interface gives access to the TrainingJobs of a namespace
+type Interface interface {
+	TrainingJobs(namespace string) TrainingJobInterface
+}
+
+// TrainingJobInterface has the methods to work with the TrainingJobs of a namespace
+type TrainingJobInterface interface {
+	Create(*TrainingJob) (*TrainingJob, error)
+	UpdateStatus(*TrainingJob) (*TrainingJob, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	Get(name string, options metav1.GetOptions) (*TrainingJob, error)
+	List(opts metav1.ListOptions) (*TrainingJobList, error)
+}
+
+// Client talks to the Kubernetes API server about TrainingJobs
+type Client struct {
+	restClient rest.Interface
+}
+
+// NewForConfig creates a Client for the given config
+func NewForConfig(c *rest.Config) (*Client, error) {
+	config := *c
+	config.GroupVersion = &SchemeGroupVersion
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = serializer.DirectCodecFactory{CodecFactory: Codecs}
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{client}, nil
+}
+
+// TrainingJobs returns the TrainingJobs of a namespace
+func (c *Client) TrainingJobs(namespace string) TrainingJobInterface {
+	return &trainingJobs{client: c.restClient, ns: namespace}
+}
+
+type trainingJobs struct {
+	client rest.Interface
+	ns     string
+}
+
+// Create creates a TrainingJob and returns the server's representation of it
+func (c *trainingJobs) Create(trainingJob *TrainingJob) (result *TrainingJob, err error) {
+	result = &TrainingJob{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource(Resource).
+		Body(trainingJob).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus replaces the status of a TrainingJob
+func (c *trainingJobs) UpdateStatus(trainingJob *TrainingJob) (result *TrainingJob, err error) {
+	result = &TrainingJob{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource(Resource).
+		Name(trainingJob.Name).
+		SubResource("status").
+		Body(trainingJob).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete deletes a TrainingJob, the garbage collector deletes the objects it owns
+func (c *trainingJobs) Delete(name string, options *metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource(Resource).
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Get returns a TrainingJob
+func (c *trainingJobs) Get(name string, options metav1.GetOptions) (result *TrainingJob, err error) {
+	result = &TrainingJob{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource(Resource).
+		Name(name).
+		VersionedParams(&options, ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List returns the TrainingJobs matching the selectors of opts
+func (c *trainingJobs) List(opts metav1.ListOptions) (result *TrainingJobList, err error) {
+	result = &TrainingJobList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource(Resource).
+		VersionedParams(&opts, ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
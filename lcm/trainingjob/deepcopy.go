@@ -0,0 +1,84 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainingjob
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto copies the receiver into out
+func (in *TrainingJobStatus) DeepCopyInto(out *TrainingJobStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		out.LastUpdateTime = in.LastUpdateTime.DeepCopy()
+	}
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *TrainingJob) DeepCopyInto(out *TrainingJob) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy returns a copy of the receiver
+func (in *TrainingJob) DeepCopy() *TrainingJob {
+	if in == nil {
+		return nil
+	}
+	out := new(TrainingJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject returns a copy of the receiver as a runtime.Object
+func (in *TrainingJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *TrainingJobList) DeepCopyInto(out *TrainingJobList) {
+	*out = *in
+	out.ListMeta = *in.ListMeta.DeepCopy()
+	if in.Items != nil {
+		out.Items = make([]TrainingJob, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// DeepCopy returns a copy of the receiver
+func (in *TrainingJobList) DeepCopy() *TrainingJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrainingJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject returns a copy of the receiver as a runtime.Object
+func (in *TrainingJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
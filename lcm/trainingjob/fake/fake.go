@@ -0,0 +1,115 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fake has a TrainingJob client that keeps the TrainingJobs in memory, like the fake clientset of client-go.
+package fake
+
+import (
+	"github.com/IBM/FfDL/lcm/trainingjob"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/testing"
+)
+
+var (
+	trainingJobsResource = trainingjob.SchemeGroupVersion.WithResource(trainingjob.Resource)
+	trainingJobsKind     = trainingjob.SchemeGroupVersion.WithKind(trainingjob.Kind)
+)
+
+// Clientset implements trainingjob.Interface on an object tracker. The actions are recorded in the embedded
+// testing.Fake, which also allows to add reactors.
+type Clientset struct {
+	testing.Fake
+}
+
+// NewSimpleClientset returns a client that responds with the provided objects. Creates, updates and deletions are
+// processed as-is, without validations, defaults or garbage collection.
+func NewSimpleClientset(objects ...runtime.Object) *Clientset {
+	o := testing.NewObjectTracker(trainingjob.Scheme, trainingjob.Codecs.UniversalDecoder())
+	for _, obj := range objects {
+		if err := o.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	c := &Clientset{}
+	c.AddReactor("*", "*", testing.ObjectReaction(o))
+	c.AddWatchReactor("*", testing.DefaultWatchReactor(watch.NewFake(), nil))
+	return c
+}
+
+var _ trainingjob.Interface = &Clientset{}
+
+// TrainingJobs returns the TrainingJobs of a namespace
+func (c *Clientset) TrainingJobs(namespace string) trainingjob.TrainingJobInterface {
+	return &fakeTrainingJobs{&c.Fake, namespace}
+}
+
+type fakeTrainingJobs struct {
+	fake *testing.Fake
+	ns   string
+}
+
+func (c *fakeTrainingJobs) Create(trainingJob *trainingjob.TrainingJob) (*trainingjob.TrainingJob, error) {
+	obj, err := c.fake.Invokes(testing.NewCreateAction(trainingJobsResource, c.ns, trainingJob), &trainingjob.TrainingJob{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*trainingjob.TrainingJob), err
+}
+
+func (c *fakeTrainingJobs) UpdateStatus(trainingJob *trainingjob.TrainingJob) (*trainingjob.TrainingJob, error) {
+	obj, err := c.fake.Invokes(testing.NewUpdateSubresourceAction(trainingJobsResource, "status", c.ns, trainingJob), &trainingjob.TrainingJob{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*trainingjob.TrainingJob), err
+}
+
+func (c *fakeTrainingJobs) Delete(name string, options *metav1.DeleteOptions) error {
+	_, err := c.fake.Invokes(testing.NewDeleteAction(trainingJobsResource, c.ns, name), &trainingjob.TrainingJob{})
+	return err
+}
+
+func (c *fakeTrainingJobs) Get(name string, options metav1.GetOptions) (*trainingjob.TrainingJob, error) {
+	obj, err := c.fake.Invokes(testing.NewGetAction(trainingJobsResource, c.ns, name), &trainingjob.TrainingJob{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*trainingjob.TrainingJob), err
+}
+
+func (c *fakeTrainingJobs) List(opts metav1.ListOptions) (*trainingjob.TrainingJobList, error) {
+	obj, err := c.fake.Invokes(testing.NewListAction(trainingJobsResource, trainingJobsKind, c.ns, opts), &trainingjob.TrainingJobList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &trainingjob.TrainingJobList{}
+	for _, item := range obj.(*trainingjob.TrainingJobList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
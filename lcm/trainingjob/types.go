@@ -0,0 +1,91 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package trainingjob defines the TrainingJob custom resource of the LCM and a client for it. A TrainingJob is created
+// for every training job the LCM deploys to Kubernetes and owns all objects of the training job, so that they are
+// deleted together with it.
+package trainingjob
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+// GroupName is the API group of the custom resources of FfDL
+const GroupName = "ffdl.ibm.com"
+
+// Kind is the kind of the TrainingJob custom resource
+const Kind = "TrainingJob"
+
+// Resource is the plural name of the TrainingJob custom resource
+const Resource = "trainingjobs"
+
+// SchemeGroupVersion is the group version of the TrainingJob custom resource
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+var (
+	// Scheme knows the TrainingJob types
+	Scheme = runtime.NewScheme()
+	// Codecs encodes and decodes the TrainingJob types
+	Codecs = serializer.NewCodecFactory(Scheme)
+	// ParameterCodec encodes the options of requests
+	ParameterCodec = runtime.NewParameterCodec(Scheme)
+)
+
+func init() {
+	Scheme.AddKnownTypes(SchemeGroupVersion, &TrainingJob{}, &TrainingJobList{})
+	metav1.AddToGroupVersion(Scheme, SchemeGroupVersion)
+}
+
+// TrainingJob is a training job the LCM deployed to Kubernetes. Its name is the job name of the training, which is a
+// valid object name unlike the training id.
+type TrainingJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrainingJobSpec   `json:"spec"`
+	Status TrainingJobStatus `json:"status,omitempty"`
+}
+
+// TrainingJobSpec describes the training of a TrainingJob
+type TrainingJobSpec struct {
+	TrainingID string `json:"trainingId"`
+	UserID     string `json:"userId"`
+	Framework  string `json:"framework,omitempty"`
+	Learners   int32  `json:"learners"`
+}
+
+// TrainingJobStatus is the state of a TrainingJob as last observed by the LCM
+type TrainingJobStatus struct {
+	// Phase is the least advanced status the learners recorded in etcd, or FAILED if a learner failed
+	Phase string `json:"phase,omitempty"`
+	// ReadyLearners is the number of learner pods that are ready
+	ReadyLearners int32 `json:"readyLearners"`
+	// JobMonitorAvailable is whether the job monitor of the training is running
+	JobMonitorAvailable bool `json:"jobMonitorAvailable"`
+	// LastUpdateTime is when the status last changed
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// TrainingJobList is a list of TrainingJobs
+type TrainingJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TrainingJob `json:"items"`
+}
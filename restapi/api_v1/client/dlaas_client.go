@@ -12,8 +12,10 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/IBM/FfDL/restapi/api_v1/client/events"
+	"github.com/IBM/FfDL/restapi/api_v1/client/experiments"
 	"github.com/IBM/FfDL/restapi/api_v1/client/models"
 	"github.com/IBM/FfDL/restapi/api_v1/client/training_data"
+	"github.com/IBM/FfDL/restapi/api_v1/client/usage"
 )
 
 // Default dlaas HTTP client.
@@ -59,10 +61,14 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Dlaas {
 
 	cli.Events = events.New(transport, formats)
 
+	cli.Experiments = experiments.New(transport, formats)
+
 	cli.Models = models.New(transport, formats)
 
 	cli.TrainingData = training_data.New(transport, formats)
 
+	cli.Usage = usage.New(transport, formats)
+
 	return cli
 }
 
@@ -109,10 +115,14 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type Dlaas struct {
 	Events *events.Client
 
+	Experiments *experiments.Client
+
 	Models *models.Client
 
 	TrainingData *training_data.Client
 
+	Usage *usage.Client
+
 	Transport runtime.ClientTransport
 }
 
@@ -122,8 +132,12 @@ func (c *Dlaas) SetTransport(transport runtime.ClientTransport) {
 
 	c.Events.SetTransport(transport)
 
+	c.Experiments.SetTransport(transport)
+
 	c.Models.SetTransport(transport)
 
 	c.TrainingData.SetTransport(transport)
 
+	c.Usage.SetTransport(transport)
+
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -74,6 +75,51 @@ for the list models operation typically these are written to a http.Request
 */
 type ListModelsParams struct {
 
+	/*Framework
+	  Only list models using this framework, such as tensorflow.
+
+	*/
+	Framework *string
+	/*LabelSelector
+	  Only list models whose labels match this selector, such as team=vision,dataset!=v2.
+
+	*/
+	LabelSelector *string
+	/*NamePrefix
+	  Only list models whose name starts with this prefix.
+
+	*/
+	NamePrefix *string
+	/*PageSize
+	  Maximum number of models to return. All models are returned if not set.
+
+	*/
+	PageSize *int32
+	/*PageToken
+	  The next_page_token of the previous response, to continue the listing where it ended.
+
+	*/
+	PageToken *string
+	/*Sort
+	  Sort by submitted or name, prefixed with - for descending order. Default -submitted.
+
+	*/
+	Sort *string
+	/*Status
+	  Comma separated list of training statuses, such as COMPLETED,FAILED.
+
+	*/
+	Status *string
+	/*SubmittedAfter
+	  Only list models submitted at or after this RFC3339 timestamp.
+
+	*/
+	SubmittedAfter *string
+	/*SubmittedBefore
+	  Only list models submitted before this RFC3339 timestamp.
+
+	*/
+	SubmittedBefore *string
 	/*Version
 	  The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.
 
@@ -118,6 +164,105 @@ func (o *ListModelsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithFramework adds the framework to the list models params
+func (o *ListModelsParams) WithFramework(framework *string) *ListModelsParams {
+	o.SetFramework(framework)
+	return o
+}
+
+// SetFramework adds the framework to the list models params
+func (o *ListModelsParams) SetFramework(framework *string) {
+	o.Framework = framework
+}
+
+// WithLabelSelector adds the labelSelector to the list models params
+func (o *ListModelsParams) WithLabelSelector(labelSelector *string) *ListModelsParams {
+	o.SetLabelSelector(labelSelector)
+	return o
+}
+
+// SetLabelSelector adds the labelSelector to the list models params
+func (o *ListModelsParams) SetLabelSelector(labelSelector *string) {
+	o.LabelSelector = labelSelector
+}
+
+// WithNamePrefix adds the namePrefix to the list models params
+func (o *ListModelsParams) WithNamePrefix(namePrefix *string) *ListModelsParams {
+	o.SetNamePrefix(namePrefix)
+	return o
+}
+
+// SetNamePrefix adds the namePrefix to the list models params
+func (o *ListModelsParams) SetNamePrefix(namePrefix *string) {
+	o.NamePrefix = namePrefix
+}
+
+// WithPageSize adds the pageSize to the list models params
+func (o *ListModelsParams) WithPageSize(pageSize *int32) *ListModelsParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list models params
+func (o *ListModelsParams) SetPageSize(pageSize *int32) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list models params
+func (o *ListModelsParams) WithPageToken(pageToken *string) *ListModelsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list models params
+func (o *ListModelsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
+// WithSort adds the sort to the list models params
+func (o *ListModelsParams) WithSort(sort *string) *ListModelsParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the list models params
+func (o *ListModelsParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithStatus adds the status to the list models params
+func (o *ListModelsParams) WithStatus(status *string) *ListModelsParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list models params
+func (o *ListModelsParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WithSubmittedAfter adds the submittedAfter to the list models params
+func (o *ListModelsParams) WithSubmittedAfter(submittedAfter *string) *ListModelsParams {
+	o.SetSubmittedAfter(submittedAfter)
+	return o
+}
+
+// SetSubmittedAfter adds the submittedAfter to the list models params
+func (o *ListModelsParams) SetSubmittedAfter(submittedAfter *string) {
+	o.SubmittedAfter = submittedAfter
+}
+
+// WithSubmittedBefore adds the submittedBefore to the list models params
+func (o *ListModelsParams) WithSubmittedBefore(submittedBefore *string) *ListModelsParams {
+	o.SetSubmittedBefore(submittedBefore)
+	return o
+}
+
+// SetSubmittedBefore adds the submittedBefore to the list models params
+func (o *ListModelsParams) SetSubmittedBefore(submittedBefore *string) {
+	o.SubmittedBefore = submittedBefore
+}
+
 // WithVersion adds the version to the list models params
 func (o *ListModelsParams) WithVersion(version string) *ListModelsParams {
 	o.SetVersion(version)
@@ -137,6 +282,150 @@ func (o *ListModelsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 	}
 	var res []error
 
+	if o.Framework != nil {
+
+		// query param framework
+		var qrFramework string
+		if o.Framework != nil {
+			qrFramework = *o.Framework
+		}
+		qFramework := qrFramework
+		if qFramework != "" {
+			if err := r.SetQueryParam("framework", qFramework); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.LabelSelector != nil {
+
+		// query param label_selector
+		var qrLabelSelector string
+		if o.LabelSelector != nil {
+			qrLabelSelector = *o.LabelSelector
+		}
+		qLabelSelector := qrLabelSelector
+		if qLabelSelector != "" {
+			if err := r.SetQueryParam("label_selector", qLabelSelector); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.NamePrefix != nil {
+
+		// query param name_prefix
+		var qrNamePrefix string
+		if o.NamePrefix != nil {
+			qrNamePrefix = *o.NamePrefix
+		}
+		qNamePrefix := qrNamePrefix
+		if qNamePrefix != "" {
+			if err := r.SetQueryParam("name_prefix", qNamePrefix); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int32
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt32(qrPageSize)
+		if qPageSize != "" {
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.SubmittedAfter != nil {
+
+		// query param submitted_after
+		var qrSubmittedAfter string
+		if o.SubmittedAfter != nil {
+			qrSubmittedAfter = *o.SubmittedAfter
+		}
+		qSubmittedAfter := qrSubmittedAfter
+		if qSubmittedAfter != "" {
+			if err := r.SetQueryParam("submitted_after", qSubmittedAfter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.SubmittedBefore != nil {
+
+		// query param submitted_before
+		var qrSubmittedBefore string
+		if o.SubmittedBefore != nil {
+			qrSubmittedBefore = *o.SubmittedBefore
+		}
+		qSubmittedBefore := qrSubmittedBefore
+		if qSubmittedBefore != "" {
+			if err := r.SetQueryParam("submitted_before", qSubmittedBefore); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// query param version
 	qrVersion := o.Version
 	qVersion := qrVersion
@@ -32,6 +32,13 @@ func (o *ListModelsReader) ReadResponse(response runtime.ClientResponse, consume
 		}
 		return result, nil
 
+	case 400:
+		result := NewListModelsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	case 401:
 		result := NewListModelsUnauthorized()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -73,6 +80,35 @@ func (o *ListModelsOK) readResponse(response runtime.ClientResponse, consumer ru
 	return nil
 }
 
+// NewListModelsBadRequest creates a ListModelsBadRequest with default headers values
+func NewListModelsBadRequest() *ListModelsBadRequest {
+	return &ListModelsBadRequest{}
+}
+
+/*ListModelsBadRequest handles this case with default header values.
+
+Error in the query parameters.
+*/
+type ListModelsBadRequest struct {
+	Payload *restmodels.Error
+}
+
+func (o *ListModelsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v1/models][%d] listModelsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListModelsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(restmodels.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListModelsUnauthorized creates a ListModelsUnauthorized with default headers values
 func NewListModelsUnauthorized() *ListModelsUnauthorized {
 	return &ListModelsUnauthorized{}
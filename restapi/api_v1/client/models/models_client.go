@@ -58,6 +58,37 @@ func (a *Client) DeleteModel(params *DeleteModelParams, authInfo runtime.ClientA
 
 }
 
+/*
+DeleteModels deletes the models whose labels match a selector
+
+Deletes the models of the user whose labels match the `label_selector`, like deleting each of them. It does not delete any data in the user's data store.
+*/
+func (a *Client) DeleteModels(params *DeleteModelsParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteModelsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteModelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deleteModels",
+		Method:             "DELETE",
+		PathPattern:        "/v1/models",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeleteModelsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteModelsOK), nil
+
+}
+
 /*
 DownloadModelDefinition downloads the model definition
 
@@ -214,6 +245,38 @@ func (a *Client) GetModel(params *GetModelParams, authInfo runtime.ClientAuthInf
 
 }
 
+/*
+GetModelHistory gets the status history of a training
+
+Get the status transitions of a training, with the time spent in each status.
+
+*/
+func (a *Client) GetModelHistory(params *GetModelHistoryParams, authInfo runtime.ClientAuthInfoWriter) (*GetModelHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetModelHistoryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getModelHistory",
+		Method:             "GET",
+		PathPattern:        "/v1/models/{model_id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetModelHistoryReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetModelHistoryOK), nil
+
+}
+
 /*
 ListModels gets a list of available deep learning models
 
@@ -247,9 +310,9 @@ func (a *Client) ListModels(params *ListModelsParams, authInfo runtime.ClientAut
 }
 
 /*
-PatchModel changes the status of the training progress
+PatchModel changes the status, the labels or the priority of a training
 
-Changes the status of the training progress to the given `status` value (currently `halt` only). Halt means the training will be stopped and the last snapshot will be stored and can be retrieved.
+Changes the status of the training progress to the given `status` value (`halt` or `resume`). Halt means the training will be stopped and the last snapshot will be stored and can be retrieved. Resume means a halted training will be queued again and continue from its last checkpoint. The credentials of its data stores are erased when a training is halted, so the `manifest` it was created with has to be given again to resume it. If `labels` are given, they replace the labels of the training. If `priority` is given, it becomes the priority of the queued training, which only priority administrators may set.
 */
 func (a *Client) PatchModel(params *PatchModelParams, authInfo runtime.ClientAuthInfoWriter) (*PatchModelAccepted, error) {
 	// TODO: Validate the params before sending
@@ -277,13 +340,44 @@ func (a *Client) PatchModel(params *PatchModelParams, authInfo runtime.ClientAut
 
 }
 
+/*
+PatchModels halts the trainings whose labels match a selector
+
+Halts the trainings of the user whose labels match the `label_selector` and that have not finished yet, like halting each of them. Only `halt` is accepted as `status`.
+*/
+func (a *Client) PatchModels(params *PatchModelsParams, authInfo runtime.ClientAuthInfoWriter) (*PatchModelsAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchModelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "patchModels",
+		Method:             "PATCH",
+		PathPattern:        "/v1/models",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &PatchModelsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PatchModelsAccepted), nil
+
+}
+
 /*
 PostModel trains a new deep learning model
 
 Trains a deep neural network written in a DL framework supported by the DLaaS platform (such as Caffe, Tensorflow, etc.). The model code has to be uploaded and configuration parameters have to be provided.
 
 */
-func (a *Client) PostModel(params *PostModelParams, authInfo runtime.ClientAuthInfoWriter) (*PostModelCreated, error) {
+func (a *Client) PostModel(params *PostModelParams, authInfo runtime.ClientAuthInfoWriter) (*PostModelOK, *PostModelCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewPostModelParams()
@@ -302,10 +396,80 @@ func (a *Client) PostModel(params *PostModelParams, authInfo runtime.ClientAuthI
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *PostModelOK:
+		return value, nil, nil
+	case *PostModelCreated:
+		return nil, value, nil
+	}
+	return nil, nil, nil
+
+}
+
+/*
+WatchModel watches the status of a training
+
+Stream the status transitions of a training as server-sent events, or as websocket messages if the request is a websocket upgrade. The stream starts with the transitions the training already went through and ends when the training has finished.
+
+*/
+func (a *Client) WatchModel(params *WatchModelParams, authInfo runtime.ClientAuthInfoWriter) (*WatchModelOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWatchModelParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "watchModel",
+		Method:             "GET",
+		PathPattern:        "/v1/models/{model_id}/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &WatchModelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WatchModelOK), nil
+
+}
+
+/*
+WatchModels watches the status of all trainings of the user
+
+Stream the status transitions of all trainings of the user as server-sent events, or as websocket messages if the request is a websocket upgrade. Only the transitions after the start of the stream are sent.
+
+*/
+func (a *Client) WatchModels(params *WatchModelsParams, authInfo runtime.ClientAuthInfoWriter) (*WatchModelsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWatchModelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "watchModels",
+		Method:             "GET",
+		PathPattern:        "/v1/models/watch",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &WatchModelsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*PostModelCreated), nil
+	return result.(*WatchModelsOK), nil
 
 }
 
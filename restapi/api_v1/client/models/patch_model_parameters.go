@@ -82,7 +82,7 @@ type PatchModelParams struct {
 	*/
 	ModelID string
 	/*Payload
-	  Currently accepts "halt" as the only valid value.
+	  Accepts "halt" or "resume" as status, and the new labels.
 
 	*/
 	Payload *restmodels.TrainingUpdate
@@ -46,6 +46,13 @@ func (o *PatchModelReader) ReadResponse(response runtime.ClientResponse, consume
 		}
 		return nil, result
 
+	case 403:
+		result := NewPatchModelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	case 404:
 		result := NewPatchModelNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -65,7 +72,7 @@ func NewPatchModelAccepted() *PatchModelAccepted {
 
 /*PatchModelAccepted handles this case with default header values.
 
-Training successfully halted.
+Training successfully halted or resumed.
 */
 type PatchModelAccepted struct {
 	Payload *restmodels.BasicModel
@@ -145,6 +152,35 @@ func (o *PatchModelUnauthorized) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewPatchModelForbidden creates a PatchModelForbidden with default headers values
+func NewPatchModelForbidden() *PatchModelForbidden {
+	return &PatchModelForbidden{}
+}
+
+/*PatchModelForbidden handles this case with default header values.
+
+The user may not set the priority of trainings.
+*/
+type PatchModelForbidden struct {
+	Payload *restmodels.Error
+}
+
+func (o *PatchModelForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /v1/models/{model_id}][%d] patchModelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *PatchModelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(restmodels.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewPatchModelNotFound creates a PatchModelNotFound with default headers values
 func NewPatchModelNotFound() *PatchModelNotFound {
 	return &PatchModelNotFound{}
@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -23,9 +24,11 @@ import (
 // with the default values initialized.
 func NewPostModelParams() *PostModelParams {
 	var (
+		dryRunDefault  = bool(false)
 		versionDefault = string("2017-02-13")
 	)
 	return &PostModelParams{
+		DryRun:  &dryRunDefault,
 		Version: versionDefault,
 
 		timeout: cr.DefaultTimeout,
@@ -36,9 +39,11 @@ func NewPostModelParams() *PostModelParams {
 // with the default values initialized, and the ability to set a timeout on a request
 func NewPostModelParamsWithTimeout(timeout time.Duration) *PostModelParams {
 	var (
+		dryRunDefault  = bool(false)
 		versionDefault = string("2017-02-13")
 	)
 	return &PostModelParams{
+		DryRun:  &dryRunDefault,
 		Version: versionDefault,
 
 		timeout: timeout,
@@ -49,9 +54,11 @@ func NewPostModelParamsWithTimeout(timeout time.Duration) *PostModelParams {
 // with the default values initialized, and the ability to set a context for a request
 func NewPostModelParamsWithContext(ctx context.Context) *PostModelParams {
 	var (
+		dryRunDefault  = bool(false)
 		versionDefault = string("2017-02-13")
 	)
 	return &PostModelParams{
+		DryRun:  &dryRunDefault,
 		Version: versionDefault,
 
 		Context: ctx,
@@ -62,9 +69,11 @@ func NewPostModelParamsWithContext(ctx context.Context) *PostModelParams {
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewPostModelParamsWithHTTPClient(client *http.Client) *PostModelParams {
 	var (
+		dryRunDefault  = bool(false)
 		versionDefault = string("2017-02-13")
 	)
 	return &PostModelParams{
+		DryRun:     &dryRunDefault,
 		Version:    versionDefault,
 		HTTPClient: client,
 	}
@@ -75,13 +84,23 @@ for the post model operation typically these are written to a http.Request
 */
 type PostModelParams struct {
 
+	/*DryRun
+	  Only validate the model and return the Kubernetes objects its training would create, without starting it. Default false.
+
+	*/
+	DryRun *bool
+	/*IdempotencyKey
+	  A key chosen by the client, such as a UUID, to retry the request safely. Requests with the same key return the model created by the first one instead of training another, for 24 hours by default.
+
+	*/
+	IdempotencyKey *string
 	/*Manifest
 	  The manifest providing configuration for the deep learning model, the training data and the training execution.
 
 	*/
 	Manifest os.File
 	/*ModelDefinition
-	  The deep learning model code as compressed archive (ZIP).
+	  The deep learning model code as compressed archive (ZIP). Not needed if the manifest sets model_definition.location.
 
 	*/
 	ModelDefinition *os.File
@@ -129,6 +148,28 @@ func (o *PostModelParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithDryRun adds the dryRun to the post model params
+func (o *PostModelParams) WithDryRun(dryRun *bool) *PostModelParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the post model params
+func (o *PostModelParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
+// WithIdempotencyKey adds the idempotencyKey to the post model params
+func (o *PostModelParams) WithIdempotencyKey(idempotencyKey *string) *PostModelParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the post model params
+func (o *PostModelParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithManifest adds the manifest to the post model params
 func (o *PostModelParams) WithManifest(manifest os.File) *PostModelParams {
 	o.SetManifest(manifest)
@@ -170,6 +211,31 @@ func (o *PostModelParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 	}
 	var res []error
 
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+
+	}
+
 	// form file param manifest
 	if err := r.SetFileParam("manifest", &o.Manifest); err != nil {
 		return err
@@ -25,6 +25,13 @@ type PostModelReader struct {
 func (o *PostModelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
+	case 200:
+		result := NewPostModelOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
 	case 201:
 		result := NewPostModelCreated()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -51,6 +58,35 @@ func (o *PostModelReader) ReadResponse(response runtime.ClientResponse, consumer
 	}
 }
 
+// NewPostModelOK creates a PostModelOK with default headers values
+func NewPostModelOK() *PostModelOK {
+	return &PostModelOK{}
+}
+
+/*PostModelOK handles this case with default header values.
+
+The Kubernetes objects the training would create, returned for a dry run.
+*/
+type PostModelOK struct {
+	Payload *restmodels.RenderedModel
+}
+
+func (o *PostModelOK) Error() string {
+	return fmt.Sprintf("[POST /v1/models][%d] postModelOK  %+v", 200, o.Payload)
+}
+
+func (o *PostModelOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(restmodels.RenderedModel)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewPostModelCreated creates a PostModelCreated with default headers values
 func NewPostModelCreated() *PostModelCreated {
 	return &PostModelCreated{}
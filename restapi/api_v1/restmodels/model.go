@@ -29,9 +29,18 @@ type Model struct {
 	// framework
 	Framework *Framework `json:"framework,omitempty"`
 
+	// User-defined labels of the model.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// metrics
 	Metrics []*MetricData `json:"metrics"`
 
+	// The location the model definition was fetched from, if it was not uploaded.
+	ModelSource string `json:"model_source,omitempty"`
+
+	// The commit SHA or object ETag of the fetched model definition.
+	ModelSourceRevision string `json:"model_source_revision,omitempty"`
+
 	// The name of the deep learning model.
 	Name string `json:"name,omitempty"`
 
@@ -55,8 +64,14 @@ func (m *Model) UnmarshalJSON(raw []byte) error {
 
 		Framework *Framework `json:"framework,omitempty"`
 
+		Labels map[string]string `json:"labels,omitempty"`
+
 		Metrics []*MetricData `json:"metrics,omitempty"`
 
+		ModelSource string `json:"model_source,omitempty"`
+
+		ModelSourceRevision string `json:"model_source_revision,omitempty"`
+
 		Name string `json:"name,omitempty"`
 
 		Training *Training `json:"training,omitempty"`
@@ -71,8 +86,14 @@ func (m *Model) UnmarshalJSON(raw []byte) error {
 
 	m.Framework = data.Framework
 
+	m.Labels = data.Labels
+
 	m.Metrics = data.Metrics
 
+	m.ModelSource = data.ModelSource
+
+	m.ModelSourceRevision = data.ModelSourceRevision
+
 	m.Name = data.Name
 
 	m.Training = data.Training
@@ -97,8 +118,14 @@ func (m Model) MarshalJSON() ([]byte, error) {
 
 		Framework *Framework `json:"framework,omitempty"`
 
+		Labels map[string]string `json:"labels,omitempty"`
+
 		Metrics []*MetricData `json:"metrics,omitempty"`
 
+		ModelSource string `json:"model_source,omitempty"`
+
+		ModelSourceRevision string `json:"model_source_revision,omitempty"`
+
 		Name string `json:"name,omitempty"`
 
 		Training *Training `json:"training,omitempty"`
@@ -110,8 +137,14 @@ func (m Model) MarshalJSON() ([]byte, error) {
 
 	data.Framework = m.Framework
 
+	data.Labels = m.Labels
+
 	data.Metrics = m.Metrics
 
+	data.ModelSource = m.ModelSource
+
+	data.ModelSourceRevision = m.ModelSourceRevision
+
 	data.Name = m.Name
 
 	data.Training = m.Training
@@ -21,10 +21,15 @@ type ModelList struct {
 
 	// models
 	Models []*Model `json:"models"`
+
+	// Token to request the next page of models with, not set on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 /* polymorph ModelList models false */
 
+/* polymorph ModelList next_page_token false */
+
 // Validate validates this model list
 func (m *ModelList) Validate(formats strfmt.Registry) error {
 	var res []error
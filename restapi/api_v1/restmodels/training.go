@@ -26,6 +26,9 @@ type Training struct {
 	// Number of CPUs required
 	Cpus float64 `json:"cpus,omitempty"`
 
+	// Ids of the trainings that have to complete before this training is started. The training reads the results of the first one as its input data.
+	DependsOn []string `json:"depends_on"`
+
 	// events
 	Events *EventList `json:"events,omitempty"`
 
@@ -59,6 +62,8 @@ type Training struct {
 
 /* polymorph Training cpus false */
 
+/* polymorph Training depends_on false */
+
 /* polymorph Training events false */
 
 /* polymorph Training gpus false */
@@ -81,6 +86,11 @@ type Training struct {
 func (m *Training) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDependsOn(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateEvents(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -112,6 +122,15 @@ func (m *Training) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Training) validateDependsOn(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DependsOn) { // not required
+		return nil
+	}
+
+	return nil
+}
+
 func (m *Training) validateEvents(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Events) { // not required
@@ -17,10 +17,25 @@ import (
 
 type TrainingUpdate struct {
 
-	// The status action to be executed on the training job. (Currently only `halt` is supported.)
+	// The new labels of the training job, replacing all of its current labels.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// The manifest the training was created with, required to resume it.
+	Manifest string `json:"manifest,omitempty"`
+
+	// The new priority of the training job while it is queued. Only priority administrators may set it.
+	Priority *int32 `json:"priority,omitempty"`
+
+	// The status action to be executed on the training job. (`halt` or `resume`)
 	Status string `json:"status,omitempty"`
 }
 
+/* polymorph TrainingUpdate labels false */
+
+/* polymorph TrainingUpdate manifest false */
+
+/* polymorph TrainingUpdate priority false */
+
 /* polymorph TrainingUpdate status false */
 
 // Validate validates this training update
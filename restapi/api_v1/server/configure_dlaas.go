@@ -27,10 +27,12 @@ import (
 	log "github.com/sirupsen/logrus"
   mw "github.com/IBM/FfDL/restapi/middleware"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/experiments"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/models"
 	"github.com/dre1080/recover"
 	"github.com/IBM/FfDL/commons/service"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/training_data"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/usage"
 )
 
 // This file is safe to edit. Once it exists it will not be overwritten
@@ -59,6 +61,8 @@ func configureAPI(api *operations.DlaasAPI) http.Handler {
 
 	api.BinProducer = runtime.ByteStreamProducer()
 
+	api.CsvProducer = runtime.TextProducer()
+
 	// Applies when the Authorization header is set with the Basic scheme
 	//api.BasicAuthAuth = func(user string, pass string) (interface{}, error) {
 	//	// We can assume that the basic authentication is handled by the frontend
@@ -86,6 +90,15 @@ func configureAPI(api *operations.DlaasAPI) http.Handler {
 		return api.WatsonAuthTokenAuth(token)
 	}
 
+	api.ExperimentsGetExperimentHandler = experiments.GetExperimentHandlerFunc(func(params experiments.GetExperimentParams, principal interface{}) middleware.Responder {
+		return getExperiment(params)
+	})
+	api.ExperimentsListExperimentsHandler = experiments.ListExperimentsHandlerFunc(func(params experiments.ListExperimentsParams, principal interface{}) middleware.Responder {
+		return listExperiments(params)
+	})
+	api.ExperimentsPostExperimentHandler = experiments.PostExperimentHandlerFunc(func(params experiments.PostExperimentParams, principal interface{}) middleware.Responder {
+		return postExperiment(params)
+	})
 	api.ModelsDeleteModelHandler = models.DeleteModelHandlerFunc(func(params models.DeleteModelParams, principal interface{}) middleware.Responder {
 		return deleteModel(params)
 	})
@@ -104,6 +117,12 @@ func configureAPI(api *operations.DlaasAPI) http.Handler {
 	api.ModelsGetModelHandler = models.GetModelHandlerFunc(func(params models.GetModelParams, principal interface{}) middleware.Responder {
 		return getModel(params)
 	})
+	api.ModelsGetModelHistoryHandler = models.GetModelHistoryHandlerFunc(func(params models.GetModelHistoryParams, principal interface{}) middleware.Responder {
+		return getModelHistory(params)
+	})
+	api.UsageGetUsageHandler = usage.GetUsageHandlerFunc(func(params usage.GetUsageParams, principal interface{}) middleware.Responder {
+		return getUsage(params)
+	})
 	api.ModelsListModelsHandler = models.ListModelsHandlerFunc(func(params models.ListModelsParams, principal interface{}) middleware.Responder {
 		return listModels(params)
 	})
@@ -113,6 +132,18 @@ func configureAPI(api *operations.DlaasAPI) http.Handler {
 	api.ModelsPatchModelHandler = models.PatchModelHandlerFunc(func(params models.PatchModelParams, principal interface{}) middleware.Responder {
 		return patchModel(params)
 	})
+	api.ModelsPatchModelsHandler = models.PatchModelsHandlerFunc(func(params models.PatchModelsParams, principal interface{}) middleware.Responder {
+		return patchModels(params)
+	})
+	api.ModelsDeleteModelsHandler = models.DeleteModelsHandlerFunc(func(params models.DeleteModelsParams, principal interface{}) middleware.Responder {
+		return deleteModels(params)
+	})
+	api.ModelsWatchModelHandler = models.WatchModelHandlerFunc(func(params models.WatchModelParams, principal interface{}) middleware.Responder {
+		return watchModel(params)
+	})
+	api.ModelsWatchModelsHandler = models.WatchModelsHandlerFunc(func(params models.WatchModelsParams, principal interface{}) middleware.Responder {
+		return watchModels(params)
+	})
 	api.TrainingDataGetEMetricsHandler = training_data.GetEMetricsHandlerFunc(func(params training_data.GetEMetricsParams, principal interface{}) middleware.Responder {
 		return getEMetrics(params)
 	})
@@ -32,6 +32,158 @@ func init() {
   "host": "gateway.watsonplatform.net",
   "basePath": "/",
   "paths": {
+    "/v1/experiments": {
+      "get": {
+        "description": "Get a list of all experiments of the user, most recent first.\n",
+        "tags": [
+          "Experiments"
+        ],
+        "summary": "Get a list of experiments.",
+        "operationId": "listExperiments",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "List of experiments.",
+            "schema": {
+              "$ref": "#/definitions/ExperimentList"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Runs the training described by the manifest and the model definition once for every set of parameter values of the experiment. Parameter values replace ${name} placeholders in the training command and are set as HP_<NAME> environment variables.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Experiments"
+        ],
+        "summary": "Creates a hyperparameter sweep experiment.",
+        "operationId": "postExperiment",
+        "parameters": [
+          {
+            "type": "file",
+            "description": "The deep learning model code as compressed archive (ZIP).",
+            "name": "model_definition",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The manifest providing configuration for the deep learning model, the training data and the training execution.",
+            "name": "manifest",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The experiment providing the parameters to sweep, the strategy and the objective.",
+            "name": "experiment",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Experiment successfully accepted.",
+            "schema": {
+              "$ref": "#/definitions/BasicNewExperiment"
+            },
+            "headers": {
+              "Location": {
+                "type": "string",
+                "description": "Location header containing the experiment id."
+              }
+            }
+          },
+          "400": {
+            "description": "Error in the model_definition, manifest or experiment.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/experiments/{experiment_id}": {
+      "get": {
+        "description": "Get the trials of an experiment with their parameter values, training status and objective value, and the best model so far.\n",
+        "tags": [
+          "Experiments"
+        ],
+        "summary": "Get detailed information about an experiment.",
+        "operationId": "getExperiment",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the experiment.",
+            "name": "experiment_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Detailed experiment information.",
+            "schema": {
+              "$ref": "#/definitions/Experiment"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Experiment with the given ID not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/v1/logs/{model_id}/emetrics": {
       "get": {
         "tags": [
@@ -187,6 +339,61 @@ func init() {
         "summary": "Get a list of available deep learning models.",
         "operationId": "listModels",
         "parameters": [
+          {
+            "type": "string",
+            "description": "Only list models using this framework, such as tensorflow.",
+            "name": "framework",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list models whose labels match this selector, such as team=vision,dataset!=v2.",
+            "name": "label_selector",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list models whose name starts with this prefix.",
+            "name": "name_prefix",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "Maximum number of models to return. All models are returned if not set.",
+            "name": "page_size",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The next_page_token of the previous response, to continue the listing where it ended.",
+            "name": "page_token",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Sort by submitted or name, prefixed with - for descending order. Default -submitted.",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma separated list of training statuses, such as COMPLETED,FAILED.",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list models submitted at or after this RFC3339 timestamp.",
+            "name": "submitted_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list models submitted before this RFC3339 timestamp.",
+            "name": "submitted_before",
+            "in": "query"
+          },
           {
             "type": "string",
             "default": "2017-02-13",
@@ -203,6 +410,12 @@ func init() {
               "$ref": "#/definitions/ModelList"
             }
           },
+          "400": {
+            "description": "Error in the query parameters.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "401": {
             "description": "Unauthorized",
             "schema": {
@@ -227,7 +440,7 @@ func init() {
         "parameters": [
           {
             "type": "file",
-            "description": "The deep learning model code as compressed archive (ZIP).",
+            "description": "The deep learning model code as compressed archive (ZIP). Not needed if the manifest sets model_definition.location.",
             "name": "model_definition",
             "in": "formData"
           },
@@ -245,9 +458,28 @@ func init() {
             "name": "version",
             "in": "query",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Only validate the model and return the Kubernetes objects its training would create, without starting it. Default false.",
+            "name": "dry_run",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A key chosen by the client, such as a UUID, to retry the request safely. Requests with the same key return the model created by the first one instead of training another, for 24 hours by default.",
+            "name": "Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
+          "200": {
+            "description": "The Kubernetes objects the training would create, returned for a dry run.",
+            "schema": {
+              "$ref": "#/definitions/RenderedModel"
+            }
+          },
           "201": {
             "description": "Deep learning model successfully accepted.",
             "schema": {
@@ -273,6 +505,142 @@ func init() {
             }
           }
         }
+      },
+      "delete": {
+        "description": "Deletes the models of the user whose labels match the ` + "`" + `label_selector` + "`" + `, like deleting each of them. It does not delete any data in the user's data store.\n",
+        "tags": [
+          "Models"
+        ],
+        "summary": "Deletes the models whose labels match a selector",
+        "operationId": "deleteModels",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only delete models whose labels match this selector, such as team=vision,dataset!=v2.",
+            "name": "label_selector",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The models that were deleted and why deleting the others failed.",
+            "schema": {
+              "$ref": "#/definitions/BulkResult"
+            }
+          },
+          "400": {
+            "description": "Incorrect label selector specified.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Halts the trainings of the user whose labels match the ` + "`" + `label_selector` + "`" + ` and that have not finished yet, like halting each of them. Only ` + "`" + `halt` + "`" + ` is accepted as ` + "`" + `status` + "`" + `.",
+        "tags": [
+          "Models"
+        ],
+        "summary": "Halts the trainings whose labels match a selector",
+        "operationId": "patchModels",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only halt models whose labels match this selector, such as team=vision,dataset!=v2.",
+            "name": "label_selector",
+            "in": "query",
+            "required": true
+          },
+          {
+            "description": "Accepts \"halt\" as status.",
+            "name": "payload",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TrainingUpdate"
+            }
+          },
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The models that were halted and why halting the others failed.",
+            "schema": {
+              "$ref": "#/definitions/BulkResult"
+            }
+          },
+          "400": {
+            "description": "Incorrect status or label selector specified.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/models/watch": {
+      "get": {
+        "description": "Stream the status transitions of all trainings of the user as server-sent events, or as websocket messages if the request is a websocket upgrade. Only the transitions after the start of the stream are sent.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "Models"
+        ],
+        "summary": "Watch the status of all trainings of the user.",
+        "operationId": "watchModels",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A stream of status events.",
+            "schema": {
+              "$ref": "#/definitions/StatusEvent"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
       }
     },
     "/v1/models/{model_id}": {
@@ -313,6 +681,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "The user may not set the priority of trainings.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "404": {
             "description": "Model with the given ID not found.",
             "schema": {
@@ -368,11 +742,11 @@ func init() {
         }
       },
       "patch": {
-        "description": "Changes the status of the training progress to the given ` + "`" + `status` + "`" + ` value (currently ` + "`" + `halt` + "`" + ` only). Halt means the training will be stopped and the last snapshot will be stored and can be retrieved.",
+        "description": "Changes the status of the training progress to the given ` + "`" + `status` + "`" + ` value (` + "`" + `halt` + "`" + ` or ` + "`" + `resume` + "`" + `). Halt means the training will be stopped and the last snapshot will be stored and can be retrieved. Resume means a halted training will be queued again and continue from its last checkpoint. The credentials of its data stores are erased when a training is halted, so the ` + "`" + `manifest` + "`" + ` it was created with has to be given again to resume it. If ` + "`" + `labels` + "`" + ` are given, they replace the labels of the training. If ` + "`" + `priority` + "`" + ` is given, it becomes the priority of the queued training, which only priority administrators may set.",
         "tags": [
           "Models"
         ],
-        "summary": "Changes the status of the training progress.",
+        "summary": "Changes the status, the labels or the priority of a training.",
         "operationId": "patchModel",
         "parameters": [
           {
@@ -383,7 +757,7 @@ func init() {
             "required": true
           },
           {
-            "description": "Currently accepts \"halt\" as the only valid value.",
+            "description": "Accepts \"halt\" or \"resume\" as status, the new labels, and the new priority.",
             "name": "payload",
             "in": "body",
             "required": true,
@@ -402,13 +776,13 @@ func init() {
         ],
         "responses": {
           "202": {
-            "description": "Training successfully halted.",
+            "description": "Training successfully halted, resumed, labeled or prioritized.",
             "schema": {
               "$ref": "#/definitions/BasicModel"
             }
           },
           "400": {
-            "description": "Incorrect status specified.",
+            "description": "Incorrect status, labels or priority specified.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -739,6 +1113,53 @@ func init() {
         }
       }
     },
+    "/v1/models/{model_id}/history": {
+      "get": {
+        "description": "Get the status transitions of a training, with the time spent in each status.",
+        "tags": [
+          "Models"
+        ],
+        "summary": "Get the status history of a training.",
+        "operationId": "getModelHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the model.",
+            "name": "model_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The status transitions of the training, oldest first.",
+            "schema": {
+              "$ref": "#/definitions/StatusHistory"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Model with the given ID not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/v1/models/{model_id}/logs": {
       "get": {
         "description": "Get training logs for the given model as websocket stream. Each message can contain one or more log lines.\n",
@@ -907,26 +1328,167 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Model definition",
+            "description": "Model definition",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The trained model cannot be found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "410": {
+            "description": "If the trained model storage time has expired and it has been deleted. It only gets deleted if it not stored on an external data store.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/models/{model_id}/watch": {
+      "get": {
+        "description": "Stream the status transitions of a training as server-sent events, or as websocket messages if the request is a websocket upgrade. The stream starts with the transitions the training already went through and ends when the training has finished.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "Models"
+        ],
+        "summary": "Watch the status of a training.",
+        "operationId": "watchModel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The id of the model.",
+            "name": "model_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A stream of status events.",
+            "schema": {
+              "$ref": "#/definitions/StatusEvent"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Model with the given ID not found.",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/v1/usage": {
+      "get": {
+        "description": "Get the GPU-hours, CPU-hours and memory GiB-hours consumed by the learners of finished trainings, aggregated by user or by the value of a label. A training counts towards the time window it finished in. Only the usage administrators can see the usage of other users.\n",
+        "produces": [
+          "application/json",
+          "text/csv"
+        ],
+        "tags": [
+          "Usage"
+        ],
+        "summary": "Get the resources consumed by trainings.",
+        "operationId": "getUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "2017-02-13",
+            "description": "The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.",
+            "name": "version",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only include the trainings of this user. Usage administrators get the usage of all users if it is not set, other users their own usage.",
+            "name": "user_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only include trainings with matching labels, such as team=vision,env!=test.",
+            "name": "label_selector",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only include trainings that finished at or after this RFC3339 timestamp.",
+            "name": "completed_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only include trainings that finished before this RFC3339 timestamp.",
+            "name": "completed_before",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Aggregate by the value of this label instead of by user.",
+            "name": "group_by_label",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "json",
+              "csv"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "Format of the report, json or csv. Default json.",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The usage, one entry per user or label value.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/Usage"
             }
           },
-          "401": {
-            "description": "Unauthorized",
+          "400": {
+            "description": "Incorrect parameters.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "The trained model cannot be found.",
+          "401": {
+            "description": "Unauthorized",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "410": {
-            "description": "If the trained model storage time has expired and it has been deleted. It only gets deleted if it not stored on an external data store.",
+          "403": {
+            "description": "The user may not see the usage of other users.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -955,6 +1517,19 @@ func init() {
         }
       }
     },
+    "BasicNewExperiment": {
+      "type": "object",
+      "properties": {
+        "experiment_id": {
+          "description": "A unique id of the experiment.",
+          "type": "string"
+        },
+        "location": {
+          "description": "Location of the experiment to retrieve it.",
+          "type": "string"
+        }
+      }
+    },
     "BasicNewModel": {
       "allOf": [
         {
@@ -970,6 +1545,31 @@ func init() {
         }
       ]
     },
+    "BulkResult": {
+      "type": "object",
+      "properties": {
+        "models": {
+          "description": "The models the operation was applied to.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BulkResultEntry"
+          }
+        }
+      }
+    },
+    "BulkResultEntry": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Why the operation failed for the model, empty if it succeeded.",
+          "type": "string"
+        },
+        "model_id": {
+          "description": "The id of the model.",
+          "type": "string"
+        }
+      }
+    },
     "Datastore": {
       "type": "object",
       "properties": {
@@ -1064,6 +1664,110 @@ func init() {
         }
       }
     },
+    "Experiment": {
+      "type": "object",
+      "properties": {
+        "best_model_id": {
+          "description": "The id of the completed model with the best objective value so far.",
+          "type": "string"
+        },
+        "best_value": {
+          "description": "The objective value of the best model.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "completed": {
+          "description": "Experiment completion timestamp (Format: yyyy-MM-dd'T'HH:mm:ss.SSS'Z')\n",
+          "type": "string"
+        },
+        "experiment_id": {
+          "description": "A unique id of the experiment.",
+          "type": "string"
+        },
+        "location": {
+          "description": "Location of the experiment to retrieve it.",
+          "type": "string"
+        },
+        "max_parallel": {
+          "description": "Maximum number of trials running at the same time.",
+          "type": "integer",
+          "format": "int32"
+        },
+        "name": {
+          "description": "The name of the experiment.",
+          "type": "string"
+        },
+        "objective_goal": {
+          "description": "Whether the objective metric is maximized or minimized.",
+          "type": "string"
+        },
+        "objective_metric": {
+          "description": "The evaluation metric used to select the best trial.",
+          "type": "string"
+        },
+        "state": {
+          "description": "State of the experiment (RUNNING or COMPLETED).",
+          "type": "string"
+        },
+        "strategy": {
+          "description": "How the trials are generated from the parameters (GRID, RANDOM or LIST).",
+          "type": "string"
+        },
+        "submitted": {
+          "description": "Experiment submission timestamp (Format: yyyy-MM-dd'T'HH:mm:ss.SSS'Z')\n",
+          "type": "string"
+        },
+        "trials": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ExperimentTrial"
+          }
+        }
+      }
+    },
+    "ExperimentList": {
+      "type": "object",
+      "properties": {
+        "experiments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Experiment"
+          }
+        }
+      }
+    },
+    "ExperimentTrial": {
+      "type": "object",
+      "properties": {
+        "index": {
+          "description": "The position of the trial in the experiment.",
+          "type": "integer",
+          "format": "int32"
+        },
+        "model_id": {
+          "description": "The id of the model trained for this trial. Empty until the trial has been started.",
+          "type": "string"
+        },
+        "parameters": {
+          "description": "The parameter values of this trial.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "Training status of the trial.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The last reported value of the objective metric.",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        }
+      }
+    },
     "Framework": {
       "type": "object",
       "properties": {
@@ -1122,12 +1826,27 @@ func init() {
             "framework": {
               "$ref": "#/definitions/Framework"
             },
+            "labels": {
+              "description": "User-defined labels of the model.",
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            },
             "metrics": {
               "type": "array",
               "items": {
                 "$ref": "#/definitions/MetricData"
               }
             },
+            "model_source": {
+              "description": "The location the model definition was fetched from, if it was not uploaded.",
+              "type": "string"
+            },
+            "model_source_revision": {
+              "description": "The commit SHA or object ETag of the fetched model definition.",
+              "type": "string"
+            },
             "name": {
               "description": "The name of the deep learning model.",
               "type": "string"
@@ -1147,6 +1866,10 @@ func init() {
           "items": {
             "$ref": "#/definitions/Model"
           }
+        },
+        "next_page_token": {
+          "description": "Token to request the next page of models with, not set on the last page.",
+          "type": "string"
         }
       }
     },
@@ -1160,6 +1883,99 @@ func init() {
         "ALL"
       ]
     },
+    "RenderedModel": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RenderedObject"
+          }
+        }
+      }
+    },
+    "RenderedObject": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "description": "Kind of the Kubernetes object, such as StatefulSet.",
+          "type": "string"
+        },
+        "manifest": {
+          "description": "The Kubernetes object in YAML, with the values of secrets redacted.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the Kubernetes object.",
+          "type": "string"
+        }
+      }
+    },
+    "StatusEvent": {
+      "type": "object",
+      "properties": {
+        "error_code": {
+          "description": "A code identifying the cause of a status message.",
+          "type": "string"
+        },
+        "model_id": {
+          "description": "The id of the training.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status the training entered.",
+          "type": "string"
+        },
+        "status_message": {
+          "description": "A human readable message description of the training status.",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time the training entered the status, in milliseconds since the epoch.",
+          "type": "string"
+        }
+      }
+    },
+    "StatusHistory": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/StatusHistoryEntry"
+          }
+        },
+        "model_id": {
+          "type": "string"
+        }
+      }
+    },
+    "StatusHistoryEntry": {
+      "type": "object",
+      "properties": {
+        "duration": {
+          "description": "Milliseconds the training spent in the status, up to now for the current status of an unfinished training.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error_code": {
+          "description": "A code identifying the cause of a status message.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Status of the training.",
+          "type": "string"
+        },
+        "status_message": {
+          "description": "A human readable message description of the training status.",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time the training entered the status, in milliseconds since the epoch.",
+          "type": "string"
+        }
+      }
+    },
     "Training": {
       "type": "object",
       "properties": {
@@ -1172,6 +1988,14 @@ func init() {
           "type": "number",
           "format": "double"
         },
+        "depends_on": {
+          "description": "Ids of the trainings that have to complete before this training is started. The training reads the results of the first one as its input data.",
+          "type": "array",
+          "items": {
+            "description": "Reference to a training id.",
+            "type": "string"
+          }
+        },
         "events": {
           "$ref": "#/definitions/EventList"
         },
@@ -1258,9 +2082,66 @@ func init() {
     "TrainingUpdate": {
       "type": "object",
       "properties": {
+        "labels": {
+          "description": "The new labels of the training job, replacing all of its current labels.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "manifest": {
+          "description": "The manifest the training was created with, required to resume it.",
+          "type": "string"
+        },
+        "priority": {
+          "description": "The new priority of the training job while it is queued. Only priority administrators may set it.",
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
         "status": {
-          "description": "The status action to be executed on the training job. (Currently only ` + "`" + `halt` + "`" + ` is supported.)",
+          "description": "The status action to be executed on the training job. (` + "`" + `halt` + "`" + ` or ` + "`" + `resume` + "`" + `)",
+          "type": "string"
+        }
+      }
+    },
+    "Usage": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UsageEntry"
+          }
+        }
+      }
+    },
+    "UsageEntry": {
+      "type": "object",
+      "properties": {
+        "cpu_hours": {
+          "description": "CPUs requested by the learners, multiplied by the hours they ran.",
+          "type": "number",
+          "format": "double"
+        },
+        "gpu_hours": {
+          "description": "GPUs requested by the learners, multiplied by the hours they ran.",
+          "type": "number",
+          "format": "double"
+        },
+        "key": {
+          "description": "The user, or the value of the group_by_label label, which is empty for the trainings without it.",
           "type": "string"
+        },
+        "memory_hours": {
+          "description": "Memory in GiB requested by the learners, multiplied by the hours they ran.",
+          "type": "number",
+          "format": "double"
+        },
+        "trainings": {
+          "description": "Number of finished trainings.",
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
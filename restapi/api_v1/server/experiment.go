@@ -0,0 +1,108 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ExperimentV1 represents an experiment file used to define a hyperparameter sweep over a training job
+type ExperimentV1 struct {
+	Name        string              `yaml:"name,omitempty"`
+	Strategy    string              `yaml:"strategy,omitempty"`
+	MaxTrials   int32               `yaml:"max_trials,omitempty"`
+	MaxParallel int32               `yaml:"max_parallel,omitempty"`
+	Objective   *objectiveV1        `yaml:"objective,omitempty"`
+	Parameters  []*hyperParameterV1 `yaml:"parameters,omitempty"`
+}
+
+type objectiveV1 struct {
+	Metric     string `yaml:"metric,omitempty"`
+	Grouplabel string `yaml:"grouplabel,omitempty"`
+	Goal       string `yaml:"goal,omitempty"`
+}
+
+type hyperParameterV1 struct {
+	Name    string   `yaml:"name,omitempty"`
+	Values  []string `yaml:"values,omitempty"`
+	Min     float64  `yaml:"min,omitempty"`
+	Max     float64  `yaml:"max,omitempty"`
+	Integer bool     `yaml:"integer,omitempty"`
+}
+
+const (
+	objectiveGoalMaximize = "maximize"
+	objectiveGoalMinimize = "minimize"
+)
+
+// LoadExperimentV1 constructs an experiment object from a byte array
+func LoadExperimentV1(data []byte) (*ExperimentV1, error) {
+	e := &ExperimentV1{}
+	err := yaml.Unmarshal(data, &e)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// experiment2Spec converts the experiment file to the experiment spec of the trainer microservice.
+// The parameter values themselves are validated by the trainer.
+func experiment2Spec(e *ExperimentV1) (*grpc_trainer_v2.ExperimentSpec, error) {
+	strategy := strings.ToUpper(e.Strategy)
+	if strategy == "" {
+		strategy = grpc_trainer_v2.ExperimentSpec_GRID.String()
+	}
+	strategyValue, ok := grpc_trainer_v2.ExperimentSpec_Strategy_value[strategy]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy '%s', must be one of grid, random or list", e.Strategy)
+	}
+
+	spec := &grpc_trainer_v2.ExperimentSpec{
+		Strategy:    grpc_trainer_v2.ExperimentSpec_Strategy(strategyValue),
+		MaxTrials:   e.MaxTrials,
+		MaxParallel: e.MaxParallel,
+	}
+	if e.Objective != nil {
+		goal := strings.ToLower(e.Objective.Goal)
+		if goal != "" && goal != objectiveGoalMaximize && goal != objectiveGoalMinimize {
+			return nil, fmt.Errorf("unknown objective goal '%s', must be maximize or minimize", e.Objective.Goal)
+		}
+		spec.Objective = &grpc_trainer_v2.Objective{
+			Metric:     e.Objective.Metric,
+			Grouplabel: e.Objective.Grouplabel,
+			Minimize:   goal == objectiveGoalMinimize,
+		}
+	}
+	for _, p := range e.Parameters {
+		if p == nil {
+			continue
+		}
+		spec.Parameters = append(spec.Parameters, &grpc_trainer_v2.HyperParameter{
+			Name:    p.Name,
+			Values:  p.Values,
+			Min:     p.Min,
+			Max:     p.Max,
+			Integer: p.Integer,
+		})
+	}
+	return spec, nil
+}
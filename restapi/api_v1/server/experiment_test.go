@@ -0,0 +1,65 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExperiment2Spec(t *testing.T) {
+	e, err := LoadExperimentV1([]byte(`
+name: lr-sweep
+strategy: random
+max_trials: 10
+max_parallel: 2
+objective:
+  metric: accuracy
+  grouplabel: test
+  goal: maximize
+parameters:
+  - name: lr
+    min: 0.001
+    max: 0.1
+  - name: optimizer
+    values: [sgd, adam]
+`))
+	assert.NoError(t, err)
+
+	spec, err := experiment2Spec(e)
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_trainer_v2.ExperimentSpec_RANDOM, spec.Strategy)
+	assert.EqualValues(t, 10, spec.MaxTrials)
+	assert.EqualValues(t, 2, spec.MaxParallel)
+	assert.Equal(t, "accuracy", spec.Objective.Metric)
+	assert.False(t, spec.Objective.Minimize)
+	if assert.Len(t, spec.Parameters, 2) {
+		assert.Equal(t, 0.1, spec.Parameters[0].Max)
+		assert.Equal(t, []string{"sgd", "adam"}, spec.Parameters[1].Values)
+	}
+
+	e.Strategy = "bayesian"
+	_, err = experiment2Spec(e)
+	assert.Error(t, err)
+
+	e.Strategy = ""
+	e.Objective.Goal = "best"
+	_, err = experiment2Spec(e)
+	assert.Error(t, err)
+}
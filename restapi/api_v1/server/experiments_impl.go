@@ -0,0 +1,241 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/experiments"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// postExperiment posts a model definition together with an experiment file and starts the hyperparameter sweep
+func postExperiment(params experiments.PostExperimentParams) middleware.Responder {
+	logr := logger.LocLogger(logWithPostExperimentParams(params))
+	logr.Debugf("postExperiment invoked: %v", params.HTTPRequest.Header)
+
+	badRequest := func(description string, err error) middleware.Responder {
+		return experiments.NewPostExperimentBadRequest().WithPayload(&restmodels.Error{
+			Code:        400,
+			Description: description,
+			Error:       err.Error(),
+		})
+	}
+
+	manifestBytes, err := ioutil.ReadAll(params.Manifest.Data)
+	if err != nil {
+		logr.WithError(err).Error("Cannot read 'manifest' parameter")
+		return badRequest("Incorrect parameters", err)
+	}
+	manifest, err := LoadManifestV1(manifestBytes)
+	if err != nil {
+		logr.WithError(err).Error("Parameter 'manifest' contains incorrect YAML")
+		return badRequest("Incorrect manifest", err)
+	}
+	if len(manifest.DataStores) != 1 {
+		return badRequest("Incorrect manifest", fmt.Errorf("please specify exactly one data_store in the manifest"))
+	}
+
+	experimentBytes, err := ioutil.ReadAll(params.Experiment.Data)
+	if err != nil {
+		logr.WithError(err).Error("Cannot read 'experiment' parameter")
+		return badRequest("Incorrect parameters", err)
+	}
+	experiment, err := LoadExperimentV1(experimentBytes)
+	if err != nil {
+		logr.WithError(err).Error("Parameter 'experiment' contains incorrect YAML")
+		return badRequest("Incorrect experiment", err)
+	}
+	spec, err := experiment2Spec(experiment)
+	if err != nil {
+		return badRequest("Incorrect experiment", err)
+	}
+
+	modelDefinition, err := ioutil.ReadAll(params.ModelDefinition.Data)
+	if err != nil {
+		logr.WithError(err).Error("Cannot read 'model_definition' parameter")
+		return badRequest("Incorrect parameters", err)
+	}
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	defer trainer.Close()
+
+	name := experiment.Name
+	if name == "" {
+		name = manifest.Name
+	}
+	eresp, err := trainer.Client().CreateExperiment(params.HTTPRequest.Context(), &grpc_trainer_v2.CreateExperimentRequest{
+		UserId: getUserID(params.HTTPRequest),
+		Name:   name,
+		Base:   manifest2TrainingRequest(manifest, modelDefinition, params.HTTPRequest, logr),
+		Spec:   spec,
+	})
+	if err != nil {
+		logr.WithError(err).Errorf("Trainer CreateExperiment service call failed")
+		if grpc.Code(err) == codes.InvalidArgument || grpc.Code(err) == codes.NotFound {
+			return experiments.NewPostExperimentBadRequest().WithPayload(&restmodels.Error{
+				Code:        400,
+				Description: "",
+				Error:       grpc.ErrorDesc(err),
+			})
+		}
+		if grpc.Code(err) == codes.ResourceExhausted {
+			return experiments.NewPostExperimentBadRequest().WithPayload(&restmodels.Error{
+				Code:        http.StatusTooManyRequests,
+				Description: grpc.ErrorDesc(err),
+				Error:       grpc.ErrorDesc(err),
+			})
+		}
+		return error500(logr, "")
+	}
+
+	loc := params.HTTPRequest.URL.Path + "/" + eresp.ExperimentId
+	return experiments.NewPostExperimentCreated().
+		WithLocation(loc).
+		WithPayload(&restmodels.BasicNewExperiment{
+			ExperimentID: eresp.ExperimentId,
+			Location:     loc,
+		})
+}
+
+func getExperiment(params experiments.GetExperimentParams) middleware.Responder {
+	logr := logger.LocLogger(logWithGetExperimentParams(params))
+	logr.Debugf("getExperiment invoked: %v", params.HTTPRequest.Header)
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	defer trainer.Close()
+
+	resp, err := trainer.Client().GetExperiment(params.HTTPRequest.Context(), &grpc_trainer_v2.GetExperimentRequest{
+		ExperimentId: params.ExperimentID,
+		UserId:       getUserID(params.HTTPRequest),
+	})
+	if err != nil {
+		logr.WithError(err).Errorf("Trainer GetExperiment service call failed")
+		if grpc.Code(err) == codes.PermissionDenied {
+			return experiments.NewGetExperimentUnauthorized().WithPayload(&restmodels.Error{
+				Error:       "Unauthorized",
+				Code:        http.StatusUnauthorized,
+				Description: "",
+			})
+		}
+		if grpc.Code(err) == codes.NotFound {
+			return experiments.NewGetExperimentNotFound().WithPayload(&restmodels.Error{
+				Error:       "Not found",
+				Code:        http.StatusNotFound,
+				Description: "",
+			})
+		}
+		return error500(logr, "")
+	}
+
+	return experiments.NewGetExperimentOK().WithPayload(
+		createExperiment(params.HTTPRequest.URL.Path, resp.Experiment))
+}
+
+func listExperiments(params experiments.ListExperimentsParams) middleware.Responder {
+	logr := logger.LocLogger(logWithListExperimentsParams(params))
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	defer trainer.Close()
+
+	resp, err := trainer.Client().GetAllExperiments(params.HTTPRequest.Context(), &grpc_trainer_v2.GetAllExperimentsRequest{
+		UserId: getUserID(params.HTTPRequest),
+	})
+	if err != nil {
+		logr.WithError(err).Error("Trainer GetAllExperiments service call failed")
+		if grpc.Code(err) == codes.PermissionDenied {
+			return experiments.NewListExperimentsUnauthorized().WithPayload(&restmodels.Error{
+				Error:       "Unauthorized",
+				Code:        http.StatusUnauthorized,
+				Description: "",
+			})
+		}
+		return error500(logr, "")
+	}
+
+	earr := make([]*restmodels.Experiment, 0, len(resp.Experiments))
+	for _, e := range resp.Experiments {
+		earr = append(earr, createExperiment(params.HTTPRequest.URL.Path+"/"+e.ExperimentId, e))
+	}
+	return experiments.NewListExperimentsOK().WithPayload(&restmodels.ExperimentList{
+		Experiments: earr,
+	})
+}
+
+// createExperiment converts the trainer's experiment to its REST representation located at loc
+func createExperiment(loc string, e *grpc_trainer_v2.Experiment) *restmodels.Experiment {
+	m := &restmodels.Experiment{
+		ExperimentID: e.ExperimentId,
+		Location:     loc,
+		Name:         e.Name,
+		State:        e.State.String(),
+		Submitted:    e.SubmissionTimestamp,
+		Completed:    e.CompletionTimestamp,
+		BestModelID:  e.BestTrainingId,
+		Trials:       make([]*restmodels.ExperimentTrial, 0, len(e.Trials)),
+	}
+	if e.BestTrainingId != "" {
+		bestValue := e.BestValue
+		m.BestValue = &bestValue
+	}
+	if e.Spec != nil {
+		m.Strategy = e.Spec.Strategy.String()
+		m.MaxParallel = e.Spec.MaxParallel
+		if e.Spec.Objective != nil {
+			m.ObjectiveMetric = e.Spec.Objective.Metric
+			m.ObjectiveGoal = objectiveGoalMaximize
+			if e.Spec.Objective.Minimize {
+				m.ObjectiveGoal = objectiveGoalMinimize
+			}
+		}
+	}
+	for _, t := range e.Trials {
+		trial := &restmodels.ExperimentTrial{
+			Index:      t.Index,
+			ModelID:    t.TrainingId,
+			Parameters: t.Parameters,
+			Status:     t.Status.String(),
+		}
+		if t.HasValue {
+			value := t.Value
+			trial.Value = &value
+		}
+		m.Trials = append(m.Trials, trial)
+	}
+	return m
+}
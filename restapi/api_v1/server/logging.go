@@ -22,7 +22,9 @@ import (
 	"github.com/IBM/FfDL/commons/logger"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/models"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/events"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/experiments"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/training_data"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/usage"
 )
 
 func logWithPostModelParams(params models.PostModelParams) *log.Entry {
@@ -43,6 +45,22 @@ func logWithDeleteModelParams(params models.DeleteModelParams) *log.Entry {
 	return &log.Entry{Logger: log.StandardLogger(), Data: data}
 }
 
+func logWithDeleteModelsParams(params models.DeleteModelsParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
+func logWithPatchModelsParams(params models.PatchModelsParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
 func logWithGetModelParams(params models.GetModelParams) *log.Entry {
 	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
 
@@ -52,6 +70,40 @@ func logWithGetModelParams(params models.GetModelParams) *log.Entry {
 	return &log.Entry{Logger: log.StandardLogger(), Data: data}
 }
 
+func logWithGetModelHistoryParams(params models.GetModelHistoryParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+	data[logger.LogkeyTrainingID] = params.ModelID
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
+func logWithGetUsageParams(params usage.GetUsageParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
+func logWithWatchModelParams(params models.WatchModelParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+	data[logger.LogkeyTrainingID] = params.ModelID
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
+func logWithWatchModelsParams(params models.WatchModelsParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
 func logWithDownloadModelDefinitionParams(params models.DownloadModelDefinitionParams) *log.Entry {
 	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
 
@@ -162,3 +214,28 @@ func logWithGetEventEndpointParams(params events.GetEventEndpointParams) *log.En
 	data["endpoint ID"] = params.EndpointID
 	return &log.Entry{Logger: log.StandardLogger(), Data: data}
 }
+
+func logWithPostExperimentParams(params experiments.PostExperimentParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+	data[logger.LogkeyModelFilename] = params.Manifest.Header.Filename
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
+func logWithGetExperimentParams(params experiments.GetExperimentParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+	data["experiment_id"] = params.ExperimentID
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
+
+func logWithListExperimentsParams(params experiments.ListExperimentsParams) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyRestAPIService)
+	data[logger.LogkeyUserID] = getUserID(params.HTTPRequest)
+
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
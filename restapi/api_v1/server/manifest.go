@@ -32,18 +32,30 @@ import (
 
 // ManifestV1 represents a manifest used to define the configurations for a training job
 type ManifestV1 struct {
-	Name              string            `yaml:"name,omitempty"`
-	Description       string            `yaml:"description,omitempty"`
-	Version           string            `yaml:"version,omitempty"`
-	Cpus              float64           `yaml:"cpus,omitempty"`
-	Gpus              float64           `yaml:"gpus,omitempty"`
-	Gpu_type	  string	    `yaml:"gpu_type,omitempty"`
-	Learners          int32             `yaml:"learners,omitempty"`
-	Memory            string            `yaml:"memory,omitempty"`
-	Storage           string            `yaml:"storage,omitempty"`
-	DataStores        []*dataStoreRef   `yaml:"data_stores,omitempty"`
-	Framework         *frameworkV1      `yaml:"framework,omitempty"`
-	EvaluationMetrics *EMExtractionSpec `yaml:"evaluation_metrics,omitempty"`
+	Name              string                     `yaml:"name,omitempty"`
+	Description       string                     `yaml:"description,omitempty"`
+	Version           string                     `yaml:"version,omitempty"`
+	Cpus              float64                    `yaml:"cpus,omitempty"`
+	Gpus              float64                    `yaml:"gpus,omitempty"`
+	Gpu_type          string                     `yaml:"gpu_type,omitempty"`
+	Learners          int32                      `yaml:"learners,omitempty"`
+	Memory            string                     `yaml:"memory,omitempty"`
+	Storage           string                     `yaml:"storage,omitempty"`
+	Schedpolicy       string                     `yaml:"schedpolicy,omitempty"`
+	Architecture      string                     `yaml:"architecture,omitempty"`
+	NodeSelector      map[string]string          `yaml:"node_selector,omitempty"`
+	Tolerations       []*tolerationV1            `yaml:"tolerations,omitempty"`
+	DataStores        []*dataStoreRef            `yaml:"data_stores,omitempty"`
+	Framework         *frameworkV1               `yaml:"framework,omitempty"`
+	EvaluationMetrics *EMExtractionSpec          `yaml:"evaluation_metrics,omitempty"`
+	Priority          int32                      `yaml:"priority,omitempty"`
+	DependsOn         []string                   `yaml:"depends_on,omitempty"`
+	Retry             *retryPolicyV1             `yaml:"retry,omitempty"`
+	MaxDuration       string                     `yaml:"max_duration,omitempty"`
+	Labels            map[string]string          `yaml:"labels,omitempty"`
+	ModelDefinition   *modelDefinitionV1         `yaml:"model_definition,omitempty"`
+	Env               map[string]string          `yaml:"env,omitempty"`
+	SecretEnv         map[string]*secretKeyRefV1 `yaml:"secret_env,omitempty"`
 }
 
 // EMExtractionSpec specifies which log-collector is run, and how the evaluation metrics are extracted.
@@ -93,6 +105,32 @@ type frameworkV1 struct {
 	Command  string `yaml:"command,omitempty"`
 }
 
+type retryPolicyV1 struct {
+	MaxAttempts int32    `yaml:"max_attempts,omitempty"`
+	ErrorCodes  []string `yaml:"error_codes,omitempty"`
+	Backoff     int32    `yaml:"backoff,omitempty"`
+}
+
+// modelDefinitionV1 locates a model definition that is fetched by the trainer rather than uploaded with the manifest
+type modelDefinitionV1 struct {
+	// git+<url>[#<branch, tag or commit>] or datastore://<data store id>/<container>/<object>
+	Location string `yaml:"location,omitempty"`
+}
+
+// secretKeyRefV1 refers to a key of a Kubernetes secret of the user, which carries the label user_id=<user id>
+type secretKeyRefV1 struct {
+	Secret string `yaml:"secret,omitempty"`
+	Key    string `yaml:"key,omitempty"`
+}
+
+// tolerationV1 lets the learners run on nodes with a matching taint
+type tolerationV1 struct {
+	Key      string `yaml:"key,omitempty"`
+	Operator string `yaml:"operator,omitempty"`
+	Value    string `yaml:"value,omitempty"`
+	Effect   string `yaml:"effect,omitempty"`
+}
+
 type storageContainerV1 struct {
 	Container string `yaml:"container,omitempty"`
 }
@@ -169,7 +207,9 @@ func manifest2TrainingRequest(m *ManifestV1, modelDefinition []byte, http *http.
 	logr *logger.LocLoggingEntry) *grpc_trainer_v2.CreateRequest {
 
 	r := &grpc_trainer_v2.CreateRequest{
-		UserId: getUserID(http),
+		UserId:   getUserID(http),
+		Priority: m.Priority,
+		Labels:   m.Labels,
 		ModelDefinition: &grpc_trainer_v2.ModelDefinition{
 			Name:        m.Name,
 			Description: m.Description,
@@ -178,35 +218,36 @@ func manifest2TrainingRequest(m *ManifestV1, modelDefinition []byte, http *http.
 				Version:  m.Framework.Version,
 				ImageTag: m.Framework.ImageTag,
 			},
-			Content: modelDefinition,
+			Content:  modelDefinition,
+			Location: modelLocation(m),
 		},
 		Training: &grpc_trainer_v2.Training{
-			Command:   m.Framework.Command,
-			InputData: []string{m.DataStores[0].ID + "-input"},
-			Profiling: false,
-		},
-		Datastores: []*grpc_trainer_v2.Datastore{
-			{
-				Id:         m.DataStores[0].ID + "-input",
-				Type:       m.DataStores[0].Type,
-				Connection: m.DataStores[0].Connection,
-				Fields: map[string]string{
-					"bucket": m.DataStores[0].TrainingData.Container,
-				},
-			},
+			Command:     m.Framework.Command,
+			DependsOn:   m.DependsOn,
+			MaxDuration: m.MaxDuration,
+			Profiling:   false,
+			Env:         m.Env,
 		},
 	}
 
-	if m.DataStores[0].TrainingResults != nil {
-		r.Training.OutputData = []string{m.DataStores[0].ID + "-output"}
-		r.Datastores = append(r.Datastores, &grpc_trainer_v2.Datastore{
-			Id:         m.DataStores[0].ID + "-output",
-			Type:       m.DataStores[0].Type,
-			Connection: m.DataStores[0].Connection,
-			Fields: map[string]string{
-				"bucket": m.DataStores[0].TrainingResults.Container,
-			},
-		})
+	for name, ref := range m.SecretEnv {
+		if r.Training.SecretEnv == nil {
+			r.Training.SecretEnv = make(map[string]*grpc_trainer_v2.SecretKeyRef)
+		}
+		if ref == nil {
+			ref = &secretKeyRefV1{}
+		}
+		r.Training.SecretEnv[name] = &grpc_trainer_v2.SecretKeyRef{Secret: ref.Secret, Key: ref.Key}
+	}
+
+	r.Datastores, r.Training.InputData, r.Training.OutputData = manifestDatastores(m)
+
+	if m.Retry != nil {
+		r.Training.RetryPolicy = &grpc_trainer_v2.RetryPolicy{
+			MaxAttempts:    m.Retry.MaxAttempts,
+			ErrorCodes:     m.Retry.ErrorCodes,
+			BackoffSeconds: m.Retry.Backoff,
+		}
 	}
 
 	mem, memUnit, err := convertMemoryFromManifest(m.Memory)
@@ -223,16 +264,30 @@ func manifest2TrainingRequest(m *ManifestV1, modelDefinition []byte, http *http.
 	}
 
 	r.Training.Resources = &grpc_trainer_v2.ResourceRequirements{
-		Gpus:        float32(m.Gpus),
-		GpuType:     string(m.Gpu_type),
-		Cpus:        float32(m.Cpus),
-		Memory:      mem,
-		MemoryUnit:  memUnit,
-		Storage:     storage,
-		StorageUnit: storageUnit,
-		Learners:    m.Learners,
+		Gpus:         float32(m.Gpus),
+		GpuType:      string(m.Gpu_type),
+		Cpus:         float32(m.Cpus),
+		Memory:       mem,
+		MemoryUnit:   memUnit,
+		Storage:      storage,
+		StorageUnit:  storageUnit,
+		Learners:     m.Learners,
+		Schedpolicy:  m.Schedpolicy,
+		Architecture: m.Architecture,
+		NodeSelector: m.NodeSelector,
 		// TODO add storage support
 	}
+	for _, t := range m.Tolerations {
+		if t == nil {
+			t = &tolerationV1{}
+		}
+		r.Training.Resources.Tolerations = append(r.Training.Resources.Tolerations, &grpc_trainer_v2.Toleration{
+			Key:      t.Key,
+			Operator: t.Operator,
+			Value:    t.Value,
+			Effect:   t.Effect,
+		})
+	}
 
 	if m.EvaluationMetrics != nil {
 		err = validateEvaluationMetricsSpec(m)
@@ -247,6 +302,66 @@ func manifest2TrainingRequest(m *ManifestV1, modelDefinition []byte, http *http.
 	return r
 }
 
+// manifestDatastores returns the data stores of the manifest with the ids the trainer knows them by, and the ids of
+// the input and output data. Every data store is an input, an output or both. The first ones are where DATA_DIR and
+// RESULT_DIR point to.
+func manifestDatastores(m *ManifestV1) (datastores []*grpc_trainer_v2.Datastore, inputData []string, outputData []string) {
+	for _, ds := range m.DataStores {
+		if ds.TrainingData != nil {
+			inputData = append(inputData, ds.ID+"-input")
+			datastores = append(datastores, &grpc_trainer_v2.Datastore{
+				Id:         ds.ID + "-input",
+				Type:       ds.Type,
+				Connection: ds.Connection,
+				Fields: map[string]string{
+					"bucket": ds.TrainingData.Container,
+				},
+			})
+		}
+		if ds.TrainingResults != nil {
+			outputData = append(outputData, ds.ID+"-output")
+			datastores = append(datastores, &grpc_trainer_v2.Datastore{
+				Id:         ds.ID + "-output",
+				Type:       ds.Type,
+				Connection: ds.Connection,
+				Fields: map[string]string{
+					"bucket": ds.TrainingResults.Container,
+				},
+			})
+		}
+	}
+	return datastores, inputData, outputData
+}
+
+// modelLocation returns the location of the model definition in the manifest, with the data store of a
+// datastore:// location referred to by the id the trainer knows it by
+func modelLocation(m *ManifestV1) string {
+	if m.ModelDefinition == nil {
+		return ""
+	}
+	location := m.ModelDefinition.Location
+	if !strings.HasPrefix(location, "datastore://") {
+		return location
+	}
+	path := strings.TrimPrefix(location, "datastore://")
+	i := strings.Index(path, "/")
+	if i < 0 {
+		return location
+	}
+	for _, ds := range m.DataStores {
+		if ds.ID != path[:i] {
+			continue
+		}
+		if ds.TrainingData != nil {
+			return "datastore://" + ds.ID + "-input" + path[i:]
+		}
+		if ds.TrainingResults != nil {
+			return "datastore://" + ds.ID + "-output" + path[i:]
+		}
+	}
+	return location
+}
+
 // Converts an incoming memory spec like 4GB or 1024mb into a memory (float64)
 // and a unit.
 // Rules for conversion:
@@ -19,6 +19,10 @@
 import (
 	"testing"
 	"io/ioutil"
+	"net/http/httptest"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,3 +39,114 @@ func TestLoadManifestV1(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, m)
 }
+
+func TestModelLocation(t *testing.T) {
+	m, err := LoadManifestV1([]byte(`
+name: mnist
+data_stores:
+  - id: code
+    type: s3_datastore
+    training_data:
+      container: code
+  - id: results
+    type: s3_datastore
+    training_results:
+      container: results
+model_definition:
+  location: datastore://code/models/mnist.zip
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, "datastore://code-input/models/mnist.zip", modelLocation(m))
+
+	m.ModelDefinition.Location = "datastore://results/models/mnist.zip"
+	assert.Equal(t, "datastore://results-output/models/mnist.zip", modelLocation(m))
+
+	m.ModelDefinition.Location = "git+https://github.com/IBM/FfDL.git#master"
+	assert.Equal(t, "git+https://github.com/IBM/FfDL.git#master", modelLocation(m))
+
+	m.ModelDefinition = nil
+	assert.Empty(t, modelLocation(m))
+}
+
+func TestManifestEnv(t *testing.T) {
+	m, err := LoadManifestV1([]byte(`
+name: mnist
+framework:
+  name: tensorflow
+  version: "1.5"
+  command: python train.py
+env:
+  NCCL_DEBUG: INFO
+  NUM_EPOCHS: 10
+secret_env:
+  WANDB_API_KEY:
+    secret: wandb
+    key: api_key
+`))
+	assert.NoError(t, err)
+
+	req := manifest2TrainingRequest(m, nil, httptest.NewRequest("POST", "/v1/models", nil),
+		logger.LocLogger(logger.LogServiceBasic(logger.LogkeyRestAPIService)))
+	assert.Equal(t, map[string]string{"NCCL_DEBUG": "INFO", "NUM_EPOCHS": "10"}, req.Training.Env)
+	assert.Equal(t, map[string]*grpc_trainer_v2.SecretKeyRef{"WANDB_API_KEY": {Secret: "wandb", Key: "api_key"}},
+		req.Training.SecretEnv)
+}
+
+func TestManifestScheduling(t *testing.T) {
+	m, err := LoadManifestV1([]byte(`
+name: mnist
+learners: 2
+schedpolicy: spread
+architecture: ppc64le
+node_selector:
+  pool: training
+tolerations:
+  - key: dedicated
+    value: ffdl
+    effect: NoSchedule
+  - key: preemptible
+    operator: Exists
+framework:
+  name: tensorflow
+  version: "1.5"
+  command: python train.py
+`))
+	assert.NoError(t, err)
+
+	req := manifest2TrainingRequest(m, nil, httptest.NewRequest("POST", "/v1/models", nil),
+		logger.LocLogger(logger.LogServiceBasic(logger.LogkeyRestAPIService)))
+	assert.Equal(t, "spread", req.Training.Resources.Schedpolicy)
+	assert.Equal(t, "ppc64le", req.Training.Resources.Architecture)
+	assert.Equal(t, map[string]string{"pool": "training"}, req.Training.Resources.NodeSelector)
+	assert.Equal(t, []*grpc_trainer_v2.Toleration{
+		{Key: "dedicated", Value: "ffdl", Effect: "NoSchedule"},
+		{Key: "preemptible", Operator: "Exists"},
+	}, req.Training.Resources.Tolerations)
+}
+
+func TestManifestDatastores(t *testing.T) {
+	m, err := LoadManifestV1([]byte(`
+name: mnist
+data_stores:
+  - id: cos
+    type: s3_datastore
+    training_data:
+      container: data
+    training_results:
+      container: results
+    connection:
+      auth_url: http://s3.example.com
+      user_name: alice
+      password: secret
+`))
+	assert.NoError(t, err)
+
+	datastores, inputData, outputData := manifestDatastores(m)
+	assert.Equal(t, []string{"cos-input"}, inputData)
+	assert.Equal(t, []string{"cos-output"}, outputData)
+	if assert.Len(t, datastores, 2) {
+		assert.Equal(t, "data", datastores[0].Fields["bucket"])
+		assert.Equal(t, "results", datastores[1].Fields["bucket"])
+		assert.Equal(t, "secret", datastores[1].Connection["password"])
+	}
+}
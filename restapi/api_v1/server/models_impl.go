@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -53,6 +54,9 @@ import (
 
 const (
 	defaultLogPageSize = 10
+
+	// priorityAdminsKey is the comma separated list of users who may set the priority of trainings
+	priorityAdminsKey = "priority_admins"
 )
 
 // postModel posts a model definition and starts the training
@@ -81,23 +85,31 @@ func postModel(params models.PostModelParams) middleware.Responder {
 		})
 	}
 
-	modelDefinition, err := ioutil.ReadAll(params.ModelDefinition.Data)
-	if err != nil {
-		logr.Errorf("Cannot read 'model_definition' parameter: %s", err.Error())
+	var modelDefinition []byte
+	if params.ModelDefinition != nil {
+		modelDefinition, err = ioutil.ReadAll(params.ModelDefinition.Data)
+		if err != nil {
+			logr.Errorf("Cannot read 'model_definition' parameter: %s", err.Error())
+			return models.NewPostModelBadRequest().WithPayload(&restmodels.Error{
+				Code:        400,
+				Description: "Incorrect parameters",
+				Error:       err.Error(),
+			})
+		}
+	}
+	if len(modelDefinition) == 0 && (manifest.ModelDefinition == nil || manifest.ModelDefinition.Location == "") {
 		return models.NewPostModelBadRequest().WithPayload(&restmodels.Error{
 			Code:        400,
 			Description: "Incorrect parameters",
-			Error:       err.Error(),
+			Error:       "Please upload the model_definition or set model_definition.location in the manifest.",
 		})
 	}
 
-	// TODO this is an interim HACK b/c with the new rest API in combination with the OLD
-	// manifest we do not have a way to select the from a list of dataStores so we cap it to only one.
-	if len(manifest.DataStores) > 1 {
+	if len(manifest.DataStores) == 0 {
 		return models.NewPostModelBadRequest().WithPayload(&restmodels.Error{
 			Code:        400,
 			Description: "",
-			Error:       "Please only specify one data_store in the manifest. This constraint will go away with the once the new manifest is in place.",
+			Error:       "Please specify at least one data_store in the manifest.",
 		})
 	}
 
@@ -110,29 +122,33 @@ func postModel(params models.PostModelParams) middleware.Responder {
 
 	// TODO do some basic manifest.yml validation to avoid a panic
 
-	tresp, err := trainer.Client().CreateTrainingJob(params.HTTPRequest.Context(),
-		manifest2TrainingRequest(manifest, modelDefinition, params.HTTPRequest, logr))
+	createReq := manifest2TrainingRequest(manifest, modelDefinition, params.HTTPRequest, logr)
 
-	if err != nil {
-		logr.WithError(err).Errorf("Trainer service call failed")
-		if grpc.Code(err) == codes.InvalidArgument || grpc.Code(err) == codes.NotFound {
-			return models.NewPostModelBadRequest().WithPayload(
-				&restmodels.Error{
-					Description: "",
-					Error:       grpc.ErrorDesc(err),
-					Code:        400,
-				})
+	if params.DryRun != nil && *params.DryRun {
+		rresp, err := trainer.Client().RenderTrainingJob(params.HTTPRequest.Context(), createReq)
+		if err != nil {
+			logr.WithError(err).Errorf("Trainer service call failed")
+			return postModelError(logr, err)
 		}
-
-		if grpc.Code(err) == codes.ResourceExhausted {
-			return models.NewPostModelBadRequest().WithPayload(&restmodels.Error{
-				Code:        http.StatusTooManyRequests,
-				Description: grpc.ErrorDesc(err),
-				Error:       grpc.ErrorDesc(err),
+		objects := make([]*restmodels.RenderedObject, 0, len(rresp.Objects))
+		for _, obj := range rresp.Objects {
+			objects = append(objects, &restmodels.RenderedObject{
+				Kind:     obj.Kind,
+				Name:     obj.Name,
+				Manifest: obj.Manifest,
 			})
 		}
+		return models.NewPostModelOK().WithPayload(&restmodels.RenderedModel{Objects: objects})
+	}
 
-		return error500(logr, "")
+	if params.IdempotencyKey != nil {
+		createReq.IdempotencyKey = *params.IdempotencyKey
+	}
+	tresp, err := trainer.Client().CreateTrainingJob(params.HTTPRequest.Context(), createReq)
+
+	if err != nil {
+		logr.WithError(err).Errorf("Trainer service call failed")
+		return postModelError(logr, err)
 	}
 
 	loc := params.HTTPRequest.URL.Path + "/" + tresp.TrainingId
@@ -146,6 +162,37 @@ func postModel(params models.PostModelParams) middleware.Responder {
 		})
 }
 
+// postModelError maps the error of a trainer call creating or rendering a training to a response
+func postModelError(logr *logger.LocLoggingEntry, err error) middleware.Responder {
+	if grpc.Code(err) == codes.InvalidArgument || grpc.Code(err) == codes.NotFound {
+		return models.NewPostModelBadRequest().WithPayload(
+			&restmodels.Error{
+				Description: "",
+				Error:       grpc.ErrorDesc(err),
+				Code:        400,
+			})
+	}
+
+	if grpc.Code(err) == codes.ResourceExhausted {
+		return models.NewPostModelBadRequest().WithPayload(&restmodels.Error{
+			Code:        http.StatusTooManyRequests,
+			Description: grpc.ErrorDesc(err),
+			Error:       grpc.ErrorDesc(err),
+		})
+	}
+
+	// a request with the same idempotency key is still creating its training
+	if grpc.Code(err) == codes.Aborted {
+		return models.NewPostModelBadRequest().WithPayload(&restmodels.Error{
+			Code:        http.StatusConflict,
+			Description: grpc.ErrorDesc(err),
+			Error:       grpc.ErrorDesc(err),
+		})
+	}
+
+	return error500(logr, "")
+}
+
 func deleteModel(params models.DeleteModelParams) middleware.Responder {
 	logr := logger.LocLogger(logWithDeleteModelParams(params))
 	logr.Debugf("deleteModel invoked: %v", params.HTTPRequest.Header)
@@ -236,6 +283,207 @@ func getModel(params models.GetModelParams) middleware.Responder {
 	return models.NewGetModelOK().WithPayload(m)
 }
 
+func getModelHistory(params models.GetModelHistoryParams) middleware.Responder {
+	logr := logger.LocLogger(logWithGetModelHistoryParams(params))
+	logr.Debugf("getModelHistory invoked: %v", params.HTTPRequest.Header)
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	defer trainer.Close()
+
+	resp, err := trainer.Client().GetTrainingStatusHistory(params.HTTPRequest.Context(), &grpc_trainer_v2.GetRequest{
+		TrainingId: params.ModelID,
+		UserId:     getUserID(params.HTTPRequest),
+	})
+	if err != nil {
+		logr.WithError(err).Errorf("Trainer GetTrainingStatusHistory service call failed")
+		if grpc.Code(err) == codes.PermissionDenied {
+			return models.NewGetModelHistoryUnauthorized().WithPayload(&restmodels.Error{
+				Error:       "Unauthorized",
+				Code:        http.StatusUnauthorized,
+				Description: "",
+			})
+		}
+		if grpc.Code(err) == codes.NotFound {
+			return models.NewGetModelHistoryNotFound().WithPayload(&restmodels.Error{
+				Error:       "Not found",
+				Code:        http.StatusNotFound,
+				Description: "",
+			})
+		}
+		return error500(logr, "")
+	}
+	return models.NewGetModelHistoryOK().WithPayload(createStatusHistory(resp))
+}
+
+func createStatusHistory(resp *grpc_trainer_v2.StatusHistoryResponse) *restmodels.StatusHistory {
+	history := &restmodels.StatusHistory{
+		ModelID: resp.TrainingId,
+		Entries: []*restmodels.StatusHistoryEntry{},
+	}
+	for _, e := range resp.Entries {
+		history.Entries = append(history.Entries, &restmodels.StatusHistoryEntry{
+			Status:        e.Status.String(),
+			Timestamp:     e.Timestamp,
+			Duration:      e.Duration,
+			StatusMessage: e.StatusMessage,
+			ErrorCode:     e.ErrorCode,
+		})
+	}
+	return history
+}
+
+// watchModel streams the status transitions of a training until it has finished
+func watchModel(params models.WatchModelParams) middleware.Responder {
+	logr := logger.LocLogger(logWithWatchModelParams(params))
+	logr.Debugf("watchModel invoked: %v", params.HTTPRequest.Header)
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+
+	stream, err := trainer.Client().WatchTrainingJob(ctx, &grpc_trainer_v2.GetRequest{
+		TrainingId: params.ModelID,
+		UserId:     getUserID(params.HTTPRequest),
+	})
+	var first *grpc_trainer_v2.StatusEvent
+	if err == nil {
+		// the errors of a stream are only returned when receiving, and the stream always starts with the
+		// transitions the training already went through
+		first, err = stream.Recv()
+	}
+	if err != nil && err != io.EOF {
+		defer trainer.Close()
+		defer cancel()
+		logr.WithError(err).Errorf("Trainer WatchTrainingJob service call failed")
+		if grpc.Code(err) == codes.PermissionDenied {
+			return models.NewWatchModelUnauthorized().WithPayload(&restmodels.Error{
+				Error:       "Unauthorized",
+				Code:        http.StatusUnauthorized,
+				Description: "",
+			})
+		}
+		if grpc.Code(err) == codes.NotFound {
+			return models.NewWatchModelNotFound().WithPayload(&restmodels.Error{
+				Error:       "Not found",
+				Code:        http.StatusNotFound,
+				Description: "",
+			})
+		}
+		return error500(logr, "")
+	}
+
+	recv := func() (*grpc_trainer_v2.StatusEvent, error) {
+		if first != nil {
+			e := first
+			first = nil
+			return e, nil
+		}
+		return stream.Recv()
+	}
+	return serveStatusEvents(params.HTTPRequest, logr, trainer, recv, cancel)
+}
+
+// watchModels streams the status transitions of all trainings of the user
+func watchModels(params models.WatchModelsParams) middleware.Responder {
+	logr := logger.LocLogger(logWithWatchModelsParams(params))
+	logr.Debugf("watchModels invoked: %v", params.HTTPRequest.Header)
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+
+	stream, err := trainer.Client().WatchUserTrainingJobs(ctx, &grpc_trainer_v2.WatchRequest{
+		UserId: getUserID(params.HTTPRequest),
+	})
+	if err != nil {
+		defer trainer.Close()
+		defer cancel()
+		logr.WithError(err).Errorf("Trainer WatchUserTrainingJobs service call failed")
+		return error500(logr, "")
+	}
+	return serveStatusEvents(params.HTTPRequest, logr, trainer, stream.Recv, cancel)
+}
+
+// serveStatusEvents writes the received status events as websocket messages if the request is a websocket upgrade,
+// and as server-sent events otherwise
+func serveStatusEvents(r *http.Request, logr *logger.LocLoggingEntry, trainer trainerClient.TrainerClient,
+	recv func() (*grpc_trainer_v2.StatusEvent, error), cancel context.CancelFunc) middleware.Responder {
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		defer trainer.Close()
+		defer cancel()
+
+		if r.Header.Get("Sec-Websocket-Key") != "" {
+			websocket.Handler(func(ws *websocket.Conn) {
+				defer ws.Close()
+				for {
+					e, err := recv()
+					if err != nil {
+						if err != io.EOF {
+							logr.WithError(err).Debugf("Status event stream ended")
+						}
+						return
+					}
+					if err := websocket.JSON.Send(ws, createStatusEvent(e)); err != nil {
+						logr.WithError(err).Debugf("Cannot write status event")
+						return
+					}
+				}
+			}).ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		flusher, _ := w.(http.Flusher)
+		if flusher != nil {
+			flusher.Flush()
+		}
+		for {
+			e, err := recv()
+			if err != nil {
+				if err != io.EOF {
+					logr.WithError(err).Debugf("Status event stream ended")
+				}
+				return
+			}
+			data, err := json.Marshal(createStatusEvent(e))
+			if err != nil {
+				logr.WithError(err).Errorf("Cannot marshal status event")
+				return
+			}
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				logr.WithError(err).Debugf("Cannot write status event")
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	})
+}
+
+func createStatusEvent(e *grpc_trainer_v2.StatusEvent) *restmodels.StatusEvent {
+	return &restmodels.StatusEvent{
+		ModelID:       e.TrainingId,
+		Status:        e.Status.String(),
+		Timestamp:     e.Timestamp,
+		StatusMessage: e.StatusMessage,
+		ErrorCode:     e.ErrorCode,
+	}
+}
+
 func listModels(params models.ListModelsParams) middleware.Responder {
 	logr := logger.LocLogger(logWithGetListModelsParams(params))
 
@@ -246,13 +494,27 @@ func listModels(params models.ListModelsParams) middleware.Responder {
 	}
 	defer trainer.Close()
 
+	req, err := newGetAllRequest(params)
+	if err != nil {
+		return models.NewListModelsBadRequest().WithPayload(&restmodels.Error{
+			Error:       "Bad request",
+			Code:        http.StatusBadRequest,
+			Description: err.Error(),
+		})
+	}
+
 	logr.Debugf("Calling trainer.Client().GetAllTrainingsJobs(...)")
-	resp, err := trainer.Client().GetAllTrainingsJobs(params.HTTPRequest.Context(), &grpc_trainer_v2.GetAllRequest{
-		UserId: getUserID(params.HTTPRequest),
-	})
+	resp, err := trainer.Client().GetAllTrainingsJobs(params.HTTPRequest.Context(), req)
 
 	if err != nil {
 		logr.WithError(err).Error("Trainer readAll service call failed")
+		if grpc.Code(err) == codes.InvalidArgument {
+			return models.NewListModelsBadRequest().WithPayload(&restmodels.Error{
+				Error:       "Bad request",
+				Code:        http.StatusBadRequest,
+				Description: grpc.ErrorDesc(err),
+			})
+		}
 		if grpc.Code(err) == codes.PermissionDenied {
 			return models.NewListModelsUnauthorized().WithPayload(&restmodels.Error{
 				Error:       "Unauthorized",
@@ -271,10 +533,63 @@ func listModels(params models.ListModelsParams) middleware.Responder {
 		marr = append(marr, createModel(params.HTTPRequest, job))
 	}
 	return models.NewListModelsOK().WithPayload(&restmodels.ModelList{
-		Models: marr,
+		Models:        marr,
+		NextPageToken: resp.NextPageToken,
 	})
 }
 
+// newGetAllRequest translates the query parameters of listModels to a trainer request
+func newGetAllRequest(params models.ListModelsParams) (*grpc_trainer_v2.GetAllRequest, error) {
+	req := &grpc_trainer_v2.GetAllRequest{
+		UserId: getUserID(params.HTTPRequest),
+	}
+	if params.Status != nil {
+		for _, s := range strings.Split(*params.Status, ",") {
+			status, ok := grpc_trainer_v2.Status_value[strings.ToUpper(strings.TrimSpace(s))]
+			if !ok {
+				return nil, fmt.Errorf("unknown training status '%s'", s)
+			}
+			req.Status = append(req.Status, grpc_trainer_v2.Status(status))
+		}
+	}
+	if params.Framework != nil {
+		req.Framework = *params.Framework
+	}
+	if params.NamePrefix != nil {
+		req.NamePrefix = *params.NamePrefix
+	}
+	if params.LabelSelector != nil {
+		req.LabelSelector = *params.LabelSelector
+	}
+	for _, t := range []struct {
+		name  string
+		value *string
+		dest  *int64
+	}{
+		{"submitted_after", params.SubmittedAfter, &req.SubmittedAfter},
+		{"submitted_before", params.SubmittedBefore, &req.SubmittedBefore},
+	} {
+		if t.value == nil {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, *t.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not an RFC3339 timestamp: %s", t.name, *t.value)
+		}
+		*t.dest = parsed.UnixNano() / int64(time.Millisecond)
+	}
+	if params.Sort != nil {
+		req.Sort = *params.Sort
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	if params.PageToken != nil {
+		req.PageToken = *params.PageToken
+	}
+	return req, nil
+}
+
 func downloadModelDefinition(params models.DownloadModelDefinitionParams) middleware.Responder {
 	logr := logger.LocLogger(logWithDownloadModelDefinitionParams(params))
 	logr.Debugf("downloadModelDefinition invoked: %v", params.HTTPRequest.Header)
@@ -795,26 +1110,68 @@ func patchModel(params models.PatchModelParams) middleware.Responder {
 	logr := logger.LocLogger(logWithUpdateStatusParams(params))
 	logr.Debugf("patchModel invoked: %v", params.HTTPRequest.Header)
 
-	if params.Payload.Status != "halt" {
+	noStatus := params.Payload.Status == "" && (params.Payload.Labels != nil || params.Payload.Priority != nil)
+	if params.Payload.Status != "halt" && params.Payload.Status != "resume" && !noStatus {
 		return models.NewPatchModelBadRequest().WithPayload(&restmodels.Error{
 			Error:       "Bad request",
 			Code:        http.StatusBadRequest,
 			Description: "status parameter has incorrect value",
 		})
 	}
+	if params.Payload.Priority != nil && !isPriorityAdmin(getUserID(params.HTTPRequest)) {
+		return models.NewPatchModelForbidden().WithPayload(&restmodels.Error{
+			Error:       "Forbidden",
+			Code:        http.StatusForbidden,
+			Description: "only priority administrators can set the priority of trainings",
+		})
+	}
 
 	trainer, err := trainerClient.NewTrainer()
 	if err != nil {
 		logr.Errorf("Cannot create client for trainer service: %s", err.Error())
-		error500(logr, "")
+		return error500(logr, "")
 	}
 	defer trainer.Close()
 
-	_, err = trainer.Client().UpdateTrainingJob(params.HTTPRequest.Context(), &grpc_trainer_v2.UpdateRequest{
-		TrainingId: params.ModelID,
-		UserId:     getUserID(params.HTTPRequest),
-		Status:     grpc_trainer_v2.Status_HALTED,
-	})
+	if params.Payload.Priority != nil {
+		_, err = trainer.Client().SetTrainingJobPriority(params.HTTPRequest.Context(), &grpc_trainer_v2.PriorityRequest{
+			TrainingId: params.ModelID,
+			Priority:   *params.Payload.Priority,
+		})
+	}
+	if err == nil && params.Payload.Labels != nil {
+		_, err = trainer.Client().SetTrainingJobLabels(params.HTTPRequest.Context(), &grpc_trainer_v2.LabelsRequest{
+			TrainingId: params.ModelID,
+			UserId:     getUserID(params.HTTPRequest),
+			Labels:     params.Payload.Labels,
+		})
+	}
+	if err == nil && params.Payload.Status == "resume" {
+		resume := &grpc_trainer_v2.ResumeRequest{
+			TrainingId: params.ModelID,
+			UserId:     getUserID(params.HTTPRequest),
+		}
+		// the credentials of the data stores were erased when the training was halted
+		if params.Payload.Manifest != "" {
+			manifest, parseErr := LoadManifestV1([]byte(params.Payload.Manifest))
+			if parseErr != nil {
+				logr.WithError(parseErr).Errorf("Parameter 'manifest' contains incorrect YAML")
+				return models.NewPatchModelBadRequest().WithPayload(&restmodels.Error{
+					Error:       "Bad request",
+					Code:        http.StatusBadRequest,
+					Description: "Incorrect manifest",
+				})
+			}
+			resume.Datastores, _, _ = manifestDatastores(manifest)
+		}
+		_, err = trainer.Client().ResumeTrainingJob(params.HTTPRequest.Context(), resume)
+	} else if err == nil && params.Payload.Status == "halt" {
+		_, err = trainer.Client().UpdateTrainingJob(params.HTTPRequest.Context(), &grpc_trainer_v2.UpdateRequest{
+			TrainingId: params.ModelID,
+			UserId:     getUserID(params.HTTPRequest),
+			Status:     grpc_trainer_v2.Status_HALTED,
+		})
+	}
 	//
 	if err != nil {
 		logr.Errorf("Trainer status update service call failed: %s", err.Error())
@@ -832,12 +1189,98 @@ func patchModel(params models.PatchModelParams) middleware.Responder {
 				Description: "",
 			})
 		}
+		if grpc.Code(err) == codes.FailedPrecondition || grpc.Code(err) == codes.InvalidArgument {
+			return models.NewPatchModelBadRequest().WithPayload(&restmodels.Error{
+				Error:       "Bad request",
+				Code:        http.StatusBadRequest,
+				Description: grpc.ErrorDesc(err),
+			})
+		}
+		return error500(logr, "")
 	}
 	return models.NewPatchModelAccepted().WithPayload(&restmodels.BasicModel{
 		ModelID: params.ModelID,
 	})
 }
 
+func deleteModels(params models.DeleteModelsParams) middleware.Responder {
+	logr := logger.LocLogger(logWithDeleteModelsParams(params))
+	logr.Debugf("deleteModels invoked: %v", params.HTTPRequest.Header)
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	defer trainer.Close()
+
+	resp, err := trainer.Client().DeleteTrainingJobs(params.HTTPRequest.Context(), &grpc_trainer_v2.BulkRequest{
+		UserId:        getUserID(params.HTTPRequest),
+		LabelSelector: params.LabelSelector,
+	})
+	if err != nil {
+		logr.WithError(err).Errorf("Trainer DeleteTrainingJobs service call failed")
+		if grpc.Code(err) == codes.InvalidArgument {
+			return models.NewDeleteModelsBadRequest().WithPayload(&restmodels.Error{
+				Error:       "Bad request",
+				Code:        http.StatusBadRequest,
+				Description: grpc.ErrorDesc(err),
+			})
+		}
+		return error500(logr, "")
+	}
+	return models.NewDeleteModelsOK().WithPayload(createBulkResult(resp))
+}
+
+func patchModels(params models.PatchModelsParams) middleware.Responder {
+	logr := logger.LocLogger(logWithPatchModelsParams(params))
+	logr.Debugf("patchModels invoked: %v", params.HTTPRequest.Header)
+
+	// only halting is supported for several trainings at once
+	if params.Payload.Status != "halt" || params.Payload.Labels != nil || params.Payload.Priority != nil {
+		return models.NewPatchModelsBadRequest().WithPayload(&restmodels.Error{
+			Error:       "Bad request",
+			Code:        http.StatusBadRequest,
+			Description: "status parameter has incorrect value",
+		})
+	}
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	defer trainer.Close()
+
+	resp, err := trainer.Client().HaltTrainingJobs(params.HTTPRequest.Context(), &grpc_trainer_v2.BulkRequest{
+		UserId:        getUserID(params.HTTPRequest),
+		LabelSelector: params.LabelSelector,
+	})
+	if err != nil {
+		logr.WithError(err).Errorf("Trainer HaltTrainingJobs service call failed")
+		if grpc.Code(err) == codes.InvalidArgument {
+			return models.NewPatchModelsBadRequest().WithPayload(&restmodels.Error{
+				Error:       "Bad request",
+				Code:        http.StatusBadRequest,
+				Description: grpc.ErrorDesc(err),
+			})
+		}
+		return error500(logr, "")
+	}
+	return models.NewPatchModelsAccepted().WithPayload(createBulkResult(resp))
+}
+
+func createBulkResult(resp *grpc_trainer_v2.BulkResponse) *restmodels.BulkResult {
+	result := &restmodels.BulkResult{Models: make([]*restmodels.BulkResultEntry, 0, len(resp.Results))}
+	for _, r := range resp.Results {
+		result.Models = append(result.Models, &restmodels.BulkResultEntry{
+			ModelID: r.TrainingId,
+			Error:   r.Error,
+		})
+	}
+	return result
+}
+
 func getMetrics(params models.GetMetricsParams) middleware.Responder {
 	logsParams := models.GetLogsParams{
 		HTTPRequest: params.HTTPRequest,
@@ -869,6 +1312,11 @@ func getUserID(r *http.Request) string {
 	return r.Header.Get(mw.UserIDHeader)
 }
 
+// isPriorityAdmin returns whether userID is one of the configured priority administrators
+func isPriorityAdmin(userID string) bool {
+	return isListedUser(priorityAdminsKey, userID)
+}
+
 // Echo the data received on the WebSocket.
 func serveLogHandler(trainer trainerClient.TrainerClient, stream grpc_trainer_v2.Trainer_GetTrainedModelLogsClient,
 	logr *logger.LocLoggingEntry, cancel context.CancelFunc, isMetrics bool) websocket.Handler {
@@ -1033,7 +1481,10 @@ func createModel(req *http.Request, job *grpc_trainer_v2.Job) *restmodels.Model
 			Name:    job.ModelDefinition.Framework.Name,
 			Version: job.ModelDefinition.Framework.Version,
 		},
-		Metrics: metrics,
+		Labels:              job.Labels,
+		Metrics:             metrics,
+		ModelSource:         job.ModelDefinition.Source,
+		ModelSourceRevision: job.ModelDefinition.SourceRevision,
 		Training: &restmodels.Training{
 			Command:    job.Training.Command,
 			Cpus:       float64(job.Training.Resources.Cpus),
@@ -1043,6 +1494,7 @@ func createModel(req *http.Request, job *grpc_trainer_v2.Job) *restmodels.Model
 			Learners:   job.Training.Resources.Learners,
 			InputData:  job.Training.InputData,
 			OutputData: job.Training.OutputData,
+			DependsOn:  job.Training.DependsOn,
 			TrainingStatus: &restmodels.TrainingStatus{
 				Status:            job.Status.Status.String(),
 				StatusDescription: job.Status.Status.String(),
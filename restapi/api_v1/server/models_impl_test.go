@@ -0,0 +1,98 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/models"
+	mw "github.com/IBM/FfDL/restapi/middleware"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGetAllRequest(t *testing.T) {
+	params := models.NewListModelsParams()
+	params.HTTPRequest = httptest.NewRequest("GET", "/v1/models", nil)
+	params.Status = swag.String("completed, FAILED")
+	params.SubmittedAfter = swag.String("2018-03-01T00:00:00Z")
+	params.Sort = swag.String("-name")
+	params.PageSize = swag.Int32(20)
+	params.LabelSelector = swag.String("team=vision")
+
+	req, err := newGetAllRequest(params)
+	assert.NoError(t, err)
+	assert.Equal(t, []grpc_trainer_v2.Status{grpc_trainer_v2.Status_COMPLETED, grpc_trainer_v2.Status_FAILED}, req.Status)
+	assert.EqualValues(t, 1519862400000, req.SubmittedAfter)
+	assert.Zero(t, req.SubmittedBefore)
+	assert.Equal(t, "-name", req.Sort)
+	assert.EqualValues(t, 20, req.PageSize)
+	assert.Equal(t, "team=vision", req.LabelSelector)
+
+	params.Status = swag.String("DONE")
+	_, err = newGetAllRequest(params)
+	assert.Error(t, err)
+
+	params.Status = nil
+	params.SubmittedBefore = swag.String("yesterday")
+	_, err = newGetAllRequest(params)
+	assert.Error(t, err)
+}
+
+func TestPatchModelPriorityForbidden(t *testing.T) {
+	viper.Set(priorityAdminsKey, "carol")
+	defer viper.Set(priorityAdminsKey, "")
+
+	params := models.NewPatchModelParams()
+	params.HTTPRequest = httptest.NewRequest("PATCH", "/v1/models/training-1", nil)
+	params.HTTPRequest.Header.Set(mw.UserIDHeader, "alice")
+	params.ModelID = "training-1"
+	params.Payload = &restmodels.TrainingUpdate{Priority: swag.Int32(5)}
+
+	// a user who is not a priority administrator cannot set the priority, not even of their own trainings
+	rec := httptest.NewRecorder()
+	patchModel(params).WriteResponse(rec, runtime.JSONProducer())
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	assert.True(t, isPriorityAdmin("carol"))
+	assert.False(t, isPriorityAdmin("alice"))
+}
+
+func TestPatchModelsOnlyHalts(t *testing.T) {
+	params := models.NewPatchModelsParams()
+	params.HTTPRequest = httptest.NewRequest("PATCH", "/v1/models?label_selector=team%3Dvision", nil)
+	params.HTTPRequest.Header.Set(mw.UserIDHeader, "alice")
+	params.LabelSelector = "team=vision"
+
+	// trainings can only be halted in bulk, not resumed, labeled or prioritized
+	for _, update := range []*restmodels.TrainingUpdate{
+		{Status: "resume"},
+		{Labels: map[string]string{"team": "speech"}},
+		{Status: "halt", Priority: swag.Int32(5)},
+	} {
+		params.Payload = update
+		rec := httptest.NewRecorder()
+		patchModels(params).WriteResponse(rec, runtime.JSONProducer())
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
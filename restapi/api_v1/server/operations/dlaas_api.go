@@ -20,8 +20,10 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/events"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/experiments"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/models"
 	"github.com/IBM/FfDL/restapi/api_v1/server/operations/training_data"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/usage"
 )
 
 // NewDlaasAPI creates a new Dlaas instance
@@ -41,6 +43,7 @@ func NewDlaasAPI(spec *loads.Document) *DlaasAPI {
 		MultipartformConsumer: runtime.DiscardConsumer,
 		JSONProducer:          runtime.JSONProducer(),
 		BinProducer:           runtime.ByteStreamProducer(),
+		CsvProducer:           runtime.TextProducer(),
 /*
 		EventsCreateEventEndpointHandler: events.CreateEventEndpointHandlerFunc(func(params events.CreateEventEndpointParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation EventsCreateEventEndpoint has not yet been implemented")
@@ -51,6 +54,9 @@ func NewDlaasAPI(spec *loads.Document) *DlaasAPI {
 		ModelsDeleteModelHandler: models.DeleteModelHandlerFunc(func(params models.DeleteModelParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ModelsDeleteModel has not yet been implemented")
 		}),
+		ModelsDeleteModelsHandler: models.DeleteModelsHandlerFunc(func(params models.DeleteModelsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ModelsDeleteModels has not yet been implemented")
+		}),
 		ModelsDownloadModelDefinitionHandler: models.DownloadModelDefinitionHandlerFunc(func(params models.DownloadModelDefinitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ModelsDownloadModelDefinition has not yet been implemented")
 		}),
@@ -66,6 +72,9 @@ func NewDlaasAPI(spec *loads.Document) *DlaasAPI {
 		EventsGetEventTypeEndpointsHandler: events.GetEventTypeEndpointsHandlerFunc(func(params events.GetEventTypeEndpointsParams) middleware.Responder {
 			return middleware.NotImplemented("operation EventsGetEventTypeEndpoints has not yet been implemented")
 		}),
+		ExperimentsGetExperimentHandler: experiments.GetExperimentHandlerFunc(func(params experiments.GetExperimentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ExperimentsGetExperiment has not yet been implemented")
+		}),
 		TrainingDataGetLoglinesHandler: training_data.GetLoglinesHandlerFunc(func(params training_data.GetLoglinesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation TrainingDataGetLoglines has not yet been implemented")
 		}),
@@ -78,15 +87,36 @@ func NewDlaasAPI(spec *loads.Document) *DlaasAPI {
 		ModelsGetModelHandler: models.GetModelHandlerFunc(func(params models.GetModelParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ModelsGetModel has not yet been implemented")
 		}),
+		ModelsGetModelHistoryHandler: models.GetModelHistoryHandlerFunc(func(params models.GetModelHistoryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ModelsGetModelHistory has not yet been implemented")
+		}),
+		UsageGetUsageHandler: usage.GetUsageHandlerFunc(func(params usage.GetUsageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation UsageGetUsage has not yet been implemented")
+		}),
+		ExperimentsListExperimentsHandler: experiments.ListExperimentsHandlerFunc(func(params experiments.ListExperimentsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ExperimentsListExperiments has not yet been implemented")
+		}),
 		ModelsListModelsHandler: models.ListModelsHandlerFunc(func(params models.ListModelsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ModelsListModels has not yet been implemented")
 		}),
 		ModelsPatchModelHandler: models.PatchModelHandlerFunc(func(params models.PatchModelParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ModelsPatchModel has not yet been implemented")
 		}),
+		ModelsPatchModelsHandler: models.PatchModelsHandlerFunc(func(params models.PatchModelsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ModelsPatchModels has not yet been implemented")
+		}),
+		ExperimentsPostExperimentHandler: experiments.PostExperimentHandlerFunc(func(params experiments.PostExperimentParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ExperimentsPostExperiment has not yet been implemented")
+		}),
 		ModelsPostModelHandler: models.PostModelHandlerFunc(func(params models.PostModelParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation ModelsPostModel has not yet been implemented")
 		}),
+		ModelsWatchModelHandler: models.WatchModelHandlerFunc(func(params models.WatchModelParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ModelsWatchModel has not yet been implemented")
+		}),
+		ModelsWatchModelsHandler: models.WatchModelsHandlerFunc(func(params models.WatchModelsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ModelsWatchModels has not yet been implemented")
+		}),
 
 		// Applies when the "Authorization" header is set
 		BasicAuthTokenAuth: func(token string) (interface{}, error) {
@@ -138,6 +168,8 @@ type DlaasAPI struct {
 	JSONProducer runtime.Producer
 	// BinProducer registers a producer for a "application/octet-stream" mime type
 	BinProducer runtime.Producer
+	// CsvProducer registers a producer for a "text/csv" mime type
+	CsvProducer runtime.Producer
 
 	// BasicAuthTokenAuth registers a function that takes a token and returns a principal
 	// it performs authentication based on an api key Authorization provided in the header
@@ -160,6 +192,8 @@ type DlaasAPI struct {
 	EventsDeleteEventEndpointHandler events.DeleteEventEndpointHandler
 	// ModelsDeleteModelHandler sets the operation handler for the delete model operation
 	ModelsDeleteModelHandler models.DeleteModelHandler
+	// ModelsDeleteModelsHandler sets the operation handler for the delete models operation
+	ModelsDeleteModelsHandler models.DeleteModelsHandler
 	// ModelsDownloadModelDefinitionHandler sets the operation handler for the download model definition operation
 	ModelsDownloadModelDefinitionHandler models.DownloadModelDefinitionHandler
 	// ModelsDownloadTrainedModelHandler sets the operation handler for the download trained model operation
@@ -170,6 +204,8 @@ type DlaasAPI struct {
 	EventsGetEventEndpointHandler events.GetEventEndpointHandler
 	// EventsGetEventTypeEndpointsHandler sets the operation handler for the get event type endpoints operation
 	EventsGetEventTypeEndpointsHandler events.GetEventTypeEndpointsHandler
+	// ExperimentsGetExperimentHandler sets the operation handler for the get experiment operation
+	ExperimentsGetExperimentHandler experiments.GetExperimentHandler
 	// TrainingDataGetLoglinesHandler sets the operation handler for the get loglines operation
 	TrainingDataGetLoglinesHandler training_data.GetLoglinesHandler
 	// ModelsGetLogsHandler sets the operation handler for the get logs operation
@@ -178,12 +214,26 @@ type DlaasAPI struct {
 	ModelsGetMetricsHandler models.GetMetricsHandler
 	// ModelsGetModelHandler sets the operation handler for the get model operation
 	ModelsGetModelHandler models.GetModelHandler
+	// ModelsGetModelHistoryHandler sets the operation handler for the get model history operation
+	ModelsGetModelHistoryHandler models.GetModelHistoryHandler
+	// UsageGetUsageHandler sets the operation handler for the get usage operation
+	UsageGetUsageHandler usage.GetUsageHandler
+	// ExperimentsListExperimentsHandler sets the operation handler for the list experiments operation
+	ExperimentsListExperimentsHandler experiments.ListExperimentsHandler
 	// ModelsListModelsHandler sets the operation handler for the list models operation
 	ModelsListModelsHandler models.ListModelsHandler
 	// ModelsPatchModelHandler sets the operation handler for the patch model operation
 	ModelsPatchModelHandler models.PatchModelHandler
+	// ModelsPatchModelsHandler sets the operation handler for the patch models operation
+	ModelsPatchModelsHandler models.PatchModelsHandler
+	// ExperimentsPostExperimentHandler sets the operation handler for the post experiment operation
+	ExperimentsPostExperimentHandler experiments.PostExperimentHandler
 	// ModelsPostModelHandler sets the operation handler for the post model operation
 	ModelsPostModelHandler models.PostModelHandler
+	// ModelsWatchModelHandler sets the operation handler for the watch model operation
+	ModelsWatchModelHandler models.WatchModelHandler
+	// ModelsWatchModelsHandler sets the operation handler for the watch models operation
+	ModelsWatchModelsHandler models.WatchModelsHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -255,6 +305,10 @@ func (o *DlaasAPI) Validate() error {
 		unregistered = append(unregistered, "BinProducer")
 	}
 
+	if o.CsvProducer == nil {
+		unregistered = append(unregistered, "CsvProducer")
+	}
+
 	if o.BasicAuthTokenAuth == nil {
 		unregistered = append(unregistered, "AuthorizationAuth")
 	}
@@ -279,6 +333,10 @@ func (o *DlaasAPI) Validate() error {
 		unregistered = append(unregistered, "models.DeleteModelHandler")
 	}
 
+	if o.ModelsDeleteModelsHandler == nil {
+		unregistered = append(unregistered, "models.DeleteModelsHandler")
+	}
+
 	if o.ModelsDownloadModelDefinitionHandler == nil {
 		unregistered = append(unregistered, "models.DownloadModelDefinitionHandler")
 	}
@@ -299,6 +357,10 @@ func (o *DlaasAPI) Validate() error {
 		unregistered = append(unregistered, "events.GetEventTypeEndpointsHandler")
 	}
 
+	if o.ExperimentsGetExperimentHandler == nil {
+		unregistered = append(unregistered, "experiments.GetExperimentHandler")
+	}
+
 	if o.TrainingDataGetLoglinesHandler == nil {
 		unregistered = append(unregistered, "training_data.GetLoglinesHandler")
 	}
@@ -315,6 +377,18 @@ func (o *DlaasAPI) Validate() error {
 		unregistered = append(unregistered, "models.GetModelHandler")
 	}
 
+	if o.ModelsGetModelHistoryHandler == nil {
+		unregistered = append(unregistered, "models.GetModelHistoryHandler")
+	}
+
+	if o.UsageGetUsageHandler == nil {
+		unregistered = append(unregistered, "usage.GetUsageHandler")
+	}
+
+	if o.ExperimentsListExperimentsHandler == nil {
+		unregistered = append(unregistered, "experiments.ListExperimentsHandler")
+	}
+
 	if o.ModelsListModelsHandler == nil {
 		unregistered = append(unregistered, "models.ListModelsHandler")
 	}
@@ -323,10 +397,26 @@ func (o *DlaasAPI) Validate() error {
 		unregistered = append(unregistered, "models.PatchModelHandler")
 	}
 
+	if o.ModelsPatchModelsHandler == nil {
+		unregistered = append(unregistered, "models.PatchModelsHandler")
+	}
+
+	if o.ExperimentsPostExperimentHandler == nil {
+		unregistered = append(unregistered, "experiments.PostExperimentHandler")
+	}
+
 	if o.ModelsPostModelHandler == nil {
 		unregistered = append(unregistered, "models.PostModelHandler")
 	}
 
+	if o.ModelsWatchModelHandler == nil {
+		unregistered = append(unregistered, "models.WatchModelHandler")
+	}
+
+	if o.ModelsWatchModelsHandler == nil {
+		unregistered = append(unregistered, "models.WatchModelsHandler")
+	}
+
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
 	}
@@ -403,6 +493,9 @@ func (o *DlaasAPI) ProducersFor(mediaTypes []string) map[string]runtime.Producer
 		case "application/octet-stream":
 			result["application/octet-stream"] = o.BinProducer
 
+		case "text/csv":
+			result["text/csv"] = o.CsvProducer
+
 		}
 	}
 	return result
@@ -456,6 +549,11 @@ func (o *DlaasAPI) initHandlerCache() {
 	}
 	o.handlers["DELETE"]["/v1/models/{model_id}"] = models.NewDeleteModel(o.context, o.ModelsDeleteModelHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/v1/models"] = models.NewDeleteModels(o.context, o.ModelsDeleteModelsHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -481,6 +579,11 @@ func (o *DlaasAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/v1/models/{model_id}/events/{event_type}"] = events.NewGetEventTypeEndpoints(o.context, o.EventsGetEventTypeEndpointsHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/experiments/{experiment_id}"] = experiments.NewGetExperiment(o.context, o.ExperimentsGetExperimentHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -501,6 +604,21 @@ func (o *DlaasAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/v1/models/{model_id}"] = models.NewGetModel(o.context, o.ModelsGetModelHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/models/{model_id}/history"] = models.NewGetModelHistory(o.context, o.ModelsGetModelHistoryHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/usage"] = usage.NewGetUsage(o.context, o.UsageGetUsageHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/experiments"] = experiments.NewListExperiments(o.context, o.ExperimentsListExperimentsHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -511,11 +629,31 @@ func (o *DlaasAPI) initHandlerCache() {
 	}
 	o.handlers["PATCH"]["/v1/models/{model_id}"] = models.NewPatchModel(o.context, o.ModelsPatchModelHandler)
 
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/v1/models"] = models.NewPatchModels(o.context, o.ModelsPatchModelsHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v1/experiments"] = experiments.NewPostExperiment(o.context, o.ExperimentsPostExperimentHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/v1/models"] = models.NewPostModel(o.context, o.ModelsPostModelHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/models/{model_id}/watch"] = models.NewWatchModel(o.context, o.ModelsWatchModelHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v1/models/watch"] = models.NewWatchModels(o.context, o.ModelsWatchModelsHandler)
+
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	strfmt "github.com/go-openapi/strfmt"
@@ -36,6 +37,43 @@ type ListModelsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request
 
+	/*Only list models using this framework, such as tensorflow.
+	  In: query
+	*/
+	Framework *string
+	/*Only list models whose labels match this selector, such as team=vision,dataset!=v2.
+	  In: query
+	*/
+	LabelSelector *string
+	/*Only list models whose name starts with this prefix.
+	  In: query
+	*/
+	NamePrefix *string
+	/*Maximum number of models to return. All models are returned if not set.
+	  In: query
+	*/
+	PageSize *int32
+	/*The next_page_token of the previous response, to continue the listing where it ended.
+	  In: query
+	*/
+	PageToken *string
+	/*Sort by submitted or name, prefixed with - for descending order. Default -submitted.
+	  In: query
+	*/
+	Sort *string
+	/*Comma separated list of training statuses, such as COMPLETED,FAILED.
+	  In: query
+	*/
+	Status *string
+	/*Only list models submitted at or after this RFC3339 timestamp.
+	  In: query
+	*/
+	SubmittedAfter *string
+	/*Only list models submitted before this RFC3339 timestamp.
+	  In: query
+	*/
+	SubmittedBefore *string
+
 	/*The release date of the version of the API you want to use. Specify dates in YYYY-MM-DD format.
 	  Required: true
 	  In: query
@@ -52,6 +90,51 @@ func (o *ListModelsParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	qs := runtime.Values(r.URL.Query())
 
+	qFramework, qhkFramework, _ := qs.GetOK("framework")
+	if err := o.bindFramework(qFramework, qhkFramework, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLabelSelector, qhkLabelSelector, _ := qs.GetOK("label_selector")
+	if err := o.bindLabelSelector(qLabelSelector, qhkLabelSelector, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNamePrefix, qhkNamePrefix, _ := qs.GetOK("name_prefix")
+	if err := o.bindNamePrefix(qNamePrefix, qhkNamePrefix, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPageSize, qhkPageSize, _ := qs.GetOK("page_size")
+	if err := o.bindPageSize(qPageSize, qhkPageSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPageToken, qhkPageToken, _ := qs.GetOK("page_token")
+	if err := o.bindPageToken(qPageToken, qhkPageToken, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSubmittedAfter, qhkSubmittedAfter, _ := qs.GetOK("submitted_after")
+	if err := o.bindSubmittedAfter(qSubmittedAfter, qhkSubmittedAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSubmittedBefore, qhkSubmittedBefore, _ := qs.GetOK("submitted_before")
+	if err := o.bindSubmittedBefore(qSubmittedBefore, qhkSubmittedBefore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qVersion, qhkVersion, _ := qs.GetOK("version")
 	if err := o.bindVersion(qVersion, qhkVersion, route.Formats); err != nil {
 		res = append(res, err)
@@ -63,6 +146,136 @@ func (o *ListModelsParams) BindRequest(r *http.Request, route *middleware.Matche
 	return nil
 }
 
+func (o *ListModelsParams) bindFramework(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Framework = &raw
+
+	return nil
+}
+
+func (o *ListModelsParams) bindLabelSelector(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.LabelSelector = &raw
+
+	return nil
+}
+
+func (o *ListModelsParams) bindNamePrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.NamePrefix = &raw
+
+	return nil
+}
+
+func (o *ListModelsParams) bindPageSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("page_size", "query", "int32", raw)
+	}
+	o.PageSize = &value
+
+	return nil
+}
+
+func (o *ListModelsParams) bindPageToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.PageToken = &raw
+
+	return nil
+}
+
+func (o *ListModelsParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Sort = &raw
+
+	return nil
+}
+
+func (o *ListModelsParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Status = &raw
+
+	return nil
+}
+
+func (o *ListModelsParams) bindSubmittedAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.SubmittedAfter = &raw
+
+	return nil
+}
+
+func (o *ListModelsParams) bindSubmittedBefore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.SubmittedBefore = &raw
+
+	return nil
+}
+
 func (o *ListModelsParams) bindVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
 		return errors.Required("version", "query")
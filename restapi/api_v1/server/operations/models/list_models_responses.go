@@ -56,6 +56,49 @@ func (o *ListModelsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 	}
 }
 
+// ListModelsBadRequestCode is the HTTP code returned for type ListModelsBadRequest
+const ListModelsBadRequestCode int = 400
+
+/*ListModelsBadRequest Error in the query parameters.
+
+swagger:response listModelsBadRequest
+*/
+type ListModelsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *restmodels.Error `json:"body,omitempty"`
+}
+
+// NewListModelsBadRequest creates ListModelsBadRequest with default headers values
+func NewListModelsBadRequest() *ListModelsBadRequest {
+	return &ListModelsBadRequest{}
+}
+
+// WithPayload adds the payload to the list models bad request response
+func (o *ListModelsBadRequest) WithPayload(payload *restmodels.Error) *ListModelsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list models bad request response
+func (o *ListModelsBadRequest) SetPayload(payload *restmodels.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListModelsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ListModelsUnauthorizedCode is the HTTP code returned for type ListModelsUnauthorized
 const ListModelsUnauthorizedCode int = 401
 
@@ -9,11 +9,22 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // ListModelsURL generates an URL for the list models operation
 type ListModelsURL struct {
-	Version string
+	Framework       *string
+	LabelSelector   *string
+	NamePrefix      *string
+	PageSize        *int32
+	PageToken       *string
+	Sort            *string
+	Status          *string
+	SubmittedAfter  *string
+	SubmittedBefore *string
+	Version         string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -49,6 +60,78 @@ func (o *ListModelsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var framework string
+	if o.Framework != nil {
+		framework = *o.Framework
+	}
+	if framework != "" {
+		qs.Set("framework", framework)
+	}
+
+	var labelSelector string
+	if o.LabelSelector != nil {
+		labelSelector = *o.LabelSelector
+	}
+	if labelSelector != "" {
+		qs.Set("label_selector", labelSelector)
+	}
+
+	var namePrefix string
+	if o.NamePrefix != nil {
+		namePrefix = *o.NamePrefix
+	}
+	if namePrefix != "" {
+		qs.Set("name_prefix", namePrefix)
+	}
+
+	var pageSize string
+	if o.PageSize != nil {
+		pageSize = swag.FormatInt32(*o.PageSize)
+	}
+	if pageSize != "" {
+		qs.Set("page_size", pageSize)
+	}
+
+	var pageToken string
+	if o.PageToken != nil {
+		pageToken = *o.PageToken
+	}
+	if pageToken != "" {
+		qs.Set("page_token", pageToken)
+	}
+
+	var sort string
+	if o.Sort != nil {
+		sort = *o.Sort
+	}
+	if sort != "" {
+		qs.Set("sort", sort)
+	}
+
+	var status string
+	if o.Status != nil {
+		status = *o.Status
+	}
+	if status != "" {
+		qs.Set("status", status)
+	}
+
+	var submittedAfter string
+	if o.SubmittedAfter != nil {
+		submittedAfter = *o.SubmittedAfter
+	}
+	if submittedAfter != "" {
+		qs.Set("submitted_after", submittedAfter)
+	}
+
+	var submittedBefore string
+	if o.SubmittedBefore != nil {
+		submittedBefore = *o.SubmittedBefore
+	}
+	if submittedBefore != "" {
+		qs.Set("submitted_before", submittedBefore)
+	}
+
 	version := o.Version
 	if version != "" {
 		qs.Set("version", version)
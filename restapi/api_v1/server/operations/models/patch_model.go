@@ -31,9 +31,9 @@ func NewPatchModel(ctx *middleware.Context, handler PatchModelHandler) *PatchMod
 
 /*PatchModel swagger:route PATCH /v1/models/{model_id} Models patchModel
 
-Changes the status of the training progress.
+Changes the status, the labels or the priority of a training.
 
-Changes the status of the training progress to the given `status` value (currently `halt` only). Halt means the training will be stopped and the last snapshot will be stored and can be retrieved.
+Changes the status of the training progress to the given `status` value (`halt` or `resume`). Halt means the training will be stopped and the last snapshot will be stored and can be retrieved. Resume means a halted training will be queued again and continue from its last checkpoint. The credentials of its data stores are erased when a training is halted, so the `manifest` it was created with has to be given again to resume it. If `labels` are given, they replace the labels of the training. If `priority` is given, it becomes the priority of the queued training, which only priority administrators may set.
 
 */
 type PatchModel struct {
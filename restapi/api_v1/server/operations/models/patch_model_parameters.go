@@ -44,7 +44,7 @@ type PatchModelParams struct {
 	  In: path
 	*/
 	ModelID string
-	/*Currently accepts "halt" as the only valid value.
+	/*Accepts "halt" or "resume" as status, and the new labels.
 	  Required: true
 	  In: body
 	*/
@@ -16,7 +16,7 @@ import (
 // PatchModelAcceptedCode is the HTTP code returned for type PatchModelAccepted
 const PatchModelAcceptedCode int = 202
 
-/*PatchModelAccepted Training successfully halted.
+/*PatchModelAccepted Training successfully halted or resumed.
 
 swagger:response patchModelAccepted
 */
@@ -142,6 +142,49 @@ func (o *PatchModelUnauthorized) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// PatchModelForbiddenCode is the HTTP code returned for type PatchModelForbidden
+const PatchModelForbiddenCode int = 403
+
+/*PatchModelForbidden The user may not set the priority of trainings.
+
+swagger:response patchModelForbidden
+*/
+type PatchModelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *restmodels.Error `json:"body,omitempty"`
+}
+
+// NewPatchModelForbidden creates PatchModelForbidden with default headers values
+func NewPatchModelForbidden() *PatchModelForbidden {
+	return &PatchModelForbidden{}
+}
+
+// WithPayload adds the payload to the patch model forbidden response
+func (o *PatchModelForbidden) WithPayload(payload *restmodels.Error) *PatchModelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch model forbidden response
+func (o *PatchModelForbidden) SetPayload(payload *restmodels.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchModelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // PatchModelNotFoundCode is the HTTP code returned for type PatchModelNotFound
 const PatchModelNotFoundCode int = 404
 
@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	strfmt "github.com/go-openapi/strfmt"
@@ -20,9 +21,12 @@ import (
 // with the default values initialized.
 func NewPostModelParams() PostModelParams {
 	var (
+		dryRunDefault  = bool(false)
 		versionDefault = string("2017-02-13")
 	)
 	return PostModelParams{
+		DryRun: &dryRunDefault,
+
 		Version: versionDefault,
 	}
 }
@@ -36,12 +40,21 @@ type PostModelParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request
 
+	/*Only validate the model and return the Kubernetes objects its training would create, without starting it. Default false.
+	  In: query
+	  Default: false
+	*/
+	DryRun *bool
+	/*A key chosen by the client, such as a UUID, to retry the request safely. Requests with the same key return the model created by the first one instead of training another, for 24 hours by default.
+	  In: header
+	*/
+	IdempotencyKey *string
 	/*The manifest providing configuration for the deep learning model, the training data and the training execution.
 	  Required: true
 	  In: formData
 	*/
 	Manifest runtime.File
-	/*The deep learning model code as compressed archive (ZIP).
+	/*The deep learning model code as compressed archive (ZIP). Not needed if the manifest sets model_definition.location.
 	  In: formData
 	*/
 	ModelDefinition *runtime.File
@@ -85,6 +98,15 @@ func (o *PostModelParams) BindRequest(r *http.Request, route *middleware.Matched
 		o.ModelDefinition = &runtime.File{Data: modelDefinition, Header: modelDefinitionHeader}
 	}
 
+	qDryRun, qhkDryRun, _ := qs.GetOK("dry_run")
+	if err := o.bindDryRun(qDryRun, qhkDryRun, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qVersion, qhkVersion, _ := qs.GetOK("version")
 	if err := o.bindVersion(qVersion, qhkVersion, route.Formats); err != nil {
 		res = append(res, err)
@@ -96,6 +118,40 @@ func (o *PostModelParams) BindRequest(r *http.Request, route *middleware.Matched
 	return nil
 }
 
+func (o *PostModelParams) bindDryRun(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		var dryRunDefault bool = bool(false)
+		o.DryRun = &dryRunDefault
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("dry_run", "query", "bool", raw)
+	}
+	o.DryRun = &value
+
+	return nil
+}
+
+func (o *PostModelParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IdempotencyKey = &raw
+
+	return nil
+}
+
 func (o *PostModelParams) bindVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
 		return errors.Required("version", "query")
@@ -13,6 +13,49 @@ import (
 	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
 )
 
+// PostModelOKCode is the HTTP code returned for type PostModelOK
+const PostModelOKCode int = 200
+
+/*PostModelOK The Kubernetes objects the training would create, returned for a dry run.
+
+swagger:response postModelOK
+*/
+type PostModelOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *restmodels.RenderedModel `json:"body,omitempty"`
+}
+
+// NewPostModelOK creates PostModelOK with default headers values
+func NewPostModelOK() *PostModelOK {
+	return &PostModelOK{}
+}
+
+// WithPayload adds the payload to the post model o k response
+func (o *PostModelOK) WithPayload(payload *restmodels.RenderedModel) *PostModelOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post model o k response
+func (o *PostModelOK) SetPayload(payload *restmodels.RenderedModel) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostModelOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // PostModelCreatedCode is the HTTP code returned for type PostModelCreated
 const PostModelCreatedCode int = 201
 
@@ -9,10 +9,13 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // PostModelURL generates an URL for the post model operation
 type PostModelURL struct {
+	DryRun  *bool
 	Version string
 
 	_basePath string
@@ -49,6 +52,14 @@ func (o *PostModelURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var dryRun string
+	if o.DryRun != nil {
+		dryRun = swag.FormatBool(*o.DryRun)
+	}
+	if dryRun != "" {
+		qs.Set("dry_run", dryRun)
+	}
+
 	version := o.Version
 	if version != "" {
 		qs.Set("version", version)
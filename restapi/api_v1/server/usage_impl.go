@@ -0,0 +1,188 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/usage"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+)
+
+// usageAdminsKey is the comma separated list of users who may see the usage of other users
+const usageAdminsKey = "usage_admins"
+
+var errUsageOfOtherUser = errors.New("only usage administrators can see the usage of other users")
+
+// getUsage reports the resources consumed by finished trainings, as JSON or CSV
+func getUsage(params usage.GetUsageParams) middleware.Responder {
+	logr := logger.LocLogger(logWithGetUsageParams(params))
+	logr.Debugf("getUsage invoked: %v", params.HTTPRequest.Header)
+
+	req, err := newUsageRequest(params, isUsageAdmin(getUserID(params.HTTPRequest)))
+	if err == errUsageOfOtherUser {
+		return usage.NewGetUsageForbidden().WithPayload(&restmodels.Error{
+			Error:       "Forbidden",
+			Code:        http.StatusForbidden,
+			Description: err.Error(),
+		})
+	}
+	if err != nil {
+		return usage.NewGetUsageBadRequest().WithPayload(&restmodels.Error{
+			Error:       "Bad request",
+			Code:        http.StatusBadRequest,
+			Description: err.Error(),
+		})
+	}
+
+	trainer, err := trainerClient.NewTrainer()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create client for trainer service")
+		return error500(logr, "")
+	}
+	defer trainer.Close()
+
+	resp, err := trainer.Client().GetUsage(params.HTTPRequest.Context(), req)
+	if err != nil {
+		logr.WithError(err).Errorf("Trainer GetUsage service call failed")
+		if grpc.Code(err) == codes.InvalidArgument {
+			return usage.NewGetUsageBadRequest().WithPayload(&restmodels.Error{
+				Error:       "Bad request",
+				Code:        http.StatusBadRequest,
+				Description: grpc.ErrorDesc(err),
+			})
+		}
+		return error500(logr, "")
+	}
+
+	payload := createUsage(resp)
+	if params.Format != nil && *params.Format == "csv" {
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set(runtime.HeaderContentType, "text/csv")
+			w.Header().Set("Content-Disposition", `attachment; filename="usage.csv"`)
+			w.WriteHeader(http.StatusOK)
+			if err := writeUsageCSV(w, payload); err != nil {
+				logr.WithError(err).Errorf("Cannot write the usage report")
+			}
+		})
+	}
+	return usage.NewGetUsageOK().WithPayload(payload)
+}
+
+// isUsageAdmin returns whether userID is one of the configured usage administrators
+func isUsageAdmin(userID string) bool {
+	return isListedUser(usageAdminsKey, userID)
+}
+
+// isListedUser returns whether userID is in the comma separated list of users configured with key
+func isListedUser(key string, userID string) bool {
+	for _, admin := range strings.Split(viper.GetString(key), ",") {
+		if admin = strings.TrimSpace(admin); admin != "" && admin == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// newUsageRequest translates the query parameters of getUsage to a trainer request. Users get their own usage, and
+// administrators the usage of all users unless they ask for a single one.
+func newUsageRequest(params usage.GetUsageParams, admin bool) (*grpc_trainer_v2.UsageRequest, error) {
+	req := &grpc_trainer_v2.UsageRequest{}
+	if !admin {
+		req.UserId = getUserID(params.HTTPRequest)
+	}
+	if params.UserID != nil {
+		if !admin && *params.UserID != req.UserId {
+			return nil, errUsageOfOtherUser
+		}
+		req.UserId = *params.UserID
+	}
+	if params.LabelSelector != nil {
+		req.LabelSelector = *params.LabelSelector
+	}
+	if params.GroupByLabel != nil {
+		req.GroupByLabel = *params.GroupByLabel
+	}
+	for _, t := range []struct {
+		name  string
+		value *string
+		dest  *int64
+	}{
+		{"completed_after", params.CompletedAfter, &req.CompletedAfter},
+		{"completed_before", params.CompletedBefore, &req.CompletedBefore},
+	} {
+		if t.value == nil {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, *t.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not an RFC3339 timestamp: %s", t.name, *t.value)
+		}
+		*t.dest = parsed.UnixNano() / int64(time.Millisecond)
+	}
+	return req, nil
+}
+
+func createUsage(resp *grpc_trainer_v2.UsageResponse) *restmodels.Usage {
+	u := &restmodels.Usage{Entries: []*restmodels.UsageEntry{}}
+	for _, e := range resp.Entries {
+		entry := &restmodels.UsageEntry{Key: e.Key, Trainings: e.Trainings}
+		if e.Usage != nil {
+			entry.GpuHours = e.Usage.GpuHours
+			entry.CPUHours = e.Usage.CpuHours
+			entry.MemoryHours = e.Usage.MemoryHours
+		}
+		u.Entries = append(u.Entries, entry)
+	}
+	return u
+}
+
+// writeUsageCSV writes the usage as CSV with a header row
+func writeUsageCSV(w io.Writer, u *restmodels.Usage) error {
+	formatHours := func(hours float64) string {
+		return strconv.FormatFloat(hours, 'f', -1, 64)
+	}
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"key", "trainings", "gpu_hours", "cpu_hours", "memory_hours"})
+	for _, e := range u.Entries {
+		cw.Write([]string{
+			e.Key,
+			strconv.Itoa(int(e.Trainings)),
+			formatHours(e.GpuHours),
+			formatHours(e.CPUHours),
+			formatHours(e.MemoryHours),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/FfDL/restapi/api_v1/restmodels"
+	"github.com/IBM/FfDL/restapi/api_v1/server/operations/usage"
+	mw "github.com/IBM/FfDL/restapi/middleware"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUsageRequest(t *testing.T) {
+	params := usage.NewGetUsageParams()
+	params.HTTPRequest = httptest.NewRequest("GET", "/v1/usage", nil)
+	params.HTTPRequest.Header.Set(mw.UserIDHeader, "alice")
+	params.CompletedAfter = swag.String("2018-03-01T00:00:00Z")
+	params.GroupByLabel = swag.String("team")
+
+	req, err := newUsageRequest(params, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", req.UserId)
+	assert.EqualValues(t, 1519862400000, req.CompletedAfter)
+	assert.Zero(t, req.CompletedBefore)
+	assert.Equal(t, "team", req.GroupByLabel)
+
+	// administrators see all users unless they pick one
+	req, err = newUsageRequest(params, true)
+	assert.NoError(t, err)
+	assert.Empty(t, req.UserId)
+
+	params.UserID = swag.String("bob")
+	req, err = newUsageRequest(params, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", req.UserId)
+
+	_, err = newUsageRequest(params, false)
+	assert.Equal(t, errUsageOfOtherUser, err)
+
+	params.UserID = nil
+	params.CompletedBefore = swag.String("tomorrow")
+	_, err = newUsageRequest(params, false)
+	assert.Error(t, err)
+}
+
+func TestGetUsageOfOtherUser(t *testing.T) {
+	params := usage.NewGetUsageParams()
+	params.HTTPRequest = httptest.NewRequest("GET", "/v1/usage?user_id=bob", nil)
+	params.HTTPRequest.Header.Set(mw.UserIDHeader, "alice")
+	params.UserID = swag.String("bob")
+
+	// a signed in user who is not a usage administrator is forbidden to see the usage of others
+	rec := httptest.NewRecorder()
+	getUsage(params).WriteResponse(rec, runtime.JSONProducer())
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), errUsageOfOtherUser.Error())
+}
+
+func TestIsUsageAdmin(t *testing.T) {
+	viper.Set(usageAdminsKey, "alice, carol")
+	defer viper.Set(usageAdminsKey, "")
+
+	assert.True(t, isUsageAdmin("alice"))
+	assert.True(t, isUsageAdmin("carol"))
+	assert.False(t, isUsageAdmin("bob"))
+	assert.False(t, isUsageAdmin(""))
+}
+
+func TestWriteUsageCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeUsageCSV(&buf, &restmodels.Usage{Entries: []*restmodels.UsageEntry{
+		{Key: "alice", Trainings: 2, GpuHours: 5, CPUHours: 7.5, MemoryHours: 0.25},
+		{Key: "team, vision", Trainings: 1},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "key,trainings,gpu_hours,cpu_hours,memory_hours\n"+
+		"alice,2,5,7.5,0.25\n"+
+		"\"team, vision\",1,0,0,0\n", buf.String())
+}
@@ -51,6 +51,8 @@ const (
 	ErrCodeImagePull              = "S103"
 	// ErrFailedPodReasonUnknown indicates an unknown pod error
 	ErrFailedPodReasonUnknown     = "S104"
+	// ErrCodeDeployInterrupted indicates a deployment that never completed, for example because the LCM restarted
+	ErrCodeDeployInterrupted      = "S105"
 	// ErrCodeK8SConnection indicates a kubernetes connection error
 	ErrCodeK8SConnection          = "S200"
 	// ErrCodeEtcdConnection indicates a etcd connection error
@@ -80,8 +82,14 @@ const (
 	ErrInvalidCredentials     = "C103"
 	// ErrInvalidResourceSpecs indicates invalid resouce specifications
 	ErrInvalidResourceSpecs   = "C104"
+	// ErrInvalidSecretReference indicates a secret_env entry referencing a secret the user does not own
+	ErrInvalidSecretReference = "C105"
 	// ErrLearnerProcessCrash indicates a crash of the process in the learner container
 	ErrLearnerProcessCrash    = "C201"
+	// ErrMaxDurationExceeded indicates that the training was halted after running longer than its maximum duration
+	ErrMaxDurationExceeded    = "C202"
+	// ErrDependencyFailed indicates that a training this training depends on failed or was deleted
+	ErrDependencyFailed       = "C301"
 )
 
 
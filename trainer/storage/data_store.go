@@ -67,11 +67,18 @@ type DataStore interface {
 	UploadArchive(container string, object string, payload []byte) error
 	DownloadArchive(container string, object string) ([]byte, error)
 	DeleteArchive(container string, object string) error
+	DeleteTrainedModel(path string) error
 	GetTrainedModelSize(path string, numLearners int32) (int64, error)
 	DownloadTrainedModelAsZipStream(path string, numLearners int32, writer io.Writer) error
 	DownloadTrainedModelLogFile(path string, numLearners int32, learnerIndex int32, filename string, writer io.Writer) error
 }
 
+// ETagger is implemented by data stores that can return the entity tag of an object, which changes whenever the
+// content of the object does.
+type ETagger interface {
+	ObjectETag(container string, object string) (string, error)
+}
+
 //
 // Helper functions useful accross object store implementations
 //
@@ -18,6 +18,8 @@ package storage
 
 import (
 	"archive/zip"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"strings"
@@ -82,6 +84,23 @@ func (o *inMemObjectStore) DeleteArchive(container string, object string) error
 	return nil
 }
 
+func (o *inMemObjectStore) DeleteTrainedModel(path string) error {
+	// separate path in container and object
+	index := strings.Index(path, "/")
+	if index < 0 {
+		return fmt.Errorf("path %s does not name a trained model", path)
+	}
+	container := path[0:index]
+	objectPrefix := path[index+1:] + "/"
+
+	for k := range store[container] {
+		if strings.HasPrefix(k, objectPrefix) {
+			delete(store[container], k)
+		}
+	}
+	return nil
+}
+
 func (o *inMemObjectStore) GetTrainedModelSize(path string, numLearners int32) (int64, error) {
 	// separate path in container and object
 	var container, objectPrefix string
@@ -192,6 +211,16 @@ func (o *inMemObjectStore) DownloadTrainedModelLogFile(path string, numLearners
 	return nil
 }
 
+// ObjectETag returns the MD5 hash of an object, like S3 does for objects that were not uploaded in parts
+func (o *inMemObjectStore) ObjectETag(container string, object string) (string, error) {
+	payload, ok := store[container][object]
+	if !ok {
+		return "", fmt.Errorf("container or object '%s/%s' not found", container, object)
+	}
+	sum := md5.Sum(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 func (o *inMemObjectStore) ContainerExists(name string) (bool, error) {
 	if _, ok := store[name]; ok {
 		return true, nil
@@ -41,6 +41,18 @@ func TestInMemObjectStore(t *testing.T) {
 	assert.Nil(t, download)
 }
 
+func TestInMemObjectETag(t *testing.T) {
+	os, _ := NewInMemObjectStore(nil)
+
+	os.UploadArchive("foo", "etag", []byte("hello"))
+	etag, err := os.(ETagger).ObjectETag("foo", "etag")
+	assert.NoError(t, err)
+	assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", etag)
+
+	_, err = os.(ETagger).ObjectETag("foo", "missing")
+	assert.Error(t, err)
+}
+
 func TestDownloadTrainedModelAsZipFake(t *testing.T) {
 
 	ostore, _ := NewInMemObjectStore(nil)
@@ -59,3 +71,23 @@ func TestDownloadTrainedModelAsZipFake(t *testing.T) {
 
 	// TODO should unzip and check
 }
+
+func TestDeleteTrainedModelFake(t *testing.T) {
+	ostore, _ := NewInMemObjectStore(nil)
+
+	ostore.UploadArchive("foobar", "training-bar/learner-1/model.bin", []byte("my super model"))
+	ostore.UploadArchive("foobar", "training-bar/training-log.txt", []byte("all great"))
+	ostore.UploadArchive("foobar", "training-barbaz/training-log.txt", []byte("another training"))
+	defer ostore.DeleteArchive("foobar", "training-barbaz/training-log.txt")
+
+	err := ostore.DeleteTrainedModel("foobar/training-bar")
+	assert.NoError(t, err)
+
+	download, _ := ostore.DownloadArchive("foobar", "training-bar/learner-1/model.bin")
+	assert.Nil(t, download)
+	download, _ = ostore.DownloadArchive("foobar", "training-bar/training-log.txt")
+	assert.Nil(t, download)
+	// trainings whose id starts with the same characters are kept
+	download, _ = ostore.DownloadArchive("foobar", "training-barbaz/training-log.txt")
+	assert.NotNil(t, download)
+}
@@ -61,6 +61,10 @@ func (o *notImplementedStorage) DeleteArchive(container string, object string) e
 	return fmt.Errorf("DeleteArchive Not Implemented")
 }
 
+func (o *notImplementedStorage) DeleteTrainedModel(path string) error {
+	return fmt.Errorf("DeleteTrainedModel Not Implemented")
+}
+
 func (o *notImplementedStorage) GetTrainedModelSize(path string, numLearners int32) (int64, error) {
 	return 0, fmt.Errorf("GetTrainedModelSize Not Implemented")
 
@@ -185,6 +185,24 @@ func (os *s3ObjectStore) DownloadArchive(container string, object string) ([]byt
 	return buff.Bytes(), nil
 }
 
+// ObjectETag returns the ETag of an object, without the quotes S3 puts around it
+func (os *s3ObjectStore) ObjectETag(container string, object string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+
+	if os.client == nil {
+		return "", ErrNotConnected
+	}
+	out, err := os.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(container),
+		Key:    aws.String(object),
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(aws.StringValue(out.ETag), "\""), nil
+}
+
 func (os *s3ObjectStore) DeleteArchive(container string, object string) error {
 	logr := logger.LocLogger(log.StandardLogger().WithField("module", "storage"))
 
@@ -224,6 +242,60 @@ func (os *s3ObjectStore) DeleteArchive(container string, object string) error {
 	return nil
 }
 
+// DeleteTrainedModel deletes all objects below the path of a trained model, including the training logs
+func (os *s3ObjectStore) DeleteTrainedModel(path string) error {
+	logr := logger.LocLogger(log.StandardLogger().WithField("module", "storage"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+	defer cancel()
+	cl := instrumentation.NewCallLogger(ctx, "DeleteTrainedModel", logr)
+	defer cl.Returned()
+
+	if os.client == nil {
+		return ErrNotConnected
+	}
+
+	// separate path in container and object
+	var container, objectPrefix string
+	index := strings.Index(path, "/")
+	if index < 0 {
+		return fmt.Errorf("path %s does not name a trained model", path)
+	}
+	container = path[0:index]
+	objectPrefix = path[index+1:] + "/"
+
+	var deleteErr error
+	err := os.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(container),
+		Prefix: aws.String(objectPrefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if len(page.Contents) == 0 {
+			return true
+		}
+		var objects []*s3.ObjectIdentifier
+		for _, obj := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: obj.Key})
+		}
+		_, deleteErr = os.client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(container),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		return deleteErr == nil
+	})
+	if err == nil {
+		err = deleteErr
+	}
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil
+		}
+		logr.Errorf("Deleting trained model %s failed: %s", path, err.Error())
+		return err
+	}
+	cl.Observe("deleted trained model in s3")
+	return nil
+}
+
 func (os *s3ObjectStore) GetTrainedModelSize(path string, numLearners int32) (int64, error) {
 	logr := logger.LocLogger(log.StandardLogger().WithField("module", "storage"))
 	if os.client == nil {
@@ -143,6 +143,18 @@ func (os *swiftObjectStore) DownloadArchive(container string, object string) ([]
 }
 
 
+// ObjectETag returns the MD5 hash swift keeps as ETag of an object
+func (os *swiftObjectStore) ObjectETag(container string, object string) (string, error) {
+	if os.conn == nil {
+		return "", ErrNotConnected
+	}
+	info, _, err := os.conn.Object(container, object)
+	if err != nil {
+		return "", err
+	}
+	return info.Hash, nil
+}
+
 func (os *swiftObjectStore) DeleteArchive(container string, object string) error {
 	if os.conn == nil {
 		return ErrNotConnected
@@ -170,6 +182,38 @@ func (os *swiftObjectStore) DeleteArchive(container string, object string) error
 	return nil
 }
 
+// DeleteTrainedModel deletes all objects below the path of a trained model, including the training logs
+func (os *swiftObjectStore) DeleteTrainedModel(path string) error {
+	if os.conn == nil {
+		return ErrNotConnected
+	}
+
+	// separate path in container and object
+	index := strings.Index(path, "/")
+	if index < 0 {
+		return fmt.Errorf("path %s does not name a trained model", path)
+	}
+	container := path[0:index]
+	objectPrefix := path[index+1:] + "/"
+
+	names, err := os.conn.ObjectNamesAll(container, &swift.ObjectsOpts{Prefix: objectPrefix})
+	if err == swift.ContainerNotFound {
+		return nil
+	}
+	if err != nil {
+		log.WithError(err).Errorf("Listing objects in container %s failed", container)
+		return err
+	}
+	for _, name := range names {
+		err = os.conn.ObjectDelete(container, name)
+		if err != nil && err != swift.ObjectNotFound {
+			log.WithError(err).Errorf("Deleting object %s in container %s failed", name, container)
+			return err
+		}
+	}
+	return nil
+}
+
 func (os *swiftObjectStore) GetTrainedModelSize(path string, numLearners int32) (int64, error) {
 	logr := logger.LocLogger(log.StandardLogger().WithField("module", "storage"))
 	if os.conn == nil {
@@ -60,6 +60,10 @@ func (o *volumeMountStorage) DeleteArchive(container string, object string) erro
 	return fmt.Errorf("DeleteArchive Not Implemented")
 }
 
+func (o *volumeMountStorage) DeleteTrainedModel(path string) error {
+	return fmt.Errorf("DeleteTrainedModel Not Implemented")
+}
+
 func (o *volumeMountStorage) GetTrainedModelSize(path string, numLearners int32) (int64, error) {
 	return 0, fmt.Errorf("GetTrainedModelSize Not Implemented")
 
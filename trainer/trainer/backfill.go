@@ -0,0 +1,92 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+)
+
+// Backfilling lets smaller jobs further down the queue start while the job at the head of the queue is
+// rate-limited, e.g. a 16 GPU job waiting for capacity does not stall a 1 GPU job behind it.
+//
+// Since the trainer does not know how long running jobs take, capacity is reserved for the head job by time:
+// once the job at the head of the queue has been blocked by the cluster wide GPU limit for longer than the
+// reservation period, no more jobs are backfilled and the GPUs released by finishing jobs are left for the head job.
+// Jobs blocked only by their own user or tenant quota do not hold a reservation, since other users' jobs cannot
+// delay them.
+
+func getBackfillWindow() int {
+	return viper.GetInt(backfillWindowKey)
+}
+
+func getBackfillReservation() time.Duration {
+	return time.Duration(viper.GetInt(backfillReservationKey)) * time.Second
+}
+
+// holdsReservation returns true if the head of the queue has been blocked by the cluster wide GPU limit for
+// longer than the reservation period, in which case no other jobs may be backfilled. The time the head job spent
+// waiting in the queue before, e.g. behind other jobs or for its own quota, does not count.
+func holdsReservation(head *TrainingRecord, reservation time.Duration, now time.Time) bool {
+	if head.BlockedSince == 0 || reservation <= 0 {
+		return false
+	}
+	blockedSince := time.Unix(0, head.BlockedSince*int64(time.Millisecond))
+	return now.Sub(blockedSince) >= reservation
+}
+
+// backfillFromQueue starts jobs from the backfill window behind the rate-limited head of the queue that fit the
+// remaining capacity. entries are the queue entries in order and headIndex is the index of the head job in them, the
+// entries before it are waiting for their retry backoff. trainer should acquire the queue lock before calling
+// backfillFromQueue()
+func (s *trainerService) backfillFromQueue(qHandler *queueHandler, gpuType string, head *TrainingRecord, entries []Entry, headIndex int, logr *logger.LocLoggingEntry) {
+	window := getBackfillWindow()
+	if window <= 0 || headIndex+1 >= len(entries) {
+		return
+	}
+	entries = entries[headIndex+1:]
+	if len(entries) > window {
+		entries = entries[:window]
+	}
+
+	if holdsReservation(head, getBackfillReservation(), time.Now()) {
+		logr.Debugf("training job %s holds the %s queue reservation, not backfilling", head.TrainingID, gpuType)
+		return
+	}
+
+	for _, entry := range entries {
+		trainingRecord, err := s.repo.Find(entry.TrainingID)
+		if err != nil || trainingRecord.Deleted || trainingRecord.TrainingStatus.Status != grpc_trainer_v2.Status_QUEUED {
+			// stale entries are cleaned up once they reach the head of the queue
+			continue
+		}
+
+		if rateLimited, reason, _ := s.rateLimitTrainingJob(trainingRecord, logr); rateLimited {
+			logr.Debugf("training job %s cannot be backfilled (%s)", trainingRecord.TrainingID, reason)
+			continue
+		}
+
+		logr.Infof("backfilling training job %s ahead of blocked training job %s in %s queue", trainingRecord.TrainingID, head.TrainingID, gpuType)
+		if s.startQueuedJob(qHandler, gpuType, trainingRecord, logr) {
+			s.metrics.backfillJobCounter.Add(1)
+			s.recordQueuedJobStart(trainingRecord)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func millis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func TestHoldsReservation(t *testing.T) {
+	now := time.Now()
+	head := &TrainingRecord{TrainingID: "head", BlockedSince: millis(now.Add(-time.Hour))}
+
+	assert.True(t, holdsReservation(head, 30*time.Minute, now))
+	assert.False(t, holdsReservation(head, 2*time.Hour, now))
+
+	// jobs not blocked by the cluster wide limit and disabled reservations never stop backfilling
+	assert.False(t, holdsReservation(&TrainingRecord{TrainingID: "head"}, 30*time.Minute, now))
+	assert.False(t, holdsReservation(head, 0, now))
+}
+
+func TestBackfillFromQueue(t *testing.T) {
+	viper.Set(gpuLimitsKey, "nvidia-TeslaK80=4")
+	viper.Set(backfillWindowKey, 2)
+	defer viper.Set(gpuLimitsKey, "")
+	defer viper.Set(backfillWindowKey, 0)
+
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"NVIDIA_TESLAK80": {make(chan struct{}), queue},
+	})
+	lcm := &fakeLCM{}
+	s.lcm = lcm
+
+	assert.NoError(t, s.repo.Store(createQuotaRecord("running", "alice", 2, 1, grpc_trainer_v2.Status_PROCESSING)))
+	for _, tr := range []*TrainingRecord{
+		createDeployableRecord("big", "bob", 4),
+		createDeployableRecord("small", "carol", 1),
+		createDeployableRecord("later", "dave", 1),
+	} {
+		assert.NoError(t, s.repo.Store(tr))
+		assert.NoError(t, queue.Enqueue(tr.TrainingID, 0))
+	}
+
+	// the smaller job behind the blocked head of the queue is started
+	before := millis(time.Now())
+	s.pullJobFromQueue("NVIDIA_TESLAK80")
+	assert.Equal(t, []string{"small", "later"}, lcm.deployed)
+	big, err := s.repo.Find("big")
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, big.TrainingStatus.Status)
+	assert.True(t, big.BlockedSince >= before, "head records when it was first blocked")
+
+	// the time the head is blocked is kept while it waits
+	blockedSince := big.BlockedSince
+	s.pullJobFromQueue("NVIDIA_TESLAK80")
+	big, _ = s.repo.Find("big")
+	assert.Equal(t, blockedSince, big.BlockedSince)
+
+	// once the reservation window has passed, nothing is started ahead of the head
+	for _, id := range []string{"small", "later"} {
+		tr, _ := s.repo.Find(id)
+		tr.TrainingStatus.Status = grpc_trainer_v2.Status_COMPLETED
+		assert.NoError(t, s.repo.Store(tr))
+	}
+	big.BlockedSince = millis(time.Now().Add(-time.Hour))
+	assert.NoError(t, s.repo.Store(big))
+	assert.NoError(t, s.repo.Store(createDeployableRecord("last", "erin", 1)))
+	assert.NoError(t, queue.Enqueue("last", 0))
+
+	s.pullJobFromQueue("NVIDIA_TESLAK80")
+	assert.Equal(t, []string{"small", "later"}, lcm.deployed)
+	size, _ := queue.Size()
+	assert.Equal(t, 2, size)
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+)
+
+// the data stores of a training are listed in these variables, each by the id used in its DATA_DIR_<ID>,
+// DATA_STORE_<ID>_*, RESULT_DIR_<ID> and RESULT_STORE_<ID>_* variables. The first one is the store that DATA_DIR and
+// RESULT_DIR point to.
+const (
+	inputDataIDsEnvVar  = "INPUT_DATA_IDS"
+	outputDataIDsEnvVar = "OUTPUT_DATA_IDS"
+)
+
+var nonEnvVarChars = regexp.MustCompile(`[^A-Z0-9]`)
+
+// envVarID returns the id of a data store reference as it appears in the names of environment variables. The REST API
+// refers to the input and output data of the manifest data store <id> as <id>-input and <id>-output, their variables
+// are named after <id> alone.
+func envVarID(name string) string {
+	name = strings.TrimSuffix(strings.TrimSuffix(name, "-input"), "-output")
+	return nonEnvVarChars.ReplaceAllString(strings.ToUpper(name), "_")
+}
+
+// dataStoreType returns the type the data broker and the LCM see for a data store
+func dataStoreType(ds *grpc_trainer_v2.Datastore) string {
+	if ds.Connection["type"] != "" {
+		return ds.Connection["type"]
+	}
+	return ds.Type
+}
+
+// validateDataReferences returns a message describing what is wrong with the input or output data references of a
+// training, or "". The references must point to existing data stores.
+func validateDataReferences(kind string, names []string, datastores []*grpc_trainer_v2.Datastore) string {
+	ids := make(map[string]string)
+	var first *grpc_trainer_v2.Datastore
+	for _, name := range names {
+		id := envVarID(name)
+		if other, ok := ids[id]; ok {
+			return fmt.Sprintf("Training %s data references '%s' and '%s' are not distinct", kind, other, name)
+		}
+		ids[id] = name
+
+		ds := findDatastore(name, datastores)
+		if first == nil {
+			first = ds
+		} else if dataStoreType(ds) != dataStoreType(first) {
+			// all the stores are either mounted in the learner or loaded by the same data broker
+			return fmt.Sprintf("Training %s data must reference data stores of the same type", kind)
+		}
+	}
+	return ""
+}
+
+// setDataStoreEnvVars sets the variables the data broker and the LCM need to access a data store
+func setDataStoreEnvVars(envvars map[string]string, prefix string, ds *grpc_trainer_v2.Datastore) {
+	envvars[prefix+"TYPE"] = dataStoreType(ds)
+	envvars[prefix+"AUTHURL"] = ds.Connection["auth_url"]
+	for key, name := range map[string]string{
+		"path":        "PATH",
+		"project_id":  "PROJECTID",
+		"user_name":   "USERNAME",
+		"password":    "APIKEY",
+		"domain_name": "DOMAINNAME",
+		"region":      "REGION",
+	} {
+		if ds.Connection[key] != "" {
+			envvars[prefix+name] = ds.Connection[key]
+		}
+	}
+	envvars[prefix+"OBJECTID"] = ds.Fields["bucket"]
+}
+
+// setDataReferenceEnvVars sets the variables of every input and output data store of a training. Jobs with a single
+// input and output only rely on the DATA_STORE_*, RESULT_STORE_*, DATA_DIR and RESULT_DIR variables, which are set
+// for the first store of each.
+func setDataReferenceEnvVars(envvars map[string]string, tr *TrainingRecord) {
+	var ids []string
+	for _, name := range tr.Training.InputData {
+		id := envVarID(name)
+		ds := findDatastore(name, tr.Datastores)
+		setDataStoreEnvVars(envvars, "DATA_STORE_"+id+"_", ds)
+		envvars["DATA_DIR_"+id] = ds.Fields["bucket"]
+		ids = append(ids, id)
+	}
+	envvars[inputDataIDsEnvVar] = strings.Join(ids, ",")
+
+	ids = nil
+	for _, name := range tr.Training.OutputData {
+		id := envVarID(name)
+		ds := findDatastore(name, tr.Datastores)
+		setDataStoreEnvVars(envvars, "RESULT_STORE_"+id+"_", ds)
+		envvars["RESULT_STORE_"+id+"_OBJECTID"] = resultsLocation(ds, tr.TrainingID)
+		envvars["RESULT_DIR_"+id] = ds.Fields["bucket"]
+		ids = append(ids, id)
+	}
+	if len(ids) > 0 {
+		envvars[outputDataIDsEnvVar] = strings.Join(ids, ",")
+	}
+}
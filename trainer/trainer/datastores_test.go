@@ -0,0 +1,95 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/storage"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func createMultiDataRequest() *grpc_trainer_v2.CreateRequest {
+	req := createDependentRequest()
+	req.Training.InputData = []string{"data", "pretrained-weights-input"}
+	req.Training.OutputData = []string{"results", "exported-output"}
+	for _, id := range []string{"pretrained-weights-input", "exported-output"} {
+		req.Datastores = append(req.Datastores, &grpc_trainer_v2.Datastore{
+			Id:         id,
+			Type:       storage.DataStoreTypeInMemory,
+			Connection: map[string]string{"user_name": id + "-user"},
+			Fields:     map[string]string{"bucket": id + "-bucket"},
+		})
+	}
+	return req
+}
+
+func TestValidateDataReferences(t *testing.T) {
+	req := createMultiDataRequest()
+	assert.Empty(t, validateDataReferences("input", req.Training.InputData, req.Datastores))
+	assert.Empty(t, validateDataReferences("output", nil, req.Datastores))
+
+	req.Datastores = append(req.Datastores, &grpc_trainer_v2.Datastore{Id: "Pretrained_Weights", Type: storage.DataStoreTypeInMemory})
+	assert.NotEmpty(t, validateDataReferences("input", []string{"pretrained-weights-input", "Pretrained_Weights"}, req.Datastores))
+
+	req.Datastores[len(req.Datastores)-1].Type = storage.DataStoreTypeS3
+	assert.NotEmpty(t, validateDataReferences("input", []string{"data", "Pretrained_Weights"}, req.Datastores))
+}
+
+func TestSetDataReferenceEnvVars(t *testing.T) {
+	req := createMultiDataRequest()
+	tr := &TrainingRecord{TrainingID: "training-1", Training: req.Training, Datastores: req.Datastores}
+	envvars := make(map[string]string)
+	setDataReferenceEnvVars(envvars, tr)
+
+	assert.Equal(t, "DATA,PRETRAINED_WEIGHTS", envvars["INPUT_DATA_IDS"])
+	assert.Equal(t, "RESULTS,EXPORTED", envvars["OUTPUT_DATA_IDS"])
+	assert.Equal(t, "data", envvars["DATA_DIR_DATA"])
+	assert.Equal(t, "pretrained-weights-input-bucket", envvars["DATA_DIR_PRETRAINED_WEIGHTS"])
+	assert.Equal(t, "pretrained-weights-input-bucket", envvars["DATA_STORE_PRETRAINED_WEIGHTS_OBJECTID"])
+	assert.Equal(t, "pretrained-weights-input-user", envvars["DATA_STORE_PRETRAINED_WEIGHTS_USERNAME"])
+	assert.Equal(t, storage.DataStoreTypeInMemory, envvars["DATA_STORE_PRETRAINED_WEIGHTS_TYPE"])
+	assert.Equal(t, "exported-output-bucket", envvars["RESULT_DIR_EXPORTED"])
+	assert.Equal(t, "exported-output-bucket/training-1", envvars["RESULT_STORE_EXPORTED_OBJECTID"])
+	assert.Equal(t, "exported-output-user", envvars["RESULT_STORE_EXPORTED_USERNAME"])
+}
+
+func TestCreateTrainingJobMultipleData(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	// the job waits for its parent, so it is stored without going through the queue
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	req := createMultiDataRequest()
+	req.Training.DependsOn = []string{"parent"}
+	resp, err := s.CreateTrainingJob(context.Background(), req)
+	assert.NoError(t, err)
+	tr, err := s.repo.Find(resp.TrainingId)
+	assert.NoError(t, err)
+	// only the first input data is replaced by the results of the parent, which are in the internal object store
+	assert.Equal(t, []string{internalObjectStoreID, "pretrained-weights-input"}, tr.Training.InputData)
+	assert.Equal(t, []string{"results", "exported-output"}, tr.Training.OutputData)
+
+	req = createMultiDataRequest()
+	req.Training.InputData = append(req.Training.InputData, "missing")
+	_, err = s.CreateTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
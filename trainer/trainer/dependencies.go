@@ -0,0 +1,249 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+
+	"github.com/IBM/FfDL/commons/config"
+	"github.com/IBM/FfDL/commons/logger"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/mgo.v2"
+)
+
+// maxDependencies is the number of trainings a training can depend on
+const maxDependencies = 10
+
+// resultsLocation returns the RESULT_STORE_OBJECTID of a training, the path its learners store the results under
+func resultsLocation(ds *grpc_trainer_v2.Datastore, trainingID string) string {
+	return fmt.Sprintf("%s/%s", ds.Fields["bucket"], trainingID)
+}
+
+// resolveDependencies validates the trainings the requested training depends on, and points its first input data
+// at the results of the first one. It returns whether all of them have already completed.
+func (s *trainerService) resolveDependencies(req *grpc_trainer_v2.CreateRequest, logr *logger.LocLoggingEntry) (bool, error) {
+	dependsOn := req.Training.DependsOn
+	if len(dependsOn) == 0 {
+		return true, nil
+	}
+	if len(dependsOn) > maxDependencies {
+		return false, s.failCreateRequest(fmt.Sprintf("A training can depend on at most %d trainings", maxDependencies), req, logr.Logger)
+	}
+
+	completed := true
+	var first *TrainingRecord
+	seen := make(map[string]bool)
+	for _, id := range dependsOn {
+		if seen[id] {
+			return false, s.failCreateRequest(fmt.Sprintf("Training %s is listed more than once in depends_on", id), req, logr.Logger)
+		}
+		seen[id] = true
+
+		parent, err := s.repo.Find(id)
+		if err != nil && err != mgo.ErrNotFound {
+			logr.WithError(err).Errorf("Cannot retrieve training '%s'", id)
+			return false, gerrf(codes.Internal, grpcErrorDesc(err))
+		}
+		// do not tell users about trainings of other users
+		if err == mgo.ErrNotFound || parent.UserID != req.UserId {
+			return false, s.failCreateRequest(fmt.Sprintf("Training %s in depends_on not found", id), req, logr.Logger)
+		}
+		// a halted training may still be resumed, so the training waits for it like for a running one
+		switch parent.TrainingStatus.Status {
+		case grpc_trainer_v2.Status_FAILED:
+			return false, gerrf(codes.FailedPrecondition, "Training %s in depends_on is %s", id, parent.TrainingStatus.Status)
+		case grpc_trainer_v2.Status_COMPLETED:
+		default:
+			completed = false
+		}
+		if first == nil {
+			first = parent
+		}
+	}
+
+	resolved, err := s.dependencyResultsStore(first, req.Datastores)
+	if err != nil {
+		return false, err
+	}
+	req.Datastores = append(req.Datastores, resolved)
+	// any further input data, such as pretrained weights, is left as it is
+	req.Training.InputData[0] = resolved.Id
+	logr.Infof("Training input data resolved to the results of %s: %s", first.TrainingID, resolved.Fields["bucket"])
+
+	return completed, nil
+}
+
+// dependencyResultsStore returns the data store a training reads the results of the parent training it depends on
+// from, which is the output data store of the parent pointed at its results. The credentials of the parent's data
+// stores are erased when it finishes, so the data store with the same id in datastores is used if there is one.
+// Results in the internal object store are read with the trainer's own credentials, which are only added when the
+// training is deployed, so that they are never stored with it.
+func (s *trainerService) dependencyResultsStore(parent *TrainingRecord, datastores []*grpc_trainer_v2.Datastore) (*grpc_trainer_v2.Datastore, error) {
+	location := parent.ResultsLocation
+	if location == "" {
+		// trainings submitted by older versions did not record where their results are
+		if parent.TrainingStatus.Status == grpc_trainer_v2.Status_COMPLETED {
+			return nil, gerrf(codes.FailedPrecondition, "The results location of training %s is unknown", parent.TrainingID)
+		}
+		location = resultsLocation(s.getOutputDatastore(parent.Training.OutputData, parent.Datastores), parent.TrainingID)
+	}
+
+	if len(parent.Training.OutputData) == 0 {
+		return &grpc_trainer_v2.Datastore{
+			Id:     internalObjectStoreID,
+			Type:   config.GetDataStoreType(),
+			Fields: map[string]string{"bucket": location},
+		}, nil
+	}
+	id := parent.Training.OutputData[0]
+	output := findDatastore(id, datastores)
+	if output == nil {
+		output = findDatastore(id, parent.Datastores)
+	}
+	if output == nil {
+		return nil, gerrf(codes.InvalidArgument, "Data store %s that training %s stored its results in is missing", id, parent.TrainingID)
+	}
+	resolved := &grpc_trainer_v2.Datastore{
+		Id:         fmt.Sprintf("%s-results", parent.TrainingID),
+		Type:       output.Type,
+		Connection: output.Connection,
+		Fields:     map[string]string{},
+	}
+	for k, v := range output.Fields {
+		resolved.Fields[k] = v
+	}
+	resolved.Fields["bucket"] = location
+	return resolved, nil
+}
+
+// checkDependencies looks up the trainings tr depends on. It returns whether all of them have completed, or
+// the reason why tr can never be started.
+func (s *trainerService) checkDependencies(tr *TrainingRecord) (bool, string, error) {
+	completed := true
+	for _, id := range tr.Training.DependsOn {
+		status, err := s.repo.FindTrainingStatusID(id)
+		if err == mgo.ErrNotFound {
+			return false, fmt.Sprintf("Training %s it depends on was deleted", id), nil
+		}
+		if err != nil {
+			return false, "", err
+		}
+		// a halted training may still be resumed, so the training keeps waiting for it
+		switch status {
+		case grpc_trainer_v2.Status_FAILED:
+			return false, fmt.Sprintf("Training %s it depends on is %s", id, status), nil
+		case grpc_trainer_v2.Status_COMPLETED:
+		default:
+			completed = false
+		}
+	}
+	return completed, "", nil
+}
+
+// releaseWaitingJob queues a WAITING training once all the trainings it depends on have completed, and fails it
+// if one of them failed or was deleted.
+func (s *trainerService) releaseWaitingJob(trainingID string, logr *logger.LocLoggingEntry) {
+	tr, err := s.repo.Find(trainingID)
+	if err != nil || tr.TrainingStatus.Status != grpc_trainer_v2.Status_WAITING {
+		return
+	}
+	completed, reason, err := s.checkDependencies(tr)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot check the dependencies of training %s", trainingID)
+		return
+	}
+
+	if reason != "" {
+		// failing the training releases the trainings waiting for it in turn
+		logr.Infof("Cancelling training %s: %s", trainingID, reason)
+		_, err := updateTrainingJobPostLock(s, &grpc_trainer_v2.UpdateRequest{
+			TrainingId:    trainingID,
+			UserId:        tr.UserID,
+			Status:        grpc_trainer_v2.Status_FAILED,
+			StatusMessage: reason,
+			ErrorCode:     trainerClient.ErrDependencyFailed,
+		})
+		if err != nil {
+			logr.WithError(err).Errorf("Unable to update status of training %s to FAILED", trainingID)
+		}
+		return
+	}
+	if !completed {
+		return
+	}
+
+	gpuType := TransformResourceName(tr.Training.Resources.GpuType)
+	qHandler := s.queues[gpuType]
+	if qHandler == nil {
+		qHandler = s.queues["ANY"]
+	}
+
+	// hold the queue lock so that trainings completing at the same time enqueue the job only once
+	if err := qHandler.Lock(); err != nil {
+		logr.WithError(err).Errorf("Failed to lock %s queue", gpuType)
+		return
+	}
+	defer func() {
+		if err := qHandler.Unlock(); err != nil {
+			logr.WithError(err).Errorf("Failed to unlock %s queue", gpuType)
+		}
+	}()
+
+	status, err := s.repo.FindTrainingStatusID(trainingID)
+	if err != nil || status != grpc_trainer_v2.Status_WAITING {
+		return
+	}
+	_, err = updateTrainingJobPostLock(s, &grpc_trainer_v2.UpdateRequest{
+		TrainingId:    trainingID,
+		UserId:        tr.UserID,
+		Status:        grpc_trainer_v2.Status_QUEUED,
+		StatusMessage: "Dependencies completed",
+	})
+	if err != nil {
+		logr.WithError(err).Errorf("Unable to update status of training %s to QUEUED", trainingID)
+		return
+	}
+	if err := qHandler.Enqueue(trainingID, tr.Priority); err != nil {
+		_, err = updateTrainingJobPostLock(s, &grpc_trainer_v2.UpdateRequest{
+			TrainingId:    trainingID,
+			UserId:        tr.UserID,
+			Status:        grpc_trainer_v2.Status_FAILED,
+			StatusMessage: "Job could not be enqueued",
+			ErrorCode:     trainerClient.ErrCodeFailEnqueue,
+		})
+		if err != nil {
+			logr.WithError(err).Errorf("Unable to update status of training %s to FAILED", trainingID)
+		}
+		return
+	}
+	s.metrics.enqueueJobCounter.Add(1)
+	logr.Infof("Dependencies of training %s completed, added to queue %s", trainingID, gpuType)
+}
+
+// releaseDependents releases the trainings waiting for the given training, after it finished or was deleted.
+func (s *trainerService) releaseDependents(trainingID string, logr *logger.LocLoggingEntry) {
+	dependents, err := s.repo.FindWaitingDependents(trainingID)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot retrieve the trainings depending on %s", trainingID)
+		return
+	}
+	for _, dependent := range dependents {
+		s.releaseWaitingJob(dependent.TrainingID, logr)
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/config"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func createParentRecord(id string, userID string, status grpc_trainer_v2.Status) *TrainingRecord {
+	tr := createQuotaRecord(id, userID, 1, 1, status)
+	tr.ModelDefinition = &grpc_trainer_v2.ModelDefinition{
+		Framework: &grpc_trainer_v2.Framework{Name: "tensorflow", Version: "1.5"},
+	}
+	tr.ResultsLocation = "results/" + id
+	return tr
+}
+
+func createDependentRequest(dependsOn ...string) *grpc_trainer_v2.CreateRequest {
+	req := createExperimentRequest("alice", 1).Base
+	req.UserId = "alice"
+	req.Training.DependsOn = dependsOn
+	return req
+}
+
+func TestDependentJobQueuedOnCompletion(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	assert.NoError(t, s.repo.Store(createParentRecord("first", "alice", grpc_trainer_v2.Status_PROCESSING)))
+	assert.NoError(t, s.repo.Store(createParentRecord("second", "alice", grpc_trainer_v2.Status_COMPLETED)))
+
+	resp, err := s.CreateTrainingJob(context.Background(), createDependentRequest("first", "second"))
+	assert.NoError(t, err)
+
+	tr, err := s.repo.Find(resp.TrainingId)
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_trainer_v2.Status_WAITING, tr.TrainingStatus.Status)
+	assert.Equal(t, "results/first", findDatastore(tr.Training.InputData[0], tr.Datastores).Fields["bucket"])
+	assert.Equal(t, "results/"+resp.TrainingId, tr.ResultsLocation)
+	empty, _ := queue.Empty()
+	assert.True(t, empty)
+
+	_, err = s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "first",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_COMPLETED,
+	})
+	assert.NoError(t, err)
+
+	status, _ := s.repo.FindTrainingStatusID(resp.TrainingId)
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, status)
+	id, _ := queue.Peek()
+	assert.Equal(t, resp.TrainingId, id)
+}
+
+func TestDependentJobsCancelled(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	child, err := s.CreateTrainingJob(context.Background(), createDependentRequest("parent"))
+	assert.NoError(t, err)
+	grandchild, err := s.CreateTrainingJob(context.Background(), createDependentRequest(child.TrainingId))
+	assert.NoError(t, err)
+
+	_, err = s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "parent",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_FAILED,
+	})
+	assert.NoError(t, err)
+
+	for _, id := range []string{child.TrainingId, grandchild.TrainingId} {
+		tr, err := s.repo.Find(id)
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_trainer_v2.Status_FAILED, tr.TrainingStatus.Status)
+		assert.Equal(t, trainerClient.ErrDependencyFailed, tr.TrainingStatus.ErrorCode)
+	}
+	empty, _ := queue.Empty()
+	assert.True(t, empty)
+}
+
+func TestCreateDependentJobInvalid(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	assert.NoError(t, s.repo.Store(createParentRecord("other", "bob", grpc_trainer_v2.Status_COMPLETED)))
+	assert.NoError(t, s.repo.Store(createParentRecord("failed", "alice", grpc_trainer_v2.Status_FAILED)))
+
+	_, err := s.CreateTrainingJob(context.Background(), createDependentRequest("other"))
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+	_, err = s.CreateTrainingJob(context.Background(), createDependentRequest("missing"))
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+	_, err = s.CreateTrainingJob(context.Background(), createDependentRequest("failed"))
+	assert.Equal(t, codes.FailedPrecondition, grpcCode(err))
+}
+
+func TestDependentJobWaitsForHaltedParent(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	assert.NoError(t, s.repo.Store(createParentRecord("halted", "alice", grpc_trainer_v2.Status_HALTED)))
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	// a halted parent may still be resumed
+	resp, err := s.CreateTrainingJob(context.Background(), createDependentRequest("halted"))
+	assert.NoError(t, err)
+	status, _ := s.repo.FindTrainingStatusID(resp.TrainingId)
+	assert.Equal(t, grpc_trainer_v2.Status_WAITING, status)
+
+	child, err := s.CreateTrainingJob(context.Background(), createDependentRequest("parent"))
+	assert.NoError(t, err)
+	update := &grpc_trainer_v2.UpdateRequest{TrainingId: "parent", UserId: "alice", Status: grpc_trainer_v2.Status_HALTED}
+	_, err = s.UpdateTrainingJob(context.Background(), update)
+	assert.NoError(t, err)
+	status, _ = s.repo.FindTrainingStatusID(child.TrainingId)
+	assert.Equal(t, grpc_trainer_v2.Status_WAITING, status)
+
+	update.Status = grpc_trainer_v2.Status_FAILED
+	_, err = s.UpdateTrainingJob(context.Background(), update)
+	assert.NoError(t, err)
+	status, _ = s.repo.FindTrainingStatusID(child.TrainingId)
+	assert.Equal(t, grpc_trainer_v2.Status_FAILED, status)
+}
+
+func TestDependencyResultsStore(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	// the parent has completed, so the child is queued behind another job rather than started
+	assert.NoError(t, queue.Enqueue("blocker", 0))
+	// the credentials of the parent's data stores were erased when it completed
+	parent := createParentRecord("parent", "alice", grpc_trainer_v2.Status_COMPLETED)
+	parent.Training.OutputData = []string{"results"}
+	assert.NoError(t, s.repo.Store(parent))
+
+	resp, err := s.CreateTrainingJob(context.Background(), createDependentRequest("parent"))
+	assert.NoError(t, err)
+	tr, _ := s.repo.Find(resp.TrainingId)
+	assert.Equal(t, "parent-results", tr.Training.InputData[0])
+	results := findDatastore("parent-results", tr.Datastores)
+	assert.Equal(t, "results/parent", results.Fields["bucket"])
+
+	// the child has to name the data store the parent stored its results in
+	req := createDependentRequest("parent")
+	req.Training.OutputData = nil
+	req.Datastores = req.Datastores[:1]
+	_, err = s.CreateTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+
+	// results in the internal object store are read with the credentials of the trainer, which are not stored
+	internal, err := s.dependencyResultsStore(createParentRecord("internal", "alice", grpc_trainer_v2.Status_COMPLETED), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, internalObjectStoreID, internal.Id)
+	assert.Empty(t, internal.Connection)
+
+	tr = createDeployableRecord("child", "alice", 1)
+	tr.Training.InputData[0] = internal.Id
+	tr.Datastores = append(tr.Datastores, internal)
+	job, err := s.createJobConfig(tr)
+	assert.NoError(t, err)
+	assert.Equal(t, "results/internal", job.EnvVars["DATA_STORE_OBJECTID"])
+	assert.Equal(t, config.GetDataStoreType(), job.EnvVars["DATA_STORE_TYPE"])
+}
@@ -0,0 +1,626 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	tdsService "github.com/IBM/FfDL/metrics/service/grpc_training_data_v1"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/instrumentation"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/viper"
+	"github.com/ventu-io/go-shortid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/mgo.v2"
+)
+
+// An experiment runs one training job (a trial) for each set of parameter values of its parameter space. All
+// trials are generated when the experiment is created and started by the experiment scheduler, which runs on
+// every poll interval and keeps at most max_parallel trials running at a time. The scheduler holds the experiment
+// lock while it reads and updates the experiments, but not while it creates the training jobs of the trials, which
+// may take as long as a deployment. Trials are created with an idempotency key, so a trial that two trainer replicas
+// start at the same time still gets a single training job.
+
+// number of evaluation metrics records at the end of a training that are searched for the objective metric
+const experimentMetricsWindow = 100
+
+var hyperParameterNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func getMaxExperimentTrials() int {
+	return viper.GetInt(maxExperimentTrialsKey)
+}
+
+// hyperParameterEnvVar returns the name of the environment variable that holds the value of the parameter
+func hyperParameterEnvVar(name string) string {
+	return "HP_" + strings.ToUpper(name)
+}
+
+// substituteParameters replaces the ${name} placeholders in the training command with the values of the trial
+func substituteParameters(command string, parameters map[string]string) string {
+	for name, value := range parameters {
+		command = strings.Replace(command, "${"+name+"}", value, -1)
+	}
+	return command
+}
+
+// validateExperimentSpec checks the parameter space of an experiment
+func validateExperimentSpec(spec *grpc_trainer_v2.ExperimentSpec) error {
+	if len(spec.Parameters) == 0 {
+		return gerrf(codes.InvalidArgument, "An experiment needs at least one parameter.")
+	}
+	if spec.MaxParallel < 0 {
+		return gerrf(codes.InvalidArgument, "max_parallel must not be negative.")
+	}
+	if spec.Objective != nil && spec.Objective.Metric == "" {
+		return gerrf(codes.InvalidArgument, "The objective of an experiment needs a metric.")
+	}
+
+	names := make(map[string]bool)
+	for _, p := range spec.Parameters {
+		if !hyperParameterNameRegex.MatchString(p.Name) {
+			return gerrf(codes.InvalidArgument, "Parameter name '%s' must only contain letters, digits and underscores.", p.Name)
+		}
+		if names[strings.ToUpper(p.Name)] {
+			return gerrf(codes.InvalidArgument, "Parameter '%s' is defined more than once.", p.Name)
+		}
+		names[strings.ToUpper(p.Name)] = true
+
+		switch spec.Strategy {
+		case grpc_trainer_v2.ExperimentSpec_GRID, grpc_trainer_v2.ExperimentSpec_LIST:
+			if len(p.Values) == 0 {
+				return gerrf(codes.InvalidArgument, "Parameter '%s' needs values for the %s strategy.", p.Name, spec.Strategy)
+			}
+			if spec.Strategy == grpc_trainer_v2.ExperimentSpec_LIST && len(p.Values) != len(spec.Parameters[0].Values) {
+				return gerrf(codes.InvalidArgument, "All parameters need the same number of values for the LIST strategy.")
+			}
+		case grpc_trainer_v2.ExperimentSpec_RANDOM:
+			if len(p.Values) > 0 {
+				continue
+			}
+			if p.Integer && math.Floor(p.Max) < math.Ceil(p.Min) || !p.Integer && p.Max <= p.Min {
+				return gerrf(codes.InvalidArgument, "Parameter '%s' needs values or a range with min < max.", p.Name)
+			}
+		}
+	}
+	if spec.Strategy == grpc_trainer_v2.ExperimentSpec_RANDOM && spec.MaxTrials <= 0 {
+		return gerrf(codes.InvalidArgument, "max_trials must be positive for the RANDOM strategy.")
+	}
+	return nil
+}
+
+// generateTrials returns the trials for the parameter space of a validated spec, or an error if there would
+// be more than maxTrials trials
+func generateTrials(spec *grpc_trainer_v2.ExperimentSpec, maxTrials int, rnd *rand.Rand) ([]*TrialRecord, error) {
+	var parameterSets []map[string]string
+
+	switch spec.Strategy {
+	case grpc_trainer_v2.ExperimentSpec_GRID:
+		count := 1
+		for _, p := range spec.Parameters {
+			count *= len(p.Values)
+			if count > maxTrials {
+				return nil, gerrf(codes.InvalidArgument, "The parameter grid has more than %d combinations.", maxTrials)
+			}
+		}
+		// the first parameter changes slowest
+		parameterSets = []map[string]string{{}}
+		for _, p := range spec.Parameters {
+			var next []map[string]string
+			for _, set := range parameterSets {
+				for _, value := range p.Values {
+					c := make(map[string]string, len(set)+1)
+					for k, v := range set {
+						c[k] = v
+					}
+					c[p.Name] = value
+					next = append(next, c)
+				}
+			}
+			parameterSets = next
+		}
+
+	case grpc_trainer_v2.ExperimentSpec_LIST:
+		count := len(spec.Parameters[0].Values)
+		if count > maxTrials {
+			return nil, gerrf(codes.InvalidArgument, "The experiment has more than %d trials.", maxTrials)
+		}
+		for i := 0; i < count; i++ {
+			set := make(map[string]string)
+			for _, p := range spec.Parameters {
+				set[p.Name] = p.Values[i]
+			}
+			parameterSets = append(parameterSets, set)
+		}
+
+	case grpc_trainer_v2.ExperimentSpec_RANDOM:
+		if int(spec.MaxTrials) > maxTrials {
+			return nil, gerrf(codes.InvalidArgument, "The experiment has more than %d trials.", maxTrials)
+		}
+		for i := 0; i < int(spec.MaxTrials); i++ {
+			set := make(map[string]string)
+			for _, p := range spec.Parameters {
+				set[p.Name] = sampleParameter(p, rnd)
+			}
+			parameterSets = append(parameterSets, set)
+		}
+	}
+
+	trials := make([]*TrialRecord, len(parameterSets))
+	for i, set := range parameterSets {
+		trials[i] = &TrialRecord{
+			Index:      int32(i),
+			Parameters: set,
+			Status:     grpc_trainer_v2.Status_NOT_STARTED,
+		}
+	}
+	return trials, nil
+}
+
+// sampleParameter picks one of the values of the parameter, or a value from its range if it has no values
+func sampleParameter(p *grpc_trainer_v2.HyperParameter, rnd *rand.Rand) string {
+	if len(p.Values) > 0 {
+		return p.Values[rnd.Intn(len(p.Values))]
+	}
+	if p.Integer {
+		min := int64(math.Ceil(p.Min))
+		max := int64(math.Floor(p.Max))
+		return strconv.FormatInt(min+rnd.Int63n(max-min+1), 10)
+	}
+	return strconv.FormatFloat(p.Min+rnd.Float64()*(p.Max-p.Min), 'g', -1, 64)
+}
+
+// isTrialFinished returns true if the training job of the trial will not run anymore
+func isTrialFinished(trial *TrialRecord) bool {
+	return trial.Status == grpc_trainer_v2.Status_COMPLETED || trial.Status == grpc_trainer_v2.Status_FAILED
+}
+
+// isTrialRunning returns true if the trial counts towards the max_parallel limit. Halted trials do not,
+// but they keep the experiment running until they are resumed and finish.
+func isTrialRunning(trial *TrialRecord) bool {
+	return trial.TrainingID != "" && !isTrialFinished(trial) && trial.Status != grpc_trainer_v2.Status_HALTED
+}
+
+// bestTrial returns the completed trial with the best objective value, or nil if no trial has a value yet
+func bestTrial(trials []*TrialRecord, minimize bool) *TrialRecord {
+	var best *TrialRecord
+	for _, trial := range trials {
+		if trial.Status != grpc_trainer_v2.Status_COMPLETED || !trial.HasValue {
+			continue
+		}
+		if best == nil || minimize && trial.Value < best.Value || !minimize && trial.Value > best.Value {
+			best = trial
+		}
+	}
+	return best
+}
+
+func experimentToProto(e *ExperimentRecord) *grpc_trainer_v2.Experiment {
+	exp := &grpc_trainer_v2.Experiment{
+		ExperimentId:        e.ExperimentID,
+		UserId:              e.UserID,
+		Name:                e.Name,
+		Spec:                e.Spec,
+		State:               e.State,
+		Trials:              make([]*grpc_trainer_v2.Trial, len(e.Trials)),
+		SubmissionTimestamp: e.SubmissionTimestamp,
+		CompletionTimestamp: e.CompletionTimestamp,
+	}
+	for i, trial := range e.Trials {
+		exp.Trials[i] = &grpc_trainer_v2.Trial{
+			Index:      trial.Index,
+			Parameters: trial.Parameters,
+			TrainingId: trial.TrainingID,
+			Status:     trial.Status,
+			Value:      trial.Value,
+			HasValue:   trial.HasValue,
+		}
+	}
+	if e.Spec != nil && e.Spec.Objective != nil {
+		if best := bestTrial(e.Trials, e.Spec.Objective.Minimize); best != nil {
+			exp.BestTrainingId = best.TrainingID
+			exp.BestValue = best.Value
+		}
+	}
+	return exp
+}
+
+func (s *trainerService) CreateExperiment(ctx context.Context, req *grpc_trainer_v2.CreateExperimentRequest) (*grpc_trainer_v2.CreateExperimentResponse, error) {
+	sid, _ := shortid.Generate()
+	id := fmt.Sprintf("experiment-%s", sid)
+
+	logr := logger.LocLogger(logWithExperiment(id, req.UserId))
+
+	cl := instrumentation.NewCallLogger(ctx, "CreateExperiment", logr)
+	defer cl.Returned()
+
+	if req.Base == nil || req.Spec == nil {
+		return nil, gerrf(codes.InvalidArgument, "An experiment needs a base training job and a parameter spec.")
+	}
+	if req.Base.ModelDefinition == nil || len(req.Base.ModelDefinition.Content) == 0 {
+		return nil, gerrf(codes.InvalidArgument, "An experiment needs the content of the model definition.")
+	}
+	req.Base.UserId = req.UserId
+	if err := s.validateRequest(logr.Logger, req.Base); err != nil {
+		return nil, err
+	}
+	if err := validateExperimentSpec(req.Spec); err != nil {
+		return nil, err
+	}
+	trials, err := generateTrials(req.Spec, getMaxExperimentTrials(), rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		return nil, err
+	}
+
+	// the model definition is stored once and downloaded for every trial
+	err = s.datastore.UploadArchive(s.modelsBucket, getModelZipFileName(id), req.Base.ModelDefinition.Content)
+	if err != nil {
+		logr.WithError(err).Errorf("Error uploading model to object store")
+		s.metrics.uploadModelFailedCounter.With("kind", dlaasStoreKind).Add(1)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	cl.Observe("uploaded model to dlaas object store")
+
+	base := proto.Clone(req.Base).(*grpc_trainer_v2.CreateRequest)
+	base.ModelDefinition.Content = nil
+	// every trial creates its own training
+	base.IdempotencyKey = ""
+
+	e := &ExperimentRecord{
+		ExperimentID:        id,
+		UserID:              req.UserId,
+		Name:                req.Name,
+		Base:                base,
+		Spec:                req.Spec,
+		State:               grpc_trainer_v2.Experiment_RUNNING,
+		Trials:              trials,
+		SubmissionTimestamp: trainerClient.CurrentTimestampAsString(),
+	}
+	if err := s.experimentRepo.Store(e); err != nil {
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	s.metrics.createExperimentCounter.With("strategy", req.Spec.Strategy.String()).Add(1)
+	logr.Infof("created experiment with %d trials", len(trials))
+
+	return &grpc_trainer_v2.CreateExperimentResponse{ExperimentId: id}, nil
+}
+
+func (s *trainerService) GetExperiment(ctx context.Context, req *grpc_trainer_v2.GetExperimentRequest) (*grpc_trainer_v2.GetExperimentResponse, error) {
+	logr := logger.LocLogger(logWithExperiment(req.ExperimentId, req.UserId))
+
+	cl := instrumentation.NewCallLogger(ctx, "GetExperiment", logr)
+	defer cl.Returned()
+
+	e, err := s.experimentRepo.Find(req.ExperimentId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, gerrf(codes.NotFound, "Experiment with id %s not found.", req.ExperimentId)
+		}
+		logr.WithError(err).Errorf("Cannot retrieve experiment record")
+		return nil, err
+	}
+	if e.UserID != req.UserId {
+		msg := fmt.Sprint("User does not have permission to read experiment data")
+		logr.Error(msg)
+		return nil, gerrf(codes.PermissionDenied, msg)
+	}
+	return &grpc_trainer_v2.GetExperimentResponse{Experiment: experimentToProto(e)}, nil
+}
+
+func (s *trainerService) GetAllExperiments(ctx context.Context, req *grpc_trainer_v2.GetAllExperimentsRequest) (*grpc_trainer_v2.GetAllExperimentsResponse, error) {
+	logr := logger.LocLogger(logEntry().WithField(logger.LogkeyUserID, req.UserId))
+
+	cl := instrumentation.NewCallLogger(ctx, "GetAllExperiments", logr)
+	defer cl.Returned()
+
+	experiments, err := s.experimentRepo.FindAll(req.UserId)
+	if err != nil {
+		msg := "Failed to retrieve all experiments"
+		logr.WithError(err).Error(msg)
+		return nil, gerrf(codes.Internal, msg)
+	}
+	resp := &grpc_trainer_v2.GetAllExperimentsResponse{
+		Experiments: make([]*grpc_trainer_v2.Experiment, len(experiments)),
+	}
+	for i, e := range experiments {
+		resp.Experiments[i] = experimentToProto(e)
+	}
+	return resp, nil
+}
+
+// startExperimentScheduler advances the running experiments on every poll interval until the trainer stops
+func (s *trainerService) startExperimentScheduler() {
+	s.stopExperiments = make(chan struct{})
+	tick := time.NewTicker(time.Duration(viper.GetInt(pollIntervalKey)) * time.Second).C
+	go func() {
+		for {
+			select {
+			case <-tick:
+				s.advanceExperiments()
+			case <-s.stopExperiments:
+				return
+			}
+		}
+	}()
+}
+
+// trialStart is a trial the experiment scheduler starts, and the training job it was started as
+type trialStart struct {
+	experiment *ExperimentRecord
+	trial      *TrialRecord
+	trainingID string
+	err        error
+}
+
+// advanceExperiments updates the trials of all running experiments and starts new trials where possible
+func (s *trainerService) advanceExperiments() {
+	logr := logger.LocLogger(logEntry())
+
+	var starts []*trialStart
+	locked := s.withExperimentLock(logr, func() {
+		experiments, err := s.experimentRepo.FindRunning()
+		if err != nil {
+			logr.WithError(err).Errorf("failed to retrieve running experiments")
+			return
+		}
+		for _, e := range experiments {
+			starts = append(starts, s.advanceExperiment(e)...)
+		}
+	})
+	if !locked || len(starts) == 0 {
+		return
+	}
+
+	s.startTrials(starts)
+
+	s.withExperimentLock(logr, func() {
+		s.recordTrialStarts(starts)
+	})
+}
+
+// withExperimentLock calls f while holding the experiment lock, it returns false if the lock was not acquired
+func (s *trainerService) withExperimentLock(logr *logger.LocLoggingEntry, f func()) bool {
+	if err := s.experimentLock.Lock(); err != nil {
+		logr.WithError(err).Debugf("failed to acquire experiment lock")
+		return false
+	}
+	defer func() {
+		if err := s.experimentLock.Unlock(); err != nil {
+			logr.WithError(err).Errorf("failed to release experiment lock")
+		}
+	}()
+	f()
+	return true
+}
+
+// advanceExperiment refreshes the status and objective value of the trials of the experiment and completes the
+// experiment once all trials have finished. It returns the trials to start, up to max_parallel running ones.
+// trainer should hold the experiment lock while calling advanceExperiment()
+func (s *trainerService) advanceExperiment(e *ExperimentRecord) []*trialStart {
+	logr := logger.LocLogger(logWithExperiment(e.ExperimentID, e.UserID))
+	changed := false
+	running := 0
+
+	for _, trial := range e.Trials {
+		if trial.TrainingID == "" {
+			continue
+		}
+		if !isTrialFinished(trial) {
+			status, err := s.repo.FindTrainingStatusID(trial.TrainingID)
+			if err == mgo.ErrNotFound {
+				// the training job was deleted by the user
+				status = grpc_trainer_v2.Status_FAILED
+			} else if err != nil {
+				logr.WithError(err).Errorf("failed to retrieve status of trial %d", trial.Index)
+				running++
+				continue
+			}
+			if status != trial.Status {
+				trial.Status = status
+				changed = true
+			}
+		}
+		if trial.Status == grpc_trainer_v2.Status_COMPLETED && e.Spec.Objective != nil && !trial.ValueChecked {
+			value, found, err := s.objectiveValue(e, trial)
+			if err != nil {
+				logr.WithError(err).Warnf("failed to retrieve %s of trial %d", e.Spec.Objective.Metric, trial.Index)
+			} else {
+				trial.Value, trial.HasValue, trial.ValueChecked = value, found, true
+				changed = true
+			}
+		}
+		if isTrialRunning(trial) {
+			running++
+		}
+	}
+
+	var starts []*trialStart
+	for _, trial := range e.Trials {
+		if trial.TrainingID != "" || trial.Status == grpc_trainer_v2.Status_FAILED {
+			continue
+		}
+		if e.Spec.MaxParallel > 0 && running >= int(e.Spec.MaxParallel) {
+			break
+		}
+		starts = append(starts, &trialStart{experiment: e, trial: trial})
+		running++
+	}
+
+	finished := true
+	for _, trial := range e.Trials {
+		if !isTrialFinished(trial) {
+			finished = false
+			break
+		}
+	}
+	if finished {
+		logr.Infof("all trials of experiment finished")
+		e.State = grpc_trainer_v2.Experiment_COMPLETED
+		e.CompletionTimestamp = trainerClient.CurrentTimestampAsString()
+		changed = true
+	}
+
+	if changed {
+		if err := s.experimentRepo.Store(e); err != nil {
+			logr.WithError(err).Errorf("failed to store experiment")
+		}
+	}
+	return starts
+}
+
+// startTrials creates the training jobs of the trials. The trials of an experiment after one that could not be
+// started for another reason than an invalid training job are left for the next poll interval.
+func (s *trainerService) startTrials(starts []*trialStart) {
+	var content []byte
+	var failed *ExperimentRecord
+	for i, start := range starts {
+		e := start.experiment
+		if e == failed {
+			continue
+		}
+		logr := logger.LocLogger(logWithExperiment(e.ExperimentID, e.UserID))
+		if i == 0 || starts[i-1].experiment != e {
+			var err error
+			content, err = s.datastore.DownloadArchive(s.modelsBucket, getModelZipFileName(e.ExperimentID))
+			if err != nil {
+				logr.WithError(err).Errorf("failed to download model definition of experiment")
+				failed = e
+				continue
+			}
+		}
+		start.trainingID, start.err = s.startTrial(e, start.trial, content)
+		if start.err != nil && grpcCode(start.err) != codes.InvalidArgument {
+			// try again on the next poll interval
+			logr.WithError(start.err).Errorf("failed to start trial %d", start.trial.Index)
+			failed = e
+		}
+	}
+}
+
+// recordTrialStarts stores the training jobs of the started trials, and marks the trials that are not valid training
+// jobs as failed. trainer should hold the experiment lock while calling recordTrialStarts()
+func (s *trainerService) recordTrialStarts(starts []*trialStart) {
+	for i := 0; i < len(starts); {
+		e := starts[i].experiment
+		logr := logger.LocLogger(logWithExperiment(e.ExperimentID, e.UserID))
+		// the experiment may have been updated since the trials were picked
+		current, err := s.experimentRepo.Find(e.ExperimentID)
+		if err != nil {
+			logr.WithError(err).Errorf("failed to retrieve experiment")
+		}
+		changed := false
+		for ; i < len(starts) && starts[i].experiment == e; i++ {
+			start := starts[i]
+			if current == nil || (start.trainingID == "" && grpcCode(start.err) != codes.InvalidArgument) {
+				continue
+			}
+			for _, trial := range current.Trials {
+				if trial.Index != start.trial.Index || trial.TrainingID != "" {
+					continue
+				}
+				if start.err != nil {
+					logr.WithError(start.err).Warnf("trial %d is not a valid training job", trial.Index)
+					trial.Status = grpc_trainer_v2.Status_FAILED
+				} else {
+					logr.Infof("started trial %d as training %s", trial.Index, start.trainingID)
+					trial.TrainingID = start.trainingID
+					trial.Status = grpc_trainer_v2.Status_QUEUED
+				}
+				s.metrics.experimentTrialCounter.Add(1)
+				changed = true
+			}
+		}
+		if changed {
+			if err := s.experimentRepo.Store(current); err != nil {
+				logr.WithError(err).Errorf("failed to store experiment")
+			}
+		}
+	}
+}
+
+// startTrial creates the training job of a trial from the base request of the experiment
+func (s *trainerService) startTrial(e *ExperimentRecord, trial *TrialRecord, content []byte) (string, error) {
+	req := proto.Clone(e.Base).(*grpc_trainer_v2.CreateRequest)
+	req.UserId = e.UserID
+	req.ModelDefinition.Content = content
+	// a trial started twice by different trainer replicas gets a single training job
+	req.IdempotencyKey = fmt.Sprintf("experiment-%s-trial-%d", e.ExperimentID, trial.Index)
+	if req.Training != nil {
+		req.Training.Command = substituteParameters(req.Training.Command, trial.Parameters)
+	}
+	resp, err := s.createTrainingJob(context.Background(), req, trial.Parameters)
+	if err != nil {
+		return "", err
+	}
+	return resp.TrainingId, nil
+}
+
+// objectiveValue returns the last value of the objective metric that the training job of the trial reported
+func (s *trainerService) objectiveValue(e *ExperimentRecord, trial *TrialRecord) (float64, bool, error) {
+	tds, err := s.tdsClient()
+	if err != nil {
+		return 0, false, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	query := &tdsService.Query{
+		Meta: &tdsService.MetaInfo{
+			TrainingId: trial.TrainingID,
+			UserId:     e.UserID,
+		},
+		Pos:        -experimentMetricsWindow,
+		Pagesize:   experimentMetricsWindow,
+		SearchType: tdsService.Query_TERM,
+	}
+	inStream, err := tds.Client().GetEMetrics(ctx, query)
+	if err != nil {
+		return 0, false, err
+	}
+
+	objective := e.Spec.Objective
+	value, found := 0.0, false
+	for {
+		chunk, err := inStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, false, err
+		}
+		if objective.Grouplabel != "" && chunk.Grouplabel != objective.Grouplabel {
+			continue
+		}
+		if v, ok := chunk.Values[objective.Metric]; ok {
+			if f, err := strconv.ParseFloat(v.Value, 64); err == nil {
+				value, found = f, true
+			}
+		}
+	}
+	return value, found, nil
+}
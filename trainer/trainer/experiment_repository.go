@@ -0,0 +1,248 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	log "github.com/sirupsen/logrus"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ExperimentRecord is the data structure we store in the Mongo collection "experiments"
+type ExperimentRecord struct {
+	ID           bson.ObjectId `bson:"_id,omitempty" json:"id"`
+	ExperimentID string        `bson:"experiment_id" json:"experiment_id"`
+	UserID       string        `bson:"user_id" json:"user_id"`
+	Name         string        `bson:"name,omitempty" json:"name"`
+	// the request used for every trial, without the model definition content which is kept in the object store
+	Base                *grpc_trainer_v2.CreateRequest   `bson:"base" json:"base"`
+	Spec                *grpc_trainer_v2.ExperimentSpec  `bson:"spec" json:"spec"`
+	State               grpc_trainer_v2.Experiment_State `bson:"state" json:"state"`
+	Trials              []*TrialRecord                   `bson:"trials" json:"trials"`
+	SubmissionTimestamp string                           `bson:"submission_timestamp,omitempty" json:"submission_timestamp"`
+	CompletionTimestamp string                           `bson:"completion_timestamp,omitempty" json:"completion_timestamp"`
+}
+
+// TrialRecord is a single set of parameter values of an experiment and the training job that runs it
+type TrialRecord struct {
+	Index      int32                  `bson:"index" json:"index"`
+	Parameters map[string]string      `bson:"parameters" json:"parameters"`
+	TrainingID string                 `bson:"training_id,omitempty" json:"training_id"`
+	Status     grpc_trainer_v2.Status `bson:"status" json:"status"`
+	Value      float64                `bson:"value,omitempty" json:"value"`
+	HasValue   bool                   `bson:"has_value,omitempty" json:"has_value"`
+	// set once the objective metric of a finished trial was looked up, whether or not a value was found
+	ValueChecked bool `bson:"value_checked,omitempty" json:"value_checked"`
+}
+
+type experimentRepository interface {
+	Store(e *ExperimentRecord) error
+	Find(experimentID string) (*ExperimentRecord, error)
+	FindAll(userID string) ([]*ExperimentRecord, error)
+	FindRunning() ([]*ExperimentRecord, error)
+	Close()
+}
+
+type experimentsRepository struct {
+	session    *mgo.Session
+	database   string
+	collection string
+}
+
+// inMemExperimentRepository is an experimentRepository kept in memory, for running the trainer without mongo.
+type inMemExperimentRepository struct {
+	mtx     sync.RWMutex
+	records []*ExperimentRecord // in insertion order
+}
+
+// newExperimentRepository creates a new repo for storing experiments.
+func newExperimentRepository(mongoURI string, database string, username string, password string,
+	cert string, collection string) (experimentRepository, error) {
+	log := logger.LocLogger(log.StandardLogger().WithField("module", "experimentRepository"))
+	log.Debugf("Creating mongo experiment repository for %s, collection %s:", mongoURI, collection)
+
+	session, err := ConnectMongo(mongoURI, database, username, password, cert)
+	if err != nil {
+		return nil, err
+	}
+	collectionObj := session.DB(database).C(collection)
+
+	repo := &experimentsRepository{
+		session:    session,
+		database:   collectionObj.Database.Name,
+		collection: collection,
+	}
+
+	// create index
+	collectionObj.EnsureIndexKey("user_id", "experiment_id")
+
+	return repo, nil
+}
+
+// newInMemExperimentRepository creates a new in-memory repo for experiments.
+func newInMemExperimentRepository() experimentRepository {
+	return &inMemExperimentRepository{}
+}
+
+func (r *experimentsRepository) Store(e *ExperimentRecord) error {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	var err error
+	if e.ID == "" {
+		err = sess.DB(r.database).C(r.collection).Insert(e)
+	} else {
+		err = sess.DB(r.database).C(r.collection).Update(bson.M{"_id": e.ID}, e)
+	}
+	if err != nil {
+		logWithExperiment(e.ExperimentID, e.UserID).Errorf("Error storing experiment record: %s", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (r *experimentsRepository) Find(experimentID string) (*ExperimentRecord, error) {
+	e := &ExperimentRecord{}
+	sess := r.session.Clone()
+	defer sess.Close()
+	err := sess.DB(r.database).C(r.collection).Find(bson.M{"experiment_id": experimentID}).One(e)
+	if err != nil {
+		logWithExperiment(experimentID, "").WithError(err).Debugf("Cannot retrieve experiment record")
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *experimentsRepository) FindAll(userID string) ([]*ExperimentRecord, error) {
+	var result []*ExperimentRecord
+	sess := r.session.Clone()
+	defer sess.Close()
+	err := sess.DB(r.database).C(r.collection).Find(bson.M{"user_id": userID}).Sort("-submission_timestamp").All(&result)
+	if err != nil {
+		log.WithField(logger.LogkeyUserID, userID).Errorf("Cannot retrieve all experiment records: %s", err.Error())
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *experimentsRepository) FindRunning() ([]*ExperimentRecord, error) {
+	var result []*ExperimentRecord
+	sess := r.session.Clone()
+	defer sess.Close()
+	err := sess.DB(r.database).C(r.collection).Find(bson.M{"state": grpc_trainer_v2.Experiment_RUNNING}).Sort("_id").All(&result)
+	return result, err
+}
+
+func (r *experimentsRepository) Close() {
+	log.Debugf("Closing mongo session")
+	defer r.session.Close()
+}
+
+// copyExperiment round-trips the record through bson, the same way it would be stored in and loaded from mongo
+func copyExperiment(e *ExperimentRecord) (*ExperimentRecord, error) {
+	data, err := bson.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	c := &ExperimentRecord{}
+	if err := bson.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *inMemExperimentRepository) Store(e *ExperimentRecord) error {
+	c, err := copyExperiment(e)
+	if err != nil {
+		logWithExperiment(e.ExperimentID, e.UserID).Errorf("Error storing experiment record: %s", err.Error())
+		return err
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if e.ID == "" {
+		c.ID = bson.NewObjectId()
+		r.records = append(r.records, c)
+		return nil
+	}
+	for i, existing := range r.records {
+		if existing.ID == e.ID {
+			r.records[i] = c
+			return nil
+		}
+	}
+	return mgo.ErrNotFound
+}
+
+func (r *inMemExperimentRepository) Find(experimentID string) (*ExperimentRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	for _, e := range r.records {
+		if e.ExperimentID == experimentID {
+			return copyExperiment(e)
+		}
+	}
+	return nil, mgo.ErrNotFound
+}
+
+func (r *inMemExperimentRepository) FindAll(userID string) ([]*ExperimentRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*ExperimentRecord
+	for _, e := range r.records {
+		if e.UserID == userID {
+			c, err := copyExperiment(e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, c)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].SubmissionTimestamp > result[j].SubmissionTimestamp
+	})
+	return result, nil
+}
+
+func (r *inMemExperimentRepository) FindRunning() ([]*ExperimentRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*ExperimentRecord
+	for _, e := range r.records {
+		if e.State == grpc_trainer_v2.Experiment_RUNNING {
+			c, err := copyExperiment(e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemExperimentRepository) Close() {
+}
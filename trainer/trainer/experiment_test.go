@@ -0,0 +1,267 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/storage"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGenerateGridTrials(t *testing.T) {
+	spec := &grpc_trainer_v2.ExperimentSpec{
+		Strategy: grpc_trainer_v2.ExperimentSpec_GRID,
+		Parameters: []*grpc_trainer_v2.HyperParameter{
+			{Name: "lr", Values: []string{"0.1", "0.01"}},
+			{Name: "batch", Values: []string{"32", "64", "128"}},
+		},
+	}
+	assert.NoError(t, validateExperimentSpec(spec))
+
+	trials, err := generateTrials(spec, 100, rand.New(rand.NewSource(1)))
+	assert.NoError(t, err)
+	if assert.Len(t, trials, 6) {
+		assert.Equal(t, map[string]string{"lr": "0.1", "batch": "32"}, trials[0].Parameters)
+		assert.Equal(t, map[string]string{"lr": "0.1", "batch": "64"}, trials[1].Parameters)
+		assert.Equal(t, map[string]string{"lr": "0.01", "batch": "128"}, trials[5].Parameters)
+		assert.EqualValues(t, 5, trials[5].Index)
+	}
+
+	_, err = generateTrials(spec, 5, rand.New(rand.NewSource(1)))
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
+
+func TestGenerateListTrials(t *testing.T) {
+	spec := &grpc_trainer_v2.ExperimentSpec{
+		Strategy: grpc_trainer_v2.ExperimentSpec_LIST,
+		Parameters: []*grpc_trainer_v2.HyperParameter{
+			{Name: "lr", Values: []string{"0.1", "0.01"}},
+			{Name: "batch", Values: []string{"32", "64"}},
+		},
+	}
+	assert.NoError(t, validateExperimentSpec(spec))
+
+	trials, err := generateTrials(spec, 100, rand.New(rand.NewSource(1)))
+	assert.NoError(t, err)
+	if assert.Len(t, trials, 2) {
+		assert.Equal(t, map[string]string{"lr": "0.01", "batch": "64"}, trials[1].Parameters)
+	}
+
+	spec.Parameters[1].Values = []string{"32"}
+	assert.Equal(t, codes.InvalidArgument, grpcCode(validateExperimentSpec(spec)))
+}
+
+func TestGenerateRandomTrials(t *testing.T) {
+	spec := &grpc_trainer_v2.ExperimentSpec{
+		Strategy:  grpc_trainer_v2.ExperimentSpec_RANDOM,
+		MaxTrials: 20,
+		Parameters: []*grpc_trainer_v2.HyperParameter{
+			{Name: "lr", Min: 0.001, Max: 0.1},
+			{Name: "layers", Min: 2, Max: 4, Integer: true},
+			{Name: "optimizer", Values: []string{"sgd", "adam"}},
+		},
+	}
+	assert.NoError(t, validateExperimentSpec(spec))
+
+	trials, err := generateTrials(spec, 100, rand.New(rand.NewSource(1)))
+	assert.NoError(t, err)
+	assert.Len(t, trials, 20)
+	for _, trial := range trials {
+		lr, err := strconv.ParseFloat(trial.Parameters["lr"], 64)
+		assert.NoError(t, err)
+		assert.True(t, lr >= 0.001 && lr <= 0.1)
+		assert.Contains(t, []string{"2", "3", "4"}, trial.Parameters["layers"])
+		assert.Contains(t, []string{"sgd", "adam"}, trial.Parameters["optimizer"])
+	}
+
+	spec.MaxTrials = 0
+	assert.Equal(t, codes.InvalidArgument, grpcCode(validateExperimentSpec(spec)))
+	spec.MaxTrials = 1
+	spec.Parameters[0].Max = spec.Parameters[0].Min
+	assert.Equal(t, codes.InvalidArgument, grpcCode(validateExperimentSpec(spec)))
+}
+
+func TestValidateExperimentParameterNames(t *testing.T) {
+	spec := &grpc_trainer_v2.ExperimentSpec{
+		Parameters: []*grpc_trainer_v2.HyperParameter{
+			{Name: "learning-rate", Values: []string{"0.1"}},
+		},
+	}
+	assert.Equal(t, codes.InvalidArgument, grpcCode(validateExperimentSpec(spec)))
+
+	// names map to the same environment variable
+	spec.Parameters = []*grpc_trainer_v2.HyperParameter{
+		{Name: "lr", Values: []string{"0.1"}},
+		{Name: "LR", Values: []string{"0.1"}},
+	}
+	assert.Equal(t, codes.InvalidArgument, grpcCode(validateExperimentSpec(spec)))
+}
+
+func TestSubstituteParameters(t *testing.T) {
+	command := substituteParameters("python train.py --lr ${lr} --batch ${batch} --lr2 ${lr} ${other}",
+		map[string]string{"lr": "0.1", "batch": "32"})
+	assert.Equal(t, "python train.py --lr 0.1 --batch 32 --lr2 0.1 ${other}", command)
+	assert.Equal(t, "HP_LR", hyperParameterEnvVar("lr"))
+}
+
+func TestBestTrial(t *testing.T) {
+	trials := []*TrialRecord{
+		{Index: 0, Status: grpc_trainer_v2.Status_COMPLETED, Value: 0.8, HasValue: true},
+		{Index: 1, Status: grpc_trainer_v2.Status_COMPLETED, Value: 0.9, HasValue: true},
+		{Index: 2, Status: grpc_trainer_v2.Status_COMPLETED},
+		{Index: 3, Status: grpc_trainer_v2.Status_FAILED, Value: 0.99, HasValue: true},
+	}
+	assert.EqualValues(t, 1, bestTrial(trials, false).Index)
+	assert.EqualValues(t, 0, bestTrial(trials, true).Index)
+	assert.Nil(t, bestTrial(trials[2:], false))
+}
+
+func createExperimentRequest(userID string, maxParallel int32) *grpc_trainer_v2.CreateExperimentRequest {
+	datastore := func(id string) *grpc_trainer_v2.Datastore {
+		return &grpc_trainer_v2.Datastore{
+			Id:     id,
+			Type:   storage.DataStoreTypeInMemory,
+			Fields: map[string]string{"bucket": id},
+		}
+	}
+	return &grpc_trainer_v2.CreateExperimentRequest{
+		UserId: userID,
+		Name:   "sweep",
+		Base: &grpc_trainer_v2.CreateRequest{
+			ModelDefinition: &grpc_trainer_v2.ModelDefinition{
+				Name:      "model",
+				Content:   []byte("zip"),
+				Framework: &grpc_trainer_v2.Framework{Name: "tensorflow", Version: "1.5"},
+			},
+			Training: &grpc_trainer_v2.Training{
+				Command:    "python train.py --lr ${lr}",
+				InputData:  []string{"data"},
+				OutputData: []string{"results"},
+			},
+			Datastores: []*grpc_trainer_v2.Datastore{datastore("data"), datastore("results")},
+		},
+		Spec: &grpc_trainer_v2.ExperimentSpec{
+			Strategy:    grpc_trainer_v2.ExperimentSpec_LIST,
+			MaxParallel: maxParallel,
+			Parameters: []*grpc_trainer_v2.HyperParameter{
+				{Name: "lr", Values: []string{"0.1", "0.01", "0.001"}},
+			},
+		},
+	}
+}
+
+func TestAdvanceExperiment(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	// keep the trials in the queue rather than submitting them to the LCM
+	assert.NoError(t, queue.Enqueue("blocker", 0))
+
+	resp, err := s.CreateExperiment(context.Background(), createExperimentRequest("alice", 2))
+	assert.NoError(t, err)
+
+	_, err = s.GetExperiment(context.Background(), &grpc_trainer_v2.GetExperimentRequest{ExperimentId: resp.ExperimentId, UserId: "bob"})
+	assert.Equal(t, codes.PermissionDenied, grpcCode(err))
+
+	s.advanceExperiments()
+
+	get, err := s.GetExperiment(context.Background(), &grpc_trainer_v2.GetExperimentRequest{ExperimentId: resp.ExperimentId, UserId: "alice"})
+	assert.NoError(t, err)
+	trials := get.Experiment.Trials
+	assert.Len(t, trials, 3)
+	assert.NotEmpty(t, trials[0].TrainingId)
+	assert.NotEmpty(t, trials[1].TrainingId)
+	assert.Empty(t, trials[2].TrainingId, "max_parallel trials are running")
+
+	tr, err := s.repo.Find(trials[1].TrainingId)
+	assert.NoError(t, err)
+	assert.Equal(t, "python train.py --lr 0.01", tr.Training.Command)
+	assert.Equal(t, map[string]string{"lr": "0.01"}, tr.HyperParameters)
+	assert.Empty(t, tr.ModelDefinition.Content)
+
+	for _, trial := range trials[:2] {
+		tr, _ := s.repo.Find(trial.TrainingId)
+		tr.TrainingStatus.Status = grpc_trainer_v2.Status_COMPLETED
+		assert.NoError(t, s.repo.Store(tr))
+	}
+	s.advanceExperiments()
+
+	get, _ = s.GetExperiment(context.Background(), &grpc_trainer_v2.GetExperimentRequest{ExperimentId: resp.ExperimentId, UserId: "alice"})
+	trials = get.Experiment.Trials
+	assert.Equal(t, grpc_trainer_v2.Status_COMPLETED, trials[0].Status)
+	assert.NotEmpty(t, trials[2].TrainingId)
+	assert.Equal(t, grpc_trainer_v2.Experiment_RUNNING, get.Experiment.State)
+
+	// a deleted training job counts as a failed trial
+	assert.NoError(t, s.repo.Delete(trials[2].TrainingId))
+	s.advanceExperiments()
+
+	get, _ = s.GetExperiment(context.Background(), &grpc_trainer_v2.GetExperimentRequest{ExperimentId: resp.ExperimentId, UserId: "alice"})
+	assert.Equal(t, grpc_trainer_v2.Status_FAILED, get.Experiment.Trials[2].Status)
+	assert.Equal(t, grpc_trainer_v2.Experiment_COMPLETED, get.Experiment.State)
+	assert.NotEmpty(t, get.Experiment.CompletionTimestamp)
+
+	all, err := s.GetAllExperiments(context.Background(), &grpc_trainer_v2.GetAllExperimentsRequest{UserId: "alice"})
+	assert.NoError(t, err)
+	assert.Len(t, all.Experiments, 1)
+}
+
+func TestAdvanceExperimentLock(t *testing.T) {
+	queue := newInMemJobQueue()
+	queue.lockRetries, queue.lockRetryDelay = 1, 0
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	experimentLock := newInMemJobQueue()
+	experimentLock.lockRetries, experimentLock.lockRetryDelay = 1, 0
+	s.experimentLock = experimentLock
+	assert.NoError(t, queue.Enqueue("blocker", 0))
+
+	resp, err := s.CreateExperiment(context.Background(), createExperimentRequest("alice", 2))
+	assert.NoError(t, err)
+
+	// no trials are started while another trainer holds the experiment lock
+	assert.True(t, experimentLock.tryLock())
+	s.advanceExperiments()
+	get, _ := s.GetExperiment(context.Background(), &grpc_trainer_v2.GetExperimentRequest{ExperimentId: resp.ExperimentId, UserId: "alice"})
+	assert.Empty(t, get.Experiment.Trials[0].TrainingId)
+	assert.NoError(t, experimentLock.Unlock())
+
+	// the job queues are not locked by the experiment scheduler
+	assert.True(t, queue.tryLock())
+	s.advanceExperiments()
+	get, _ = s.GetExperiment(context.Background(), &grpc_trainer_v2.GetExperimentRequest{ExperimentId: resp.ExperimentId, UserId: "alice"})
+	trials := get.Experiment.Trials
+	assert.NotEmpty(t, trials[0].TrainingId)
+	assert.NotEmpty(t, trials[1].TrainingId)
+	assert.NoError(t, queue.Unlock())
+	assert.True(t, experimentLock.tryLock(), "experiment lock is released")
+
+	// a trial started again, as by another trainer replica, gets the same training job
+	e, err := s.experimentRepo.Find(resp.ExperimentId)
+	assert.NoError(t, err)
+	trainingID, err := s.startTrial(e, e.Trials[0], []byte("zip"))
+	assert.NoError(t, err)
+	assert.Equal(t, trials[0].TrainingId, trainingID)
+}
@@ -21,6 +21,17 @@ It has these top-level messages:
 	CreateResponse
 	UpdateRequest
 	UpdateResponse
+	PriorityRequest
+	PriorityResponse
+	PurgeRequest
+	PurgeResponse
+	PurgedTraining
+	UsageRequest
+	UsageResponse
+	UsageEntry
+	ResourceUsage
+	RenderResponse
+	RenderedObject
 	GetRequest
 	GetResponse
 	GetStatusResponse
@@ -33,6 +44,15 @@ It has these top-level messages:
 	HaltResponse
 	ResumeRequest
 	ResumeResponse
+	LabelsRequest
+	LabelsResponse
+	BulkRequest
+	BulkResponse
+	BulkResult
+	StatusHistoryEntry
+	StatusHistoryResponse
+	WatchRequest
+	StatusEvent
 	DeleteRequest
 	DeleteResponse
 	Metrics
@@ -41,9 +61,23 @@ It has these top-level messages:
 	Framework
 	ImageLocation
 	Training
+	SecretKeyRef
+	RetryPolicy
 	TrainingStatus
 	Datastore
 	ResourceRequirements
+	Toleration
+	CreateExperimentRequest
+	CreateExperimentResponse
+	GetExperimentRequest
+	GetExperimentResponse
+	GetAllExperimentsRequest
+	GetAllExperimentsResponse
+	ExperimentSpec
+	HyperParameter
+	Objective
+	Experiment
+	Trial
 	ModelDefinitionRequest
 	TrainedModelRequest
 	TrainedModelLogRequest
@@ -121,6 +155,8 @@ const (
 	Status_STORING     Status = 50
 	Status_COMPLETED   Status = 60
 	Status_QUEUED      Status = 70
+	// waiting for the trainings it depends on to complete
+	Status_WAITING Status = 80
 )
 
 var Status_name = map[int32]string{
@@ -134,6 +170,7 @@ var Status_name = map[int32]string{
 	50: "STORING",
 	60: "COMPLETED",
 	70: "QUEUED",
+	80: "WAITING",
 }
 var Status_value = map[string]int32{
 	"NOT_STARTED": 0,
@@ -146,6 +183,7 @@ var Status_value = map[string]int32{
 	"STORING":     50,
 	"COMPLETED":   60,
 	"QUEUED":      70,
+	"WAITING":     80,
 }
 
 func (x Status) String() string {
@@ -207,6 +245,54 @@ func (x Query_SearchType) String() string {
 }
 func (Query_SearchType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{9, 0} }
 
+type ExperimentSpec_Strategy int32
+
+const (
+	// every combination of the parameter values
+	ExperimentSpec_GRID ExperimentSpec_Strategy = 0
+	// max_trials random picks from the parameter values or ranges
+	ExperimentSpec_RANDOM ExperimentSpec_Strategy = 1
+	// the n-th trial uses the n-th value of every parameter
+	ExperimentSpec_LIST ExperimentSpec_Strategy = 2
+)
+
+var ExperimentSpec_Strategy_name = map[int32]string{
+	0: "GRID",
+	1: "RANDOM",
+	2: "LIST",
+}
+var ExperimentSpec_Strategy_value = map[string]int32{
+	"GRID":   0,
+	"RANDOM": 1,
+	"LIST":   2,
+}
+
+func (x ExperimentSpec_Strategy) String() string {
+	return proto.EnumName(ExperimentSpec_Strategy_name, int32(x))
+}
+func (ExperimentSpec_Strategy) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{65, 0} }
+
+type Experiment_State int32
+
+const (
+	Experiment_RUNNING   Experiment_State = 0
+	Experiment_COMPLETED Experiment_State = 1
+)
+
+var Experiment_State_name = map[int32]string{
+	0: "RUNNING",
+	1: "COMPLETED",
+}
+var Experiment_State_value = map[string]int32{
+	"RUNNING":   0,
+	"COMPLETED": 1,
+}
+
+func (x Experiment_State) String() string {
+	return proto.EnumName(Experiment_State_name, int32(x))
+}
+func (Experiment_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{68, 0} }
+
 type CreateRequest struct {
 	UserId          string           `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
 	ModelDefinition *ModelDefinition `protobuf:"bytes,2,opt,name=model_definition,json=modelDefinition" json:"model_definition,omitempty" bson:"model_definition,omitempty"`
@@ -214,6 +300,13 @@ type CreateRequest struct {
 	Datastores      []*Datastore     `protobuf:"bytes,4,rep,name=datastores" json:"datastores,omitempty" bson:"datastores,omitempty"`
 	// EMExtractionSpec allows the caller to specify evaluation metrics extraction.
 	EvaluationMetrics *EMExtractionSpec `protobuf:"bytes,5,opt,name=evaluation_metrics,json=evaluationMetrics" json:"evaluation_metrics,omitempty" bson:"evaluation_metrics,omitempty"`
+	// Optional: priority of the job while it waits in the queue. Jobs with a higher priority are started first.
+	Priority int32 `protobuf:"varint,6,opt,name=priority" json:"priority,omitempty" bson:"priority,omitempty"`
+	// Optional: user-defined labels, such as team=vision. They are also set on the learner pods.
+	Labels map[string]string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty" bson:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: a key chosen by the client to make retries safe. Requests of the same user with the same key return
+	// the training created by the first one instead of creating another, as long as the key has not expired.
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey" json:"idempotency_key,omitempty" bson:"idempotency_key,omitempty"`
 }
 
 func (m *CreateRequest) Reset()                    { *m = CreateRequest{} }
@@ -256,6 +349,27 @@ func (m *CreateRequest) GetEvaluationMetrics() *EMExtractionSpec {
 	return nil
 }
 
+func (m *CreateRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CreateRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // EMExtractionSpec represents the specification for extracting structured evaluation metrics from training jobs.
 // It is used across all log collectors, so some fields may not be relevent for all log collectors.
 // Note: Don't use enums with this, as need to do untyped YAML convert to string and back
@@ -733,6 +847,304 @@ func (m *UpdateResponse) GetTrainingId() string {
 	return ""
 }
 
+type PriorityRequest struct {
+	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	Priority   int32  `protobuf:"varint,2,opt,name=priority" json:"priority,omitempty" bson:"priority,omitempty"`
+}
+
+func (m *PriorityRequest) Reset()                    { *m = PriorityRequest{} }
+func (m *PriorityRequest) String() string            { return proto.CompactTextString(m) }
+func (*PriorityRequest) ProtoMessage()               {}
+func (*PriorityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *PriorityRequest) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *PriorityRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type PriorityResponse struct {
+	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	Priority   int32  `protobuf:"varint,2,opt,name=priority" json:"priority,omitempty" bson:"priority,omitempty"`
+}
+
+func (m *PriorityResponse) Reset()                    { *m = PriorityResponse{} }
+func (m *PriorityResponse) String() string            { return proto.CompactTextString(m) }
+func (*PriorityResponse) ProtoMessage()               {}
+func (*PriorityResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *PriorityResponse) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *PriorityResponse) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+type PurgeRequest struct {
+	// only report the training jobs that would be purged
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty" bson:"dry_run,omitempty"`
+}
+
+func (m *PurgeRequest) Reset()                    { *m = PurgeRequest{} }
+func (m *PurgeRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeRequest) ProtoMessage()               {}
+func (*PurgeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *PurgeRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type PurgeResponse struct {
+	Trainings []*PurgedTraining `protobuf:"bytes,1,rep,name=trainings" json:"trainings,omitempty" bson:"trainings,omitempty"`
+}
+
+func (m *PurgeResponse) Reset()                    { *m = PurgeResponse{} }
+func (m *PurgeResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeResponse) ProtoMessage()               {}
+func (*PurgeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *PurgeResponse) GetTrainings() []*PurgedTraining {
+	if m != nil {
+		return m.Trainings
+	}
+	return nil
+}
+
+type PurgedTraining struct {
+	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	// the retention rule that selected the training job, "deleted" or "finished"
+	Reason string `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty" bson:"reason,omitempty"`
+}
+
+func (m *PurgedTraining) Reset()                    { *m = PurgedTraining{} }
+func (m *PurgedTraining) String() string            { return proto.CompactTextString(m) }
+func (*PurgedTraining) ProtoMessage()               {}
+func (*PurgedTraining) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *PurgedTraining) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *PurgedTraining) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *PurgedTraining) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UsageRequest struct {
+	// Optional: only the training jobs of this user. The training jobs of all users are included if it is empty.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	// Optional: only the training jobs with matching labels, such as team=vision,env!=test
+	LabelSelector string `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector" json:"label_selector,omitempty" bson:"label_selector,omitempty"`
+	// Optional: only the training jobs that finished in this time window, in milliseconds since the epoch
+	CompletedAfter  int64 `protobuf:"varint,3,opt,name=completed_after,json=completedAfter" json:"completed_after,omitempty" bson:"completed_after,omitempty"`
+	CompletedBefore int64 `protobuf:"varint,4,opt,name=completed_before,json=completedBefore" json:"completed_before,omitempty" bson:"completed_before,omitempty"`
+	// Optional: aggregate by the value of this label instead of by user
+	GroupByLabel string `protobuf:"bytes,5,opt,name=group_by_label,json=groupByLabel" json:"group_by_label,omitempty" bson:"group_by_label,omitempty"`
+}
+
+func (m *UsageRequest) Reset()                    { *m = UsageRequest{} }
+func (m *UsageRequest) String() string            { return proto.CompactTextString(m) }
+func (*UsageRequest) ProtoMessage()               {}
+func (*UsageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *UsageRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UsageRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *UsageRequest) GetCompletedAfter() int64 {
+	if m != nil {
+		return m.CompletedAfter
+	}
+	return 0
+}
+
+func (m *UsageRequest) GetCompletedBefore() int64 {
+	if m != nil {
+		return m.CompletedBefore
+	}
+	return 0
+}
+
+func (m *UsageRequest) GetGroupByLabel() string {
+	if m != nil {
+		return m.GroupByLabel
+	}
+	return ""
+}
+
+type UsageResponse struct {
+	Entries []*UsageEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty" bson:"entries,omitempty"`
+}
+
+func (m *UsageResponse) Reset()                    { *m = UsageResponse{} }
+func (m *UsageResponse) String() string            { return proto.CompactTextString(m) }
+func (*UsageResponse) ProtoMessage()               {}
+func (*UsageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *UsageResponse) GetEntries() []*UsageEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type UsageEntry struct {
+	// the user, or the value of the group_by_label label, which is empty for the training jobs without it
+	Key       string         `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" bson:"key,omitempty"`
+	Trainings int32          `protobuf:"varint,2,opt,name=trainings" json:"trainings,omitempty" bson:"trainings,omitempty"`
+	Usage     *ResourceUsage `protobuf:"bytes,3,opt,name=usage" json:"usage,omitempty" bson:"usage,omitempty"`
+}
+
+func (m *UsageEntry) Reset()                    { *m = UsageEntry{} }
+func (m *UsageEntry) String() string            { return proto.CompactTextString(m) }
+func (*UsageEntry) ProtoMessage()               {}
+func (*UsageEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *UsageEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *UsageEntry) GetTrainings() int32 {
+	if m != nil {
+		return m.Trainings
+	}
+	return 0
+}
+
+func (m *UsageEntry) GetUsage() *ResourceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// ResourceUsage is what the learners of a training job requested, multiplied by the time they ran
+type ResourceUsage struct {
+	GpuHours float64 `protobuf:"fixed64,1,opt,name=gpu_hours,json=gpuHours" json:"gpu_hours,omitempty" bson:"gpu_hours,omitempty"`
+	CpuHours float64 `protobuf:"fixed64,2,opt,name=cpu_hours,json=cpuHours" json:"cpu_hours,omitempty" bson:"cpu_hours,omitempty"`
+	// GiB-hours
+	MemoryHours float64 `protobuf:"fixed64,3,opt,name=memory_hours,json=memoryHours" json:"memory_hours,omitempty" bson:"memory_hours,omitempty"`
+}
+
+func (m *ResourceUsage) Reset()                    { *m = ResourceUsage{} }
+func (m *ResourceUsage) String() string            { return proto.CompactTextString(m) }
+func (*ResourceUsage) ProtoMessage()               {}
+func (*ResourceUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *ResourceUsage) GetGpuHours() float64 {
+	if m != nil {
+		return m.GpuHours
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetCpuHours() float64 {
+	if m != nil {
+		return m.CpuHours
+	}
+	return 0
+}
+
+func (m *ResourceUsage) GetMemoryHours() float64 {
+	if m != nil {
+		return m.MemoryHours
+	}
+	return 0
+}
+
+type RenderResponse struct {
+	Objects []*RenderedObject `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty" bson:"objects,omitempty"`
+}
+
+func (m *RenderResponse) Reset()                    { *m = RenderResponse{} }
+func (m *RenderResponse) String() string            { return proto.CompactTextString(m) }
+func (*RenderResponse) ProtoMessage()               {}
+func (*RenderResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *RenderResponse) GetObjects() []*RenderedObject {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type RenderedObject struct {
+	Kind string `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty" bson:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty" bson:"name,omitempty"`
+	// YAML
+	Manifest string `protobuf:"bytes,3,opt,name=manifest" json:"manifest,omitempty" bson:"manifest,omitempty"`
+}
+
+func (m *RenderedObject) Reset()                    { *m = RenderedObject{} }
+func (m *RenderedObject) String() string            { return proto.CompactTextString(m) }
+func (*RenderedObject) ProtoMessage()               {}
+func (*RenderedObject) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *RenderedObject) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RenderedObject) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RenderedObject) GetManifest() string {
+	if m != nil {
+		return m.Manifest
+	}
+	return ""
+}
+
 type GetRequest struct {
 	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
 	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
@@ -741,7 +1153,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()                    { *m = GetRequest{} }
 func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *GetRequest) GetTrainingId() string {
 	if m != nil {
@@ -764,7 +1176,7 @@ type GetResponse struct {
 func (m *GetResponse) Reset()                    { *m = GetResponse{} }
 func (m *GetResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()               {}
-func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*GetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *GetResponse) GetJob() *Job {
 	if m != nil {
@@ -780,7 +1192,7 @@ type GetStatusResponse struct {
 func (m *GetStatusResponse) Reset()                    { *m = GetStatusResponse{} }
 func (m *GetStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetStatusResponse) ProtoMessage()               {}
-func (*GetStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*GetStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *GetStatusResponse) GetStatus() *TrainingStatus {
 	if m != nil {
@@ -796,7 +1208,7 @@ type GetStatusIDResponse struct {
 func (m *GetStatusIDResponse) Reset()                    { *m = GetStatusIDResponse{} }
 func (m *GetStatusIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetStatusIDResponse) ProtoMessage()               {}
-func (*GetStatusIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*GetStatusIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *GetStatusIDResponse) GetStatus() Status {
 	if m != nil {
@@ -812,7 +1224,7 @@ type GetMetricsStringResponse struct {
 func (m *GetMetricsStringResponse) Reset()                    { *m = GetMetricsStringResponse{} }
 func (m *GetMetricsStringResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetMetricsStringResponse) ProtoMessage()               {}
-func (*GetMetricsStringResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*GetMetricsStringResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *GetMetricsStringResponse) GetMetrics() string {
 	if m != nil {
@@ -828,7 +1240,7 @@ type GetTestResponse struct {
 func (m *GetTestResponse) Reset()                    { *m = GetTestResponse{} }
 func (m *GetTestResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTestResponse) ProtoMessage()               {}
-func (*GetTestResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*GetTestResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *GetTestResponse) GetTest() string {
 	if m != nil {
@@ -839,12 +1251,30 @@ func (m *GetTestResponse) GetTest() string {
 
 type GetAllRequest struct {
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	// Optional: only list trainings with one of these statuses
+	Status []Status `protobuf:"varint,2,rep,packed,name=status,enum=grpc.trainer.v2.Status" json:"status,omitempty" bson:"status,omitempty"`
+	// Optional: only list trainings using this framework, such as tensorflow
+	Framework string `protobuf:"bytes,3,opt,name=framework" json:"framework,omitempty" bson:"framework,omitempty"`
+	// Optional: only list trainings whose model definition name starts with this prefix
+	NamePrefix string `protobuf:"bytes,4,opt,name=name_prefix,json=namePrefix" json:"name_prefix,omitempty" bson:"name_prefix,omitempty"`
+	// Optional: only list trainings submitted at or after this time, in milliseconds since the epoch
+	SubmittedAfter int64 `protobuf:"varint,5,opt,name=submitted_after,json=submittedAfter" json:"submitted_after,omitempty" bson:"submitted_after,omitempty"`
+	// Optional: only list trainings submitted before this time, in milliseconds since the epoch
+	SubmittedBefore int64 `protobuf:"varint,6,opt,name=submitted_before,json=submittedBefore" json:"submitted_before,omitempty" bson:"submitted_before,omitempty"`
+	// Optional: sort by "submitted" or "name", prefixed with "-" for descending order. The default is "-submitted".
+	Sort string `protobuf:"bytes,7,opt,name=sort" json:"sort,omitempty" bson:"sort,omitempty"`
+	// Optional: maximum number of trainings to return, 0 returns all of them
+	PageSize int32 `protobuf:"varint,8,opt,name=page_size,json=pageSize" json:"page_size,omitempty" bson:"page_size,omitempty"`
+	// Optional: the next_page_token of the previous response, to continue the listing where it ended
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken" json:"page_token,omitempty" bson:"page_token,omitempty"`
+	// Optional: only list trainings whose labels match this selector, such as "team=vision,dataset!=v2,ticket"
+	LabelSelector string `protobuf:"bytes,10,opt,name=label_selector,json=labelSelector" json:"label_selector,omitempty" bson:"label_selector,omitempty"`
 }
 
 func (m *GetAllRequest) Reset()                    { *m = GetAllRequest{} }
 func (m *GetAllRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAllRequest) ProtoMessage()               {}
-func (*GetAllRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*GetAllRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *GetAllRequest) GetUserId() string {
 	if m != nil {
@@ -853,14 +1283,79 @@ func (m *GetAllRequest) GetUserId() string {
 	return ""
 }
 
+func (m *GetAllRequest) GetStatus() []Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetAllRequest) GetFramework() string {
+	if m != nil {
+		return m.Framework
+	}
+	return ""
+}
+
+func (m *GetAllRequest) GetNamePrefix() string {
+	if m != nil {
+		return m.NamePrefix
+	}
+	return ""
+}
+
+func (m *GetAllRequest) GetSubmittedAfter() int64 {
+	if m != nil {
+		return m.SubmittedAfter
+	}
+	return 0
+}
+
+func (m *GetAllRequest) GetSubmittedBefore() int64 {
+	if m != nil {
+		return m.SubmittedBefore
+	}
+	return 0
+}
+
+func (m *GetAllRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
+
+func (m *GetAllRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetAllRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *GetAllRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
 type GetAllResponse struct {
 	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs" json:"jobs,omitempty" bson:"jobs,omitempty"`
+	// Token to request the next page with, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty" bson:"next_page_token,omitempty"`
 }
 
 func (m *GetAllResponse) Reset()                    { *m = GetAllResponse{} }
 func (m *GetAllResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAllResponse) ProtoMessage()               {}
-func (*GetAllResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*GetAllResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *GetAllResponse) GetJobs() []*Job {
 	if m != nil {
@@ -869,6 +1364,13 @@ func (m *GetAllResponse) GetJobs() []*Job {
 	return nil
 }
 
+func (m *GetAllResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type HaltRequest struct {
 	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
 	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
@@ -877,7 +1379,7 @@ type HaltRequest struct {
 func (m *HaltRequest) Reset()                    { *m = HaltRequest{} }
 func (m *HaltRequest) String() string            { return proto.CompactTextString(m) }
 func (*HaltRequest) ProtoMessage()               {}
-func (*HaltRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*HaltRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *HaltRequest) GetTrainingId() string {
 	if m != nil {
@@ -902,7 +1404,7 @@ type HaltResponse struct {
 func (m *HaltResponse) Reset()                    { *m = HaltResponse{} }
 func (m *HaltResponse) String() string            { return proto.CompactTextString(m) }
 func (*HaltResponse) ProtoMessage()               {}
-func (*HaltResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*HaltResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *HaltResponse) GetTrainingId() string {
 	if m != nil {
@@ -928,12 +1430,16 @@ func (m *HaltResponse) GetStatus() Status {
 type ResumeRequest struct {
 	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
 	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	// The data stores of the training with their credentials, which are erased when the training is halted
+	Datastores []*Datastore `protobuf:"bytes,3,rep,name=datastores" json:"datastores,omitempty" bson:"datastores,omitempty"`
+	// The location of the custom image of the training with its credentials, if it uses one
+	ImageLocation *ImageLocation `protobuf:"bytes,4,opt,name=image_location,json=imageLocation" json:"image_location,omitempty" bson:"image_location,omitempty"`
 }
 
 func (m *ResumeRequest) Reset()                    { *m = ResumeRequest{} }
 func (m *ResumeRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResumeRequest) ProtoMessage()               {}
-func (*ResumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*ResumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *ResumeRequest) GetTrainingId() string {
 	if m != nil {
@@ -949,7 +1455,21 @@ func (m *ResumeRequest) GetUserId() string {
 	return ""
 }
 
-type ResumeResponse struct {
+func (m *ResumeRequest) GetDatastores() []*Datastore {
+	if m != nil {
+		return m.Datastores
+	}
+	return nil
+}
+
+func (m *ResumeRequest) GetImageLocation() *ImageLocation {
+	if m != nil {
+		return m.ImageLocation
+	}
+	return nil
+}
+
+type ResumeResponse struct {
 	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
 	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
 	Status     Status `protobuf:"varint,3,opt,name=status,enum=grpc.trainer.v2.Status" json:"status,omitempty" bson:"status,omitempty"`
@@ -958,7 +1478,7 @@ type ResumeResponse struct {
 func (m *ResumeResponse) Reset()                    { *m = ResumeResponse{} }
 func (m *ResumeResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResumeResponse) ProtoMessage()               {}
-func (*ResumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*ResumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *ResumeResponse) GetTrainingId() string {
 	if m != nil {
@@ -981,6 +1501,269 @@ func (m *ResumeResponse) GetStatus() Status {
 	return Status_NOT_STARTED
 }
 
+type LabelsRequest struct {
+	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	// The new labels of the training, replacing all of its current labels
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" bson:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *LabelsRequest) Reset()                    { *m = LabelsRequest{} }
+func (m *LabelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*LabelsRequest) ProtoMessage()               {}
+func (*LabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *LabelsRequest) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *LabelsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *LabelsRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type LabelsResponse struct {
+	TrainingId string            `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	Labels     map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" bson:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *LabelsResponse) Reset()                    { *m = LabelsResponse{} }
+func (m *LabelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*LabelsResponse) ProtoMessage()               {}
+func (*LabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *LabelsResponse) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *LabelsResponse) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type BulkRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	// Selects the training jobs like the label selector of GetAllRequest, must not be empty
+	LabelSelector string `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector" json:"label_selector,omitempty" bson:"label_selector,omitempty"`
+}
+
+func (m *BulkRequest) Reset()                    { *m = BulkRequest{} }
+func (m *BulkRequest) String() string            { return proto.CompactTextString(m) }
+func (*BulkRequest) ProtoMessage()               {}
+func (*BulkRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *BulkRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *BulkRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+type BulkResponse struct {
+	// The training jobs the operation was applied to
+	Results []*BulkResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty" bson:"results,omitempty"`
+}
+
+func (m *BulkResponse) Reset()                    { *m = BulkResponse{} }
+func (m *BulkResponse) String() string            { return proto.CompactTextString(m) }
+func (*BulkResponse) ProtoMessage()               {}
+func (*BulkResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *BulkResponse) GetResults() []*BulkResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type BulkResult struct {
+	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	// Why the operation failed for the training job, empty if it succeeded
+	Error string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty" bson:"error,omitempty"`
+}
+
+func (m *BulkResult) Reset()                    { *m = BulkResult{} }
+func (m *BulkResult) String() string            { return proto.CompactTextString(m) }
+func (*BulkResult) ProtoMessage()               {}
+func (*BulkResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *BulkResult) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *BulkResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type StatusHistoryEntry struct {
+	Status Status `protobuf:"varint,1,opt,name=status,enum=grpc.trainer.v2.Status" json:"status,omitempty" bson:"status,omitempty"`
+	// time of the transition in milliseconds since the epoch
+	Timestamp string `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty" bson:"timestamp,omitempty"`
+	// milliseconds until the next transition, or until now for the current status of an unfinished training
+	Duration      int64  `protobuf:"varint,3,opt,name=duration" json:"duration,omitempty" bson:"duration,omitempty"`
+	StatusMessage string `protobuf:"bytes,4,opt,name=status_message,json=statusMessage" json:"status_message,omitempty" bson:"status_message,omitempty"`
+	ErrorCode     string `protobuf:"bytes,5,opt,name=error_code,json=errorCode" json:"error_code,omitempty" bson:"error_code,omitempty"`
+}
+
+func (m *StatusHistoryEntry) Reset()                    { *m = StatusHistoryEntry{} }
+func (m *StatusHistoryEntry) String() string            { return proto.CompactTextString(m) }
+func (*StatusHistoryEntry) ProtoMessage()               {}
+func (*StatusHistoryEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *StatusHistoryEntry) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_NOT_STARTED
+}
+
+func (m *StatusHistoryEntry) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *StatusHistoryEntry) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *StatusHistoryEntry) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *StatusHistoryEntry) GetErrorCode() string {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ""
+}
+
+type StatusHistoryResponse struct {
+	TrainingId string                `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	Entries    []*StatusHistoryEntry `protobuf:"bytes,2,rep,name=entries" json:"entries,omitempty" bson:"entries,omitempty"`
+}
+
+func (m *StatusHistoryResponse) Reset()                    { *m = StatusHistoryResponse{} }
+func (m *StatusHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*StatusHistoryResponse) ProtoMessage()               {}
+func (*StatusHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *StatusHistoryResponse) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *StatusHistoryResponse) GetEntries() []*StatusHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type WatchRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+}
+
+func (m *WatchRequest) Reset()                    { *m = WatchRequest{} }
+func (m *WatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()               {}
+func (*WatchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *WatchRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type StatusEvent struct {
+	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	Status     Status `protobuf:"varint,2,opt,name=status,enum=grpc.trainer.v2.Status" json:"status,omitempty" bson:"status,omitempty"`
+	// time of the transition in milliseconds since the epoch
+	Timestamp     string `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp,omitempty" bson:"timestamp,omitempty"`
+	StatusMessage string `protobuf:"bytes,4,opt,name=status_message,json=statusMessage" json:"status_message,omitempty" bson:"status_message,omitempty"`
+	ErrorCode     string `protobuf:"bytes,5,opt,name=error_code,json=errorCode" json:"error_code,omitempty" bson:"error_code,omitempty"`
+}
+
+func (m *StatusEvent) Reset()                    { *m = StatusEvent{} }
+func (m *StatusEvent) String() string            { return proto.CompactTextString(m) }
+func (*StatusEvent) ProtoMessage()               {}
+func (*StatusEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *StatusEvent) GetTrainingId() string {
+	if m != nil {
+		return m.TrainingId
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_NOT_STARTED
+}
+
+func (m *StatusEvent) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *StatusEvent) GetErrorCode() string {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ""
+}
+
 type DeleteRequest struct {
 	TrainingId string `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
 	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
@@ -989,7 +1772,7 @@ type DeleteRequest struct {
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()               {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *DeleteRequest) GetTrainingId() string {
 	if m != nil {
@@ -1012,7 +1795,7 @@ type DeleteResponse struct {
 func (m *DeleteResponse) Reset()                    { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()               {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*DeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *DeleteResponse) GetTrainingId() string {
 	if m != nil {
@@ -1031,7 +1814,7 @@ type Metrics struct {
 func (m *Metrics) Reset()                    { *m = Metrics{} }
 func (m *Metrics) String() string            { return proto.CompactTextString(m) }
 func (*Metrics) ProtoMessage()               {}
-func (*Metrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*Metrics) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *Metrics) GetTimestamp() string {
 	if m != nil {
@@ -1062,20 +1845,22 @@ func (m *Metrics) GetValues() map[string]string {
 }
 
 type Job struct {
-	TrainingId      string           `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
-	UserId          string           `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
-	ModelDefinition *ModelDefinition `protobuf:"bytes,3,opt,name=model_definition,json=modelDefinition" json:"model_definition,omitempty" bson:"model_definition,omitempty"`
-	Training        *Training        `protobuf:"bytes,4,opt,name=training" json:"training,omitempty" bson:"training,omitempty"`
-	Status          *TrainingStatus  `protobuf:"bytes,5,opt,name=status" json:"status,omitempty" bson:"status,omitempty"`
-	Datastores      []*Datastore     `protobuf:"bytes,6,rep,name=datastores" json:"datastores,omitempty" bson:"datastores,omitempty"`
-	JobId           string           `protobuf:"bytes,7,opt,name=job_id,json=jobId" json:"job_id,omitempty" bson:"job_id,omitempty"`
-	Metrics         *Metrics         `protobuf:"bytes,8,opt,name=metrics" json:"metrics,omitempty" bson:"metrics,omitempty"`
+	TrainingId      string            `protobuf:"bytes,1,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	UserId          string            `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	ModelDefinition *ModelDefinition  `protobuf:"bytes,3,opt,name=model_definition,json=modelDefinition" json:"model_definition,omitempty" bson:"model_definition,omitempty"`
+	Training        *Training         `protobuf:"bytes,4,opt,name=training" json:"training,omitempty" bson:"training,omitempty"`
+	Status          *TrainingStatus   `protobuf:"bytes,5,opt,name=status" json:"status,omitempty" bson:"status,omitempty"`
+	Datastores      []*Datastore      `protobuf:"bytes,6,rep,name=datastores" json:"datastores,omitempty" bson:"datastores,omitempty"`
+	JobId           string            `protobuf:"bytes,7,opt,name=job_id,json=jobId" json:"job_id,omitempty" bson:"job_id,omitempty"`
+	Metrics         *Metrics          `protobuf:"bytes,8,opt,name=metrics" json:"metrics,omitempty" bson:"metrics,omitempty"`
+	Priority        int32             `protobuf:"varint,9,opt,name=priority" json:"priority,omitempty" bson:"priority,omitempty"`
+	Labels          map[string]string `protobuf:"bytes,10,rep,name=labels" json:"labels,omitempty" bson:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *Job) Reset()                    { *m = Job{} }
 func (m *Job) String() string            { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()               {}
-func (*Job) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *Job) GetTrainingId() string {
 	if m != nil {
@@ -1133,18 +1918,36 @@ func (m *Job) GetMetrics() *Metrics {
 	return nil
 }
 
+func (m *Job) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Job) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type ModelDefinition struct {
-	Name        string     `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" bson:"name,omitempty"`
-	Description string     `protobuf:"bytes,2,opt,name=description" json:"description,omitempty" bson:"description,omitempty"`
-	Content     []byte     `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty" bson:"content,omitempty"`
-	Location    string     `protobuf:"bytes,4,opt,name=location" json:"location,omitempty" bson:"location,omitempty"`
-	Framework   *Framework `protobuf:"bytes,5,opt,name=framework" json:"framework,omitempty" bson:"framework,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" bson:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty" bson:"description,omitempty"`
+	Content     []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty" bson:"content,omitempty"`
+	// Optional: location where the model definition (code) is fetched from when the training is created, either a git
+	// repository as git+<url>#<branch, tag or commit>, or an object as datastore://<datastore id>/<container>/<object>
+	Location       string     `protobuf:"bytes,4,opt,name=location" json:"location,omitempty" bson:"location,omitempty"`
+	Framework      *Framework `protobuf:"bytes,5,opt,name=framework" json:"framework,omitempty" bson:"framework,omitempty"`
+	Source         string     `protobuf:"bytes,6,opt,name=source" json:"source,omitempty" bson:"source,omitempty"`
+	SourceRevision string     `protobuf:"bytes,7,opt,name=source_revision,json=sourceRevision" json:"source_revision,omitempty" bson:"source_revision,omitempty"`
 }
 
 func (m *ModelDefinition) Reset()                    { *m = ModelDefinition{} }
 func (m *ModelDefinition) String() string            { return proto.CompactTextString(m) }
 func (*ModelDefinition) ProtoMessage()               {}
-func (*ModelDefinition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*ModelDefinition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *ModelDefinition) GetName() string {
 	if m != nil {
@@ -1181,6 +1984,20 @@ func (m *ModelDefinition) GetFramework() *Framework {
 	return nil
 }
 
+func (m *ModelDefinition) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ModelDefinition) GetSourceRevision() string {
+	if m != nil {
+		return m.SourceRevision
+	}
+	return ""
+}
+
 type Framework struct {
 	Name          string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" bson:"name,omitempty"`
 	Version       string         `protobuf:"bytes,2,opt,name=version" json:"version,omitempty" bson:"version,omitempty"`
@@ -1191,7 +2008,7 @@ type Framework struct {
 func (m *Framework) Reset()                    { *m = Framework{} }
 func (m *Framework) String() string            { return proto.CompactTextString(m) }
 func (*Framework) ProtoMessage()               {}
-func (*Framework) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*Framework) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *Framework) GetName() string {
 	if m != nil {
@@ -1231,7 +2048,7 @@ type ImageLocation struct {
 func (m *ImageLocation) Reset()                    { *m = ImageLocation{} }
 func (m *ImageLocation) String() string            { return proto.CompactTextString(m) }
 func (*ImageLocation) ProtoMessage()               {}
-func (*ImageLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*ImageLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *ImageLocation) GetRegistry() string {
 	if m != nil {
@@ -1271,12 +2088,24 @@ type Training struct {
 	OutputData []string `protobuf:"bytes,4,rep,name=output_data,json=outputData" json:"output_data,omitempty" bson:"output_data,omitempty"`
 	// whether we want to enable detailed profiling during the training
 	Profiling bool `protobuf:"varint,5,opt,name=profiling" json:"profiling,omitempty" bson:"profiling,omitempty"`
+	// Optional: ids of trainings of the same user that have to complete before this training is started.
+	// The input data store of the training is replaced with the results of the first one.
+	DependsOn []string `protobuf:"bytes,6,rep,name=depends_on,json=dependsOn" json:"depends_on,omitempty" bson:"depends_on,omitempty"`
+	// Optional: how the training is retried when it fails, overrides the server defaults
+	RetryPolicy *RetryPolicy `protobuf:"bytes,7,opt,name=retry_policy,json=retryPolicy" json:"retry_policy,omitempty" bson:"retry_policy,omitempty"`
+	// Optional: maximum wall-clock time the training may run once it left the queue, such as "12h".
+	// The training is halted with error code C202 when the limit is reached.
+	MaxDuration string `protobuf:"bytes,8,opt,name=max_duration,json=maxDuration" json:"max_duration,omitempty" bson:"max_duration,omitempty"`
+	// Optional: environment variables of the learner, in addition to the ones set by FfDL
+	Env map[string]string `protobuf:"bytes,9,rep,name=env" json:"env,omitempty" bson:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: environment variables of the learner whose values are keys of secrets of the user
+	SecretEnv map[string]*SecretKeyRef `protobuf:"bytes,10,rep,name=secret_env,json=secretEnv" json:"secret_env,omitempty" bson:"secret_env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *Training) Reset()                    { *m = Training{} }
 func (m *Training) String() string            { return proto.CompactTextString(m) }
 func (*Training) ProtoMessage()               {}
-func (*Training) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*Training) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *Training) GetCommand() string {
 	if m != nil {
@@ -1313,223 +2142,795 @@ func (m *Training) GetProfiling() bool {
 	return false
 }
 
-type TrainingStatus struct {
-	Status                 Status `protobuf:"varint,1,opt,name=status,enum=grpc.trainer.v2.Status" json:"status,omitempty" bson:"status,omitempty"`
-	SubmissionTimestamp    string `protobuf:"bytes,3,opt,name=submission_timestamp,json=submissionTimestamp" json:"submission_timestamp,omitempty" bson:"submission_timestamp,omitempty"`
-	CompletionTimestamp    string `protobuf:"bytes,4,opt,name=completion_timestamp,json=completionTimestamp" json:"completion_timestamp,omitempty" bson:"completion_timestamp,omitempty"`
-	DownloadStartTimestamp string `protobuf:"bytes,5,opt,name=download_start_timestamp,json=downloadStartTimestamp" json:"download_start_timestamp,omitempty" bson:"download_start_timestamp,omitempty"`
-	ProcessStartTimestamp  string `protobuf:"bytes,6,opt,name=process_start_timestamp,json=processStartTimestamp" json:"process_start_timestamp,omitempty" bson:"process_start_timestamp,omitempty"`
-	StoreStartTimestamp    string `protobuf:"bytes,7,opt,name=store_start_timestamp,json=storeStartTimestamp" json:"store_start_timestamp,omitempty" bson:"store_start_timestamp,omitempty"`
-	StatusMessage          string `protobuf:"bytes,8,opt,name=status_message,json=statusMessage" json:"status_message,omitempty" bson:"status_message,omitempty"`
-	ErrorCode              string `protobuf:"bytes,9,opt,name=error_code,json=errorCode" json:"error_code,omitempty" bson:"error_code,omitempty"`
+func (m *Training) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
 }
 
-func (m *TrainingStatus) Reset()                    { *m = TrainingStatus{} }
-func (m *TrainingStatus) String() string            { return proto.CompactTextString(m) }
-func (*TrainingStatus) ProtoMessage()               {}
-func (*TrainingStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
-
-func (m *TrainingStatus) GetStatus() Status {
+func (m *Training) GetRetryPolicy() *RetryPolicy {
 	if m != nil {
-		return m.Status
+		return m.RetryPolicy
 	}
-	return Status_NOT_STARTED
+	return nil
 }
 
-func (m *TrainingStatus) GetSubmissionTimestamp() string {
+func (m *Training) GetMaxDuration() string {
 	if m != nil {
-		return m.SubmissionTimestamp
+		return m.MaxDuration
 	}
 	return ""
 }
 
-func (m *TrainingStatus) GetCompletionTimestamp() string {
+func (m *Training) GetEnv() map[string]string {
 	if m != nil {
-		return m.CompletionTimestamp
+		return m.Env
 	}
-	return ""
+	return nil
 }
 
-func (m *TrainingStatus) GetDownloadStartTimestamp() string {
+func (m *Training) GetSecretEnv() map[string]*SecretKeyRef {
 	if m != nil {
-		return m.DownloadStartTimestamp
+		return m.SecretEnv
+	}
+	return nil
+}
+
+// SecretKeyRef refers to a key of a Kubernetes secret in the learner namespace, which must carry the label
+// user_id=<id of the user>
+type SecretKeyRef struct {
+	Secret string `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty" bson:"secret,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty" bson:"key,omitempty"`
+}
+
+func (m *SecretKeyRef) Reset()                    { *m = SecretKeyRef{} }
+func (m *SecretKeyRef) String() string            { return proto.CompactTextString(m) }
+func (*SecretKeyRef) ProtoMessage()               {}
+func (*SecretKeyRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *SecretKeyRef) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
 	return ""
 }
 
-func (m *TrainingStatus) GetProcessStartTimestamp() string {
+func (m *SecretKeyRef) GetKey() string {
 	if m != nil {
-		return m.ProcessStartTimestamp
+		return m.Key
 	}
 	return ""
 }
 
-func (m *TrainingStatus) GetStoreStartTimestamp() string {
+type RetryPolicy struct {
+	// total number of attempts, including the first one
+	MaxAttempts int32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts" json:"max_attempts,omitempty" bson:"max_attempts,omitempty"`
+	// error codes of failures that are retried, such as S100
+	ErrorCodes []string `protobuf:"bytes,2,rep,name=error_codes,json=errorCodes" json:"error_codes,omitempty" bson:"error_codes,omitempty"`
+	// seconds to wait before the first retry, doubled for every further retry
+	BackoffSeconds int32 `protobuf:"varint,3,opt,name=backoff_seconds,json=backoffSeconds" json:"backoff_seconds,omitempty" bson:"backoff_seconds,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()                    { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()               {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *RetryPolicy) GetMaxAttempts() int32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetErrorCodes() []string {
+	if m != nil {
+		return m.ErrorCodes
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetBackoffSeconds() int32 {
+	if m != nil {
+		return m.BackoffSeconds
+	}
+	return 0
+}
+
+type TrainingStatus struct {
+	Status                 Status `protobuf:"varint,1,opt,name=status,enum=grpc.trainer.v2.Status" json:"status,omitempty" bson:"status,omitempty"`
+	SubmissionTimestamp    string `protobuf:"bytes,3,opt,name=submission_timestamp,json=submissionTimestamp" json:"submission_timestamp,omitempty" bson:"submission_timestamp,omitempty"`
+	CompletionTimestamp    string `protobuf:"bytes,4,opt,name=completion_timestamp,json=completionTimestamp" json:"completion_timestamp,omitempty" bson:"completion_timestamp,omitempty"`
+	DownloadStartTimestamp string `protobuf:"bytes,5,opt,name=download_start_timestamp,json=downloadStartTimestamp" json:"download_start_timestamp,omitempty" bson:"download_start_timestamp,omitempty"`
+	ProcessStartTimestamp  string `protobuf:"bytes,6,opt,name=process_start_timestamp,json=processStartTimestamp" json:"process_start_timestamp,omitempty" bson:"process_start_timestamp,omitempty"`
+	StoreStartTimestamp    string `protobuf:"bytes,7,opt,name=store_start_timestamp,json=storeStartTimestamp" json:"store_start_timestamp,omitempty" bson:"store_start_timestamp,omitempty"`
+	StatusMessage          string `protobuf:"bytes,8,opt,name=status_message,json=statusMessage" json:"status_message,omitempty" bson:"status_message,omitempty"`
+	ErrorCode              string `protobuf:"bytes,9,opt,name=error_code,json=errorCode" json:"error_code,omitempty" bson:"error_code,omitempty"`
+}
+
+func (m *TrainingStatus) Reset()                    { *m = TrainingStatus{} }
+func (m *TrainingStatus) String() string            { return proto.CompactTextString(m) }
+func (*TrainingStatus) ProtoMessage()               {}
+func (*TrainingStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *TrainingStatus) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_NOT_STARTED
+}
+
+func (m *TrainingStatus) GetSubmissionTimestamp() string {
+	if m != nil {
+		return m.SubmissionTimestamp
+	}
+	return ""
+}
+
+func (m *TrainingStatus) GetCompletionTimestamp() string {
+	if m != nil {
+		return m.CompletionTimestamp
+	}
+	return ""
+}
+
+func (m *TrainingStatus) GetDownloadStartTimestamp() string {
+	if m != nil {
+		return m.DownloadStartTimestamp
+	}
+	return ""
+}
+
+func (m *TrainingStatus) GetProcessStartTimestamp() string {
+	if m != nil {
+		return m.ProcessStartTimestamp
+	}
+	return ""
+}
+
+func (m *TrainingStatus) GetStoreStartTimestamp() string {
+	if m != nil {
+		return m.StoreStartTimestamp
+	}
+	return ""
+}
+
+func (m *TrainingStatus) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *TrainingStatus) GetErrorCode() string {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ""
+}
+
+type Datastore struct {
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty" bson:"id,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty" bson:"type,omitempty"`
+	// additional fields for the given Datastore type
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty" bson:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// connection information for the given Datastore type
+	Connection map[string]string `protobuf:"bytes,4,rep,name=connection" json:"connection,omitempty" bson:"connection,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *Datastore) Reset()                    { *m = Datastore{} }
+func (m *Datastore) String() string            { return proto.CompactTextString(m) }
+func (*Datastore) ProtoMessage()               {}
+func (*Datastore) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *Datastore) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Datastore) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Datastore) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *Datastore) GetConnection() map[string]string {
+	if m != nil {
+		return m.Connection
+	}
+	return nil
+}
+
+// Used to specify resource requirements of a training job
+type ResourceRequirements struct {
+	// Number of CPU cores
+	Cpus float32 `protobuf:"fixed32,1,opt,name=cpus" json:"cpus,omitempty" bson:"cpus,omitempty"`
+	// Number of GPUs
+	Gpus float32 `protobuf:"fixed32,2,opt,name=gpus" json:"gpus,omitempty" bson:"gpus,omitempty"`
+	// RAM
+	Memory      float32  `protobuf:"fixed32,3,opt,name=memory" json:"memory,omitempty" bson:"memory,omitempty"`
+	MemoryUnit  SizeUnit `protobuf:"varint,4,opt,name=memory_unit,json=memoryUnit,enum=grpc.trainer.v2.SizeUnit" json:"memory_unit,omitempty" bson:"memory_unit,omitempty"`
+	Storage     float32  `protobuf:"fixed32,5,opt,name=storage" json:"storage,omitempty" bson:"storage,omitempty"`
+	StorageUnit SizeUnit `protobuf:"varint,6,opt,name=storage_unit,json=storageUnit,enum=grpc.trainer.v2.SizeUnit" json:"storage_unit,omitempty" bson:"storage_unit,omitempty"`
+	// Number of learners
+	Learners     int32  `protobuf:"varint,7,opt,name=learners" json:"learners,omitempty" bson:"learners,omitempty"`
+	Schedpolicy  string `protobuf:"bytes,8,opt,name=schedpolicy" json:"schedpolicy,omitempty" bson:"schedpolicy,omitempty"`
+	Topology     string `protobuf:"bytes,9,opt,name=topology" json:"topology,omitempty" bson:"topology,omitempty"`
+	Architecture string `protobuf:"bytes,10,opt,name=architecture" json:"architecture,omitempty" bson:"architecture,omitempty"`
+	// Optional. If not specified, job will be scheduled ONLY on nvidia-TeslaK80
+	// Constraint strictly enforced. If e.g., a nvidia-TeslaP100 is requested,
+	// job will NOT start until a nvidia-TeslaP100 is available
+	// Can only be nvidia-TeslaK80, nvidia-TeslaP100 or nvidia-TeslaV100
+	GpuType string `protobuf:"bytes,11,opt,name=gpu_type,json=gpuType" json:"gpu_type,omitempty" bson:"gpu_type,omitempty"`
+	// Optional. Labels the nodes of the learners must have
+	NodeSelector map[string]string `protobuf:"bytes,12,rep,name=node_selector,json=nodeSelector" json:"node_selector,omitempty" bson:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional. Taints of nodes the learners may be scheduled on
+	Tolerations []*Toleration `protobuf:"bytes,13,rep,name=tolerations" json:"tolerations,omitempty" bson:"tolerations,omitempty"`
+}
+
+func (m *ResourceRequirements) Reset()                    { *m = ResourceRequirements{} }
+func (m *ResourceRequirements) String() string            { return proto.CompactTextString(m) }
+func (*ResourceRequirements) ProtoMessage()               {}
+func (*ResourceRequirements) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *ResourceRequirements) GetCpus() float32 {
+	if m != nil {
+		return m.Cpus
+	}
+	return 0
+}
+
+func (m *ResourceRequirements) GetGpus() float32 {
+	if m != nil {
+		return m.Gpus
+	}
+	return 0
+}
+
+func (m *ResourceRequirements) GetMemory() float32 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *ResourceRequirements) GetMemoryUnit() SizeUnit {
+	if m != nil {
+		return m.MemoryUnit
+	}
+	return SizeUnit_MB
+}
+
+func (m *ResourceRequirements) GetStorage() float32 {
+	if m != nil {
+		return m.Storage
+	}
+	return 0
+}
+
+func (m *ResourceRequirements) GetStorageUnit() SizeUnit {
+	if m != nil {
+		return m.StorageUnit
+	}
+	return SizeUnit_MB
+}
+
+func (m *ResourceRequirements) GetLearners() int32 {
+	if m != nil {
+		return m.Learners
+	}
+	return 0
+}
+
+func (m *ResourceRequirements) GetSchedpolicy() string {
+	if m != nil {
+		return m.Schedpolicy
+	}
+	return ""
+}
+
+func (m *ResourceRequirements) GetTopology() string {
+	if m != nil {
+		return m.Topology
+	}
+	return ""
+}
+
+func (m *ResourceRequirements) GetArchitecture() string {
+	if m != nil {
+		return m.Architecture
+	}
+	return ""
+}
+
+func (m *ResourceRequirements) GetGpuType() string {
+	if m != nil {
+		return m.GpuType
+	}
+	return ""
+}
+
+func (m *ResourceRequirements) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *ResourceRequirements) GetTolerations() []*Toleration {
+	if m != nil {
+		return m.Tolerations
+	}
+	return nil
+}
+
+// Toleration lets the learners run on nodes with a matching taint
+type Toleration struct {
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty" bson:"key,omitempty"`
+	// Equal, the default, or Exists
+	Operator string `protobuf:"bytes,2,opt,name=operator" json:"operator,omitempty" bson:"operator,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value" json:"value,omitempty" bson:"value,omitempty"`
+	// NoSchedule, PreferNoSchedule or NoExecute, empty for all effects
+	Effect string `protobuf:"bytes,4,opt,name=effect" json:"effect,omitempty" bson:"effect,omitempty"`
+}
+
+func (m *Toleration) Reset()                    { *m = Toleration{} }
+func (m *Toleration) String() string            { return proto.CompactTextString(m) }
+func (*Toleration) ProtoMessage()               {}
+func (*Toleration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *Toleration) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Toleration) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Toleration) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Toleration) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+type CreateExperimentRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty" bson:"name,omitempty"`
+	// The training job to run for each trial. The model definition content is required.
+	// Parameter placeholders of the form ${name} in training.command are replaced with the values of the trial.
+	Base *CreateRequest  `protobuf:"bytes,3,opt,name=base" json:"base,omitempty" bson:"base,omitempty"`
+	Spec *ExperimentSpec `protobuf:"bytes,4,opt,name=spec" json:"spec,omitempty" bson:"spec,omitempty"`
+}
+
+func (m *CreateExperimentRequest) Reset()                    { *m = CreateExperimentRequest{} }
+func (m *CreateExperimentRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateExperimentRequest) ProtoMessage()               {}
+func (*CreateExperimentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *CreateExperimentRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CreateExperimentRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateExperimentRequest) GetBase() *CreateRequest {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateExperimentRequest) GetSpec() *ExperimentSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+type CreateExperimentResponse struct {
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty" bson:"experiment_id,omitempty"`
+}
+
+func (m *CreateExperimentResponse) Reset()                    { *m = CreateExperimentResponse{} }
+func (m *CreateExperimentResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateExperimentResponse) ProtoMessage()               {}
+func (*CreateExperimentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *CreateExperimentResponse) GetExperimentId() string {
+	if m != nil {
+		return m.ExperimentId
+	}
+	return ""
+}
+
+type GetExperimentRequest struct {
+	ExperimentId string `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty" bson:"experiment_id,omitempty"`
+	UserId       string `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+}
+
+func (m *GetExperimentRequest) Reset()                    { *m = GetExperimentRequest{} }
+func (m *GetExperimentRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetExperimentRequest) ProtoMessage()               {}
+func (*GetExperimentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *GetExperimentRequest) GetExperimentId() string {
+	if m != nil {
+		return m.ExperimentId
+	}
+	return ""
+}
+
+func (m *GetExperimentRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type GetExperimentResponse struct {
+	Experiment *Experiment `protobuf:"bytes,1,opt,name=experiment" json:"experiment,omitempty" bson:"experiment,omitempty"`
+}
+
+func (m *GetExperimentResponse) Reset()                    { *m = GetExperimentResponse{} }
+func (m *GetExperimentResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetExperimentResponse) ProtoMessage()               {}
+func (*GetExperimentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *GetExperimentResponse) GetExperiment() *Experiment {
+	if m != nil {
+		return m.Experiment
+	}
+	return nil
+}
+
+type GetAllExperimentsRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+}
+
+func (m *GetAllExperimentsRequest) Reset()                    { *m = GetAllExperimentsRequest{} }
+func (m *GetAllExperimentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAllExperimentsRequest) ProtoMessage()               {}
+func (*GetAllExperimentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *GetAllExperimentsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type GetAllExperimentsResponse struct {
+	Experiments []*Experiment `protobuf:"bytes,1,rep,name=experiments" json:"experiments,omitempty" bson:"experiments,omitempty"`
+}
+
+func (m *GetAllExperimentsResponse) Reset()                    { *m = GetAllExperimentsResponse{} }
+func (m *GetAllExperimentsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetAllExperimentsResponse) ProtoMessage()               {}
+func (*GetAllExperimentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *GetAllExperimentsResponse) GetExperiments() []*Experiment {
+	if m != nil {
+		return m.Experiments
+	}
+	return nil
+}
+
+// ExperimentSpec describes the parameter space of an experiment and how its trials are run.
+type ExperimentSpec struct {
+	Strategy   ExperimentSpec_Strategy `protobuf:"varint,1,opt,name=strategy,enum=grpc.trainer.v2.ExperimentSpec_Strategy" json:"strategy,omitempty" bson:"strategy,omitempty"`
+	Parameters []*HyperParameter       `protobuf:"bytes,2,rep,name=parameters" json:"parameters,omitempty" bson:"parameters,omitempty"`
+	// Number of trials for the RANDOM strategy.
+	MaxTrials int32 `protobuf:"varint,3,opt,name=max_trials,json=maxTrials" json:"max_trials,omitempty" bson:"max_trials,omitempty"`
+	// Maximum number of trials running at the same time. 0 means no limit.
+	MaxParallel int32 `protobuf:"varint,4,opt,name=max_parallel,json=maxParallel" json:"max_parallel,omitempty" bson:"max_parallel,omitempty"`
+	// Optional: the evaluation metric used to select the best trial.
+	Objective *Objective `protobuf:"bytes,5,opt,name=objective" json:"objective,omitempty" bson:"objective,omitempty"`
+}
+
+func (m *ExperimentSpec) Reset()                    { *m = ExperimentSpec{} }
+func (m *ExperimentSpec) String() string            { return proto.CompactTextString(m) }
+func (*ExperimentSpec) ProtoMessage()               {}
+func (*ExperimentSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *ExperimentSpec) GetStrategy() ExperimentSpec_Strategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return ExperimentSpec_GRID
+}
+
+func (m *ExperimentSpec) GetParameters() []*HyperParameter {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *ExperimentSpec) GetMaxTrials() int32 {
+	if m != nil {
+		return m.MaxTrials
+	}
+	return 0
+}
+
+func (m *ExperimentSpec) GetMaxParallel() int32 {
+	if m != nil {
+		return m.MaxParallel
+	}
+	return 0
+}
+
+func (m *ExperimentSpec) GetObjective() *Objective {
+	if m != nil {
+		return m.Objective
+	}
+	return nil
+}
+
+type HyperParameter struct {
+	// The name of the parameter. Each trial gets it as ${name} in the training command and as the HP_<NAME> environment variable.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty" bson:"name,omitempty"`
+	// Values of the parameter. If empty, the RANDOM strategy samples uniformly between min and max.
+	Values []string `protobuf:"bytes,2,rep,name=values" json:"values,omitempty" bson:"values,omitempty"`
+	Min    float64  `protobuf:"fixed64,3,opt,name=min" json:"min,omitempty" bson:"min,omitempty"`
+	Max    float64  `protobuf:"fixed64,4,opt,name=max" json:"max,omitempty" bson:"max,omitempty"`
+	// whether sampled values are rounded to integers
+	Integer bool `protobuf:"varint,5,opt,name=integer" json:"integer,omitempty" bson:"integer,omitempty"`
+}
+
+func (m *HyperParameter) Reset()                    { *m = HyperParameter{} }
+func (m *HyperParameter) String() string            { return proto.CompactTextString(m) }
+func (*HyperParameter) ProtoMessage()               {}
+func (*HyperParameter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *HyperParameter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HyperParameter) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *HyperParameter) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *HyperParameter) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *HyperParameter) GetInteger() bool {
+	if m != nil {
+		return m.Integer
+	}
+	return false
+}
+
+type Objective struct {
+	// The key of the evaluation metric values, e.g. accuracy
+	Metric string `protobuf:"bytes,1,opt,name=metric" json:"metric,omitempty" bson:"metric,omitempty"`
+	// Optional: only consider evaluation metrics with this group label, e.g. test
+	Grouplabel string `protobuf:"bytes,2,opt,name=grouplabel" json:"grouplabel,omitempty" bson:"grouplabel,omitempty"`
+	// Whether lower values are better, e.g. for a loss
+	Minimize bool `protobuf:"varint,3,opt,name=minimize" json:"minimize,omitempty" bson:"minimize,omitempty"`
+}
+
+func (m *Objective) Reset()                    { *m = Objective{} }
+func (m *Objective) String() string            { return proto.CompactTextString(m) }
+func (*Objective) ProtoMessage()               {}
+func (*Objective) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *Objective) GetMetric() string {
 	if m != nil {
-		return m.StoreStartTimestamp
+		return m.Metric
 	}
 	return ""
 }
 
-func (m *TrainingStatus) GetStatusMessage() string {
+func (m *Objective) GetGrouplabel() string {
 	if m != nil {
-		return m.StatusMessage
+		return m.Grouplabel
 	}
 	return ""
 }
 
-func (m *TrainingStatus) GetErrorCode() string {
+func (m *Objective) GetMinimize() bool {
 	if m != nil {
-		return m.ErrorCode
+		return m.Minimize
 	}
-	return ""
+	return false
 }
 
-type Datastore struct {
-	Id   string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty" bson:"id,omitempty"`
-	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty" bson:"type,omitempty"`
-	// additional fields for the given Datastore type
-	Fields map[string]string `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty" bson:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// connection information for the given Datastore type
-	Connection map[string]string `protobuf:"bytes,4,rep,name=connection" json:"connection,omitempty" bson:"connection,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type Experiment struct {
+	ExperimentId string           `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId" json:"experiment_id,omitempty" bson:"experiment_id,omitempty"`
+	UserId       string           `protobuf:"bytes,2,opt,name=user_id,json=userId" json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Name         string           `protobuf:"bytes,3,opt,name=name" json:"name,omitempty" bson:"name,omitempty"`
+	Spec         *ExperimentSpec  `protobuf:"bytes,4,opt,name=spec" json:"spec,omitempty" bson:"spec,omitempty"`
+	State        Experiment_State `protobuf:"varint,5,opt,name=state,enum=grpc.trainer.v2.Experiment_State" json:"state,omitempty" bson:"state,omitempty"`
+	Trials       []*Trial         `protobuf:"bytes,6,rep,name=trials" json:"trials,omitempty" bson:"trials,omitempty"`
+	// The trial with the best objective value among the completed trials, if any.
+	BestTrainingId      string  `protobuf:"bytes,7,opt,name=best_training_id,json=bestTrainingId" json:"best_training_id,omitempty" bson:"best_training_id,omitempty"`
+	BestValue           float64 `protobuf:"fixed64,8,opt,name=best_value,json=bestValue" json:"best_value,omitempty" bson:"best_value,omitempty"`
+	SubmissionTimestamp string  `protobuf:"bytes,9,opt,name=submission_timestamp,json=submissionTimestamp" json:"submission_timestamp,omitempty" bson:"submission_timestamp,omitempty"`
+	CompletionTimestamp string  `protobuf:"bytes,10,opt,name=completion_timestamp,json=completionTimestamp" json:"completion_timestamp,omitempty" bson:"completion_timestamp,omitempty"`
 }
 
-func (m *Datastore) Reset()                    { *m = Datastore{} }
-func (m *Datastore) String() string            { return proto.CompactTextString(m) }
-func (*Datastore) ProtoMessage()               {}
-func (*Datastore) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (m *Experiment) Reset()                    { *m = Experiment{} }
+func (m *Experiment) String() string            { return proto.CompactTextString(m) }
+func (*Experiment) ProtoMessage()               {}
+func (*Experiment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
-func (m *Datastore) GetId() string {
+func (m *Experiment) GetExperimentId() string {
 	if m != nil {
-		return m.Id
+		return m.ExperimentId
 	}
 	return ""
 }
 
-func (m *Datastore) GetType() string {
+func (m *Experiment) GetUserId() string {
 	if m != nil {
-		return m.Type
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *Datastore) GetFields() map[string]string {
+func (m *Experiment) GetName() string {
 	if m != nil {
-		return m.Fields
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *Datastore) GetConnection() map[string]string {
+func (m *Experiment) GetSpec() *ExperimentSpec {
 	if m != nil {
-		return m.Connection
+		return m.Spec
 	}
 	return nil
 }
 
-// Used to specify resource requirements of a training job
-type ResourceRequirements struct {
-	// Number of CPU cores
-	Cpus float32 `protobuf:"fixed32,1,opt,name=cpus" json:"cpus,omitempty" bson:"cpus,omitempty"`
-	// Number of GPUs
-	Gpus float32 `protobuf:"fixed32,2,opt,name=gpus" json:"gpus,omitempty" bson:"gpus,omitempty"`
-	// RAM
-	Memory      float32  `protobuf:"fixed32,3,opt,name=memory" json:"memory,omitempty" bson:"memory,omitempty"`
-	MemoryUnit  SizeUnit `protobuf:"varint,4,opt,name=memory_unit,json=memoryUnit,enum=grpc.trainer.v2.SizeUnit" json:"memory_unit,omitempty" bson:"memory_unit,omitempty"`
-	Storage     float32  `protobuf:"fixed32,5,opt,name=storage" json:"storage,omitempty" bson:"storage,omitempty"`
-	StorageUnit SizeUnit `protobuf:"varint,6,opt,name=storage_unit,json=storageUnit,enum=grpc.trainer.v2.SizeUnit" json:"storage_unit,omitempty" bson:"storage_unit,omitempty"`
-	// Number of learners
-	Learners     int32  `protobuf:"varint,7,opt,name=learners" json:"learners,omitempty" bson:"learners,omitempty"`
-	Schedpolicy  string `protobuf:"bytes,8,opt,name=schedpolicy" json:"schedpolicy,omitempty" bson:"schedpolicy,omitempty"`
-	Topology     string `protobuf:"bytes,9,opt,name=topology" json:"topology,omitempty" bson:"topology,omitempty"`
-	Architecture string `protobuf:"bytes,10,opt,name=architecture" json:"architecture,omitempty" bson:"architecture,omitempty"`
-	// Optional. If not specified, job will be scheduled ONLY on nvidia-TeslaK80
-	// Constraint strictly enforced. If e.g., a nvidia-TeslaP100 is requested,
-	// job will NOT start until a nvidia-TeslaP100 is available
-	// Can only be nvidia-TeslaK80, nvidia-TeslaP100 or nvidia-TeslaV100
-	GpuType string `protobuf:"bytes,11,opt,name=gpu_type,json=gpuType" json:"gpu_type,omitempty" bson:"gpu_type,omitempty"`
+func (m *Experiment) GetState() Experiment_State {
+	if m != nil {
+		return m.State
+	}
+	return Experiment_RUNNING
 }
 
-func (m *ResourceRequirements) Reset()                    { *m = ResourceRequirements{} }
-func (m *ResourceRequirements) String() string            { return proto.CompactTextString(m) }
-func (*ResourceRequirements) ProtoMessage()               {}
-func (*ResourceRequirements) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
-
-func (m *ResourceRequirements) GetCpus() float32 {
+func (m *Experiment) GetTrials() []*Trial {
 	if m != nil {
-		return m.Cpus
+		return m.Trials
 	}
-	return 0
+	return nil
 }
 
-func (m *ResourceRequirements) GetGpus() float32 {
+func (m *Experiment) GetBestTrainingId() string {
 	if m != nil {
-		return m.Gpus
+		return m.BestTrainingId
 	}
-	return 0
+	return ""
 }
 
-func (m *ResourceRequirements) GetMemory() float32 {
+func (m *Experiment) GetBestValue() float64 {
 	if m != nil {
-		return m.Memory
+		return m.BestValue
 	}
 	return 0
 }
 
-func (m *ResourceRequirements) GetMemoryUnit() SizeUnit {
+func (m *Experiment) GetSubmissionTimestamp() string {
 	if m != nil {
-		return m.MemoryUnit
+		return m.SubmissionTimestamp
 	}
-	return SizeUnit_MB
+	return ""
 }
 
-func (m *ResourceRequirements) GetStorage() float32 {
+func (m *Experiment) GetCompletionTimestamp() string {
 	if m != nil {
-		return m.Storage
+		return m.CompletionTimestamp
 	}
-	return 0
+	return ""
 }
 
-func (m *ResourceRequirements) GetStorageUnit() SizeUnit {
+type Trial struct {
+	Index      int32             `protobuf:"varint,1,opt,name=index" json:"index,omitempty" bson:"index,omitempty"`
+	Parameters map[string]string `protobuf:"bytes,2,rep,name=parameters" json:"parameters,omitempty" bson:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Empty until the training job of the trial has been created
+	TrainingId string `protobuf:"bytes,3,opt,name=training_id,json=trainingId" json:"training_id,omitempty" bson:"training_id,omitempty"`
+	Status     Status `protobuf:"varint,4,opt,name=status,enum=grpc.trainer.v2.Status" json:"status,omitempty" bson:"status,omitempty"`
+	// The last reported value of the objective metric, if has_value is set
+	Value    float64 `protobuf:"fixed64,5,opt,name=value" json:"value,omitempty" bson:"value,omitempty"`
+	HasValue bool    `protobuf:"varint,6,opt,name=has_value,json=hasValue" json:"has_value,omitempty" bson:"has_value,omitempty"`
+}
+
+func (m *Trial) Reset()                    { *m = Trial{} }
+func (m *Trial) String() string            { return proto.CompactTextString(m) }
+func (*Trial) ProtoMessage()               {}
+func (*Trial) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *Trial) GetIndex() int32 {
 	if m != nil {
-		return m.StorageUnit
+		return m.Index
 	}
-	return SizeUnit_MB
+	return 0
 }
 
-func (m *ResourceRequirements) GetLearners() int32 {
+func (m *Trial) GetParameters() map[string]string {
 	if m != nil {
-		return m.Learners
+		return m.Parameters
 	}
-	return 0
+	return nil
 }
 
-func (m *ResourceRequirements) GetSchedpolicy() string {
+func (m *Trial) GetTrainingId() string {
 	if m != nil {
-		return m.Schedpolicy
+		return m.TrainingId
 	}
 	return ""
 }
 
-func (m *ResourceRequirements) GetTopology() string {
+func (m *Trial) GetStatus() Status {
 	if m != nil {
-		return m.Topology
+		return m.Status
 	}
-	return ""
+	return Status_NOT_STARTED
 }
 
-func (m *ResourceRequirements) GetArchitecture() string {
+func (m *Trial) GetValue() float64 {
 	if m != nil {
-		return m.Architecture
+		return m.Value
 	}
-	return ""
+	return 0
 }
 
-func (m *ResourceRequirements) GetGpuType() string {
+func (m *Trial) GetHasValue() bool {
 	if m != nil {
-		return m.GpuType
+		return m.HasValue
 	}
-	return ""
+	return false
 }
 
 type ModelDefinitionRequest struct {
@@ -1540,7 +2941,7 @@ type ModelDefinitionRequest struct {
 func (m *ModelDefinitionRequest) Reset()                    { *m = ModelDefinitionRequest{} }
 func (m *ModelDefinitionRequest) String() string            { return proto.CompactTextString(m) }
 func (*ModelDefinitionRequest) ProtoMessage()               {}
-func (*ModelDefinitionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*ModelDefinitionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
 func (m *ModelDefinitionRequest) GetTrainingId() string {
 	if m != nil {
@@ -1564,7 +2965,7 @@ type TrainedModelRequest struct {
 func (m *TrainedModelRequest) Reset()                    { *m = TrainedModelRequest{} }
 func (m *TrainedModelRequest) String() string            { return proto.CompactTextString(m) }
 func (*TrainedModelRequest) ProtoMessage()               {}
-func (*TrainedModelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*TrainedModelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
 func (m *TrainedModelRequest) GetTrainingId() string {
 	if m != nil {
@@ -1592,7 +2993,7 @@ type TrainedModelLogRequest struct {
 func (m *TrainedModelLogRequest) Reset()                    { *m = TrainedModelLogRequest{} }
 func (m *TrainedModelLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*TrainedModelLogRequest) ProtoMessage()               {}
-func (*TrainedModelLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*TrainedModelLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 func (m *TrainedModelLogRequest) GetTrainingId() string {
 	if m != nil {
@@ -1638,7 +3039,7 @@ type TrainedModelMetricsRequest struct {
 func (m *TrainedModelMetricsRequest) Reset()                    { *m = TrainedModelMetricsRequest{} }
 func (m *TrainedModelMetricsRequest) String() string            { return proto.CompactTextString(m) }
 func (*TrainedModelMetricsRequest) ProtoMessage()               {}
-func (*TrainedModelMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*TrainedModelMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *TrainedModelMetricsRequest) GetTrainingId() string {
 	if m != nil {
@@ -1669,7 +3070,7 @@ type GetLatestMetricsRequest struct {
 func (m *GetLatestMetricsRequest) Reset()                    { *m = GetLatestMetricsRequest{} }
 func (m *GetLatestMetricsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetLatestMetricsRequest) ProtoMessage()               {}
-func (*GetLatestMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*GetLatestMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 func (m *GetLatestMetricsRequest) GetTrainingId() string {
 	if m != nil {
@@ -1694,7 +3095,7 @@ type GetLatestMetricsResponse struct {
 func (m *GetLatestMetricsResponse) Reset()                    { *m = GetLatestMetricsResponse{} }
 func (m *GetLatestMetricsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetLatestMetricsResponse) ProtoMessage()               {}
-func (*GetLatestMetricsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*GetLatestMetricsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *GetLatestMetricsResponse) GetTrainingId() string {
 	if m != nil {
@@ -1727,7 +3128,7 @@ func (m *UpdateTrainedModelMetricsRequest) Reset()         { *m = UpdateTrainedM
 func (m *UpdateTrainedModelMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateTrainedModelMetricsRequest) ProtoMessage()    {}
 func (*UpdateTrainedModelMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{42}
+	return fileDescriptor0, []int{76}
 }
 
 func (m *UpdateTrainedModelMetricsRequest) GetTrainingId() string {
@@ -1759,7 +3160,7 @@ func (m *UpdateTrainedModelMetricsResponse) Reset()         { *m = UpdateTrained
 func (m *UpdateTrainedModelMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateTrainedModelMetricsResponse) ProtoMessage()    {}
 func (*UpdateTrainedModelMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{43}
+	return fileDescriptor0, []int{77}
 }
 
 func (m *UpdateTrainedModelMetricsResponse) GetTrainingId() string {
@@ -1776,7 +3177,7 @@ type ByteStreamResponse struct {
 func (m *ByteStreamResponse) Reset()                    { *m = ByteStreamResponse{} }
 func (m *ByteStreamResponse) String() string            { return proto.CompactTextString(m) }
 func (*ByteStreamResponse) ProtoMessage()               {}
-func (*ByteStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*ByteStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 func (m *ByteStreamResponse) GetData() []byte {
 	if m != nil {
@@ -1792,7 +3193,7 @@ type ZippedDataChunk struct {
 func (m *ZippedDataChunk) Reset()                    { *m = ZippedDataChunk{} }
 func (m *ZippedDataChunk) String() string            { return proto.CompactTextString(m) }
 func (*ZippedDataChunk) ProtoMessage()               {}
-func (*ZippedDataChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*ZippedDataChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *ZippedDataChunk) GetData() []byte {
 	if m != nil {
@@ -1807,7 +3208,7 @@ type GetVersionsRequest struct {
 func (m *GetVersionsRequest) Reset()                    { *m = GetVersionsRequest{} }
 func (m *GetVersionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetVersionsRequest) ProtoMessage()               {}
-func (*GetVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*GetVersionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 // Contains a list of all frameworks currently supported along with the versions of that framework and whether a
 // specific framework version can be used by anyone or only for internal usage.
@@ -1818,7 +3219,7 @@ type Frameworks struct {
 func (m *Frameworks) Reset()                    { *m = Frameworks{} }
 func (m *Frameworks) String() string            { return proto.CompactTextString(m) }
 func (*Frameworks) ProtoMessage()               {}
-func (*Frameworks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*Frameworks) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *Frameworks) GetFrameworks() map[string]*FrameworkDetailList {
 	if m != nil {
@@ -1834,7 +3235,7 @@ type FrameworkDetailList struct {
 func (m *FrameworkDetailList) Reset()                    { *m = FrameworkDetailList{} }
 func (m *FrameworkDetailList) String() string            { return proto.CompactTextString(m) }
 func (*FrameworkDetailList) ProtoMessage()               {}
-func (*FrameworkDetailList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*FrameworkDetailList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *FrameworkDetailList) GetVersions() []*FrameworkDetails {
 	if m != nil {
@@ -1852,7 +3253,7 @@ type FrameworkDetails struct {
 func (m *FrameworkDetails) Reset()                    { *m = FrameworkDetails{} }
 func (m *FrameworkDetails) String() string            { return proto.CompactTextString(m) }
 func (*FrameworkDetails) ProtoMessage()               {}
-func (*FrameworkDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*FrameworkDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *FrameworkDetails) GetVersion() string {
 	if m != nil {
@@ -1882,6 +3283,17 @@ func init() {
 	proto.RegisterType((*CreateResponse)(nil), "grpc.trainer.v2.CreateResponse")
 	proto.RegisterType((*UpdateRequest)(nil), "grpc.trainer.v2.UpdateRequest")
 	proto.RegisterType((*UpdateResponse)(nil), "grpc.trainer.v2.UpdateResponse")
+	proto.RegisterType((*PriorityRequest)(nil), "grpc.trainer.v2.PriorityRequest")
+	proto.RegisterType((*PriorityResponse)(nil), "grpc.trainer.v2.PriorityResponse")
+	proto.RegisterType((*PurgeRequest)(nil), "grpc.trainer.v2.PurgeRequest")
+	proto.RegisterType((*PurgeResponse)(nil), "grpc.trainer.v2.PurgeResponse")
+	proto.RegisterType((*PurgedTraining)(nil), "grpc.trainer.v2.PurgedTraining")
+	proto.RegisterType((*UsageRequest)(nil), "grpc.trainer.v2.UsageRequest")
+	proto.RegisterType((*UsageResponse)(nil), "grpc.trainer.v2.UsageResponse")
+	proto.RegisterType((*UsageEntry)(nil), "grpc.trainer.v2.UsageEntry")
+	proto.RegisterType((*ResourceUsage)(nil), "grpc.trainer.v2.ResourceUsage")
+	proto.RegisterType((*RenderResponse)(nil), "grpc.trainer.v2.RenderResponse")
+	proto.RegisterType((*RenderedObject)(nil), "grpc.trainer.v2.RenderedObject")
 	proto.RegisterType((*GetRequest)(nil), "grpc.trainer.v2.GetRequest")
 	proto.RegisterType((*GetResponse)(nil), "grpc.trainer.v2.GetResponse")
 	proto.RegisterType((*GetStatusResponse)(nil), "grpc.trainer.v2.GetStatusResponse")
@@ -1894,6 +3306,15 @@ func init() {
 	proto.RegisterType((*HaltResponse)(nil), "grpc.trainer.v2.HaltResponse")
 	proto.RegisterType((*ResumeRequest)(nil), "grpc.trainer.v2.ResumeRequest")
 	proto.RegisterType((*ResumeResponse)(nil), "grpc.trainer.v2.ResumeResponse")
+	proto.RegisterType((*LabelsRequest)(nil), "grpc.trainer.v2.LabelsRequest")
+	proto.RegisterType((*LabelsResponse)(nil), "grpc.trainer.v2.LabelsResponse")
+	proto.RegisterType((*BulkRequest)(nil), "grpc.trainer.v2.BulkRequest")
+	proto.RegisterType((*BulkResponse)(nil), "grpc.trainer.v2.BulkResponse")
+	proto.RegisterType((*BulkResult)(nil), "grpc.trainer.v2.BulkResult")
+	proto.RegisterType((*StatusHistoryEntry)(nil), "grpc.trainer.v2.StatusHistoryEntry")
+	proto.RegisterType((*StatusHistoryResponse)(nil), "grpc.trainer.v2.StatusHistoryResponse")
+	proto.RegisterType((*WatchRequest)(nil), "grpc.trainer.v2.WatchRequest")
+	proto.RegisterType((*StatusEvent)(nil), "grpc.trainer.v2.StatusEvent")
 	proto.RegisterType((*DeleteRequest)(nil), "grpc.trainer.v2.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "grpc.trainer.v2.DeleteResponse")
 	proto.RegisterType((*Metrics)(nil), "grpc.trainer.v2.Metrics")
@@ -1902,9 +3323,23 @@ func init() {
 	proto.RegisterType((*Framework)(nil), "grpc.trainer.v2.Framework")
 	proto.RegisterType((*ImageLocation)(nil), "grpc.trainer.v2.ImageLocation")
 	proto.RegisterType((*Training)(nil), "grpc.trainer.v2.Training")
+	proto.RegisterType((*SecretKeyRef)(nil), "grpc.trainer.v2.SecretKeyRef")
+	proto.RegisterType((*RetryPolicy)(nil), "grpc.trainer.v2.RetryPolicy")
 	proto.RegisterType((*TrainingStatus)(nil), "grpc.trainer.v2.TrainingStatus")
 	proto.RegisterType((*Datastore)(nil), "grpc.trainer.v2.Datastore")
 	proto.RegisterType((*ResourceRequirements)(nil), "grpc.trainer.v2.ResourceRequirements")
+	proto.RegisterType((*Toleration)(nil), "grpc.trainer.v2.Toleration")
+	proto.RegisterType((*CreateExperimentRequest)(nil), "grpc.trainer.v2.CreateExperimentRequest")
+	proto.RegisterType((*CreateExperimentResponse)(nil), "grpc.trainer.v2.CreateExperimentResponse")
+	proto.RegisterType((*GetExperimentRequest)(nil), "grpc.trainer.v2.GetExperimentRequest")
+	proto.RegisterType((*GetExperimentResponse)(nil), "grpc.trainer.v2.GetExperimentResponse")
+	proto.RegisterType((*GetAllExperimentsRequest)(nil), "grpc.trainer.v2.GetAllExperimentsRequest")
+	proto.RegisterType((*GetAllExperimentsResponse)(nil), "grpc.trainer.v2.GetAllExperimentsResponse")
+	proto.RegisterType((*ExperimentSpec)(nil), "grpc.trainer.v2.ExperimentSpec")
+	proto.RegisterType((*HyperParameter)(nil), "grpc.trainer.v2.HyperParameter")
+	proto.RegisterType((*Objective)(nil), "grpc.trainer.v2.Objective")
+	proto.RegisterType((*Experiment)(nil), "grpc.trainer.v2.Experiment")
+	proto.RegisterType((*Trial)(nil), "grpc.trainer.v2.Trial")
 	proto.RegisterType((*ModelDefinitionRequest)(nil), "grpc.trainer.v2.ModelDefinitionRequest")
 	proto.RegisterType((*TrainedModelRequest)(nil), "grpc.trainer.v2.TrainedModelRequest")
 	proto.RegisterType((*TrainedModelLogRequest)(nil), "grpc.trainer.v2.TrainedModelLogRequest")
@@ -1923,6 +3358,8 @@ func init() {
 	proto.RegisterEnum("grpc.trainer.v2.Status", Status_name, Status_value)
 	proto.RegisterEnum("grpc.trainer.v2.Any_DataType", Any_DataType_name, Any_DataType_value)
 	proto.RegisterEnum("grpc.trainer.v2.Query_SearchType", Query_SearchType_name, Query_SearchType_value)
+	proto.RegisterEnum("grpc.trainer.v2.ExperimentSpec_Strategy", ExperimentSpec_Strategy_name, ExperimentSpec_Strategy_value)
+	proto.RegisterEnum("grpc.trainer.v2.Experiment_State", Experiment_State_name, Experiment_State_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1957,14 +3394,44 @@ type TrainerClient interface {
 	// Get evaluation metrics records, based on query
 	GetTrainingEMetrics(ctx context.Context, in *Query, opts ...grpc.CallOption) (Trainer_GetTrainingEMetricsClient, error)
 	GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*Frameworks, error)
+	// Creates an experiment that runs a training job for each set of values of its parameter space.
+	CreateExperiment(ctx context.Context, in *CreateExperimentRequest, opts ...grpc.CallOption) (*CreateExperimentResponse, error)
+	// Reads an experiment with a given ID, including its trials and the best run so far.
+	GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*GetExperimentResponse, error)
+	// Returns all experiments for a given user.
+	GetAllExperiments(ctx context.Context, in *GetAllExperimentsRequest, opts ...grpc.CallOption) (*GetAllExperimentsResponse, error)
 	// For internal use only!
 	GetTrainingStatusID(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetStatusIDResponse, error)
 	// For internal use only!
 	// Updates an existing training status
 	// TODO we should not have this but until we fix the status update handling properly, we have no other choice.
 	UpdateTrainingJob(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	// Not implemented, to be removed (for GA)
+	// For internal use only!
+	// Changes the priority of a training job that is still waiting in the queue
+	SetTrainingJobPriority(ctx context.Context, in *PriorityRequest, opts ...grpc.CallOption) (*PriorityResponse, error)
+	// Resumes a halted training job from the last checkpoint in its result store
 	ResumeTrainingJob(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error)
+	// Replaces the labels of a training job
+	SetTrainingJobLabels(ctx context.Context, in *LabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error)
+	// Halts the training jobs of a user whose labels match a label selector
+	HaltTrainingJobs(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	// Deletes the training jobs of a user whose labels match a label selector
+	DeleteTrainingJobs(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	// Returns the status transitions of a training job, oldest first
+	GetTrainingStatusHistory(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*StatusHistoryResponse, error)
+	// Streams the status transitions of a training job, starting with the ones it already went through.
+	// The stream ends when the job has finished.
+	WatchTrainingJob(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (Trainer_WatchTrainingJobClient, error)
+	// Streams the status transitions of all training jobs of a user from the time of the call on
+	WatchUserTrainingJobs(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Trainer_WatchUserTrainingJobsClient, error)
+	// For internal use only!
+	// Purges the training jobs selected by the retention policy, or only reports them if dry_run is set
+	PurgeTrainingJobs(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*PurgeResponse, error)
+	// Validates a training job like CreateTrainingJob and returns the Kubernetes objects the LCM would create for it,
+	// with the values of secrets redacted. Nothing is stored, uploaded or deployed.
+	RenderTrainingJob(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*RenderResponse, error)
+	// Returns the resources consumed by finished training jobs, aggregated by user or by the value of a label
+	GetUsage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
 }
 
 type trainerClient struct {
@@ -2099,29 +3566,201 @@ func (c *trainerClient) GetTrainedModelLogs(ctx context.Context, in *TrainedMode
 	return x, nil
 }
 
-type Trainer_GetTrainedModelLogsClient interface {
-	Recv() (*ByteStreamResponse, error)
-	grpc.ClientStream
+type Trainer_GetTrainedModelLogsClient interface {
+	Recv() (*ByteStreamResponse, error)
+	grpc.ClientStream
+}
+
+type trainerGetTrainedModelLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trainerGetTrainedModelLogsClient) Recv() (*ByteStreamResponse, error) {
+	m := new(ByteStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trainerClient) GetTrainingLogs(ctx context.Context, in *Query, opts ...grpc.CallOption) (Trainer_GetTrainingLogsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Trainer_serviceDesc.Streams[3], c.cc, "/grpc.trainer.v2.Trainer/GetTrainingLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trainerGetTrainingLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trainer_GetTrainingLogsClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type trainerGetTrainingLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trainerGetTrainingLogsClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trainerClient) GetTrainingEMetrics(ctx context.Context, in *Query, opts ...grpc.CallOption) (Trainer_GetTrainingEMetricsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Trainer_serviceDesc.Streams[4], c.cc, "/grpc.trainer.v2.Trainer/GetTrainingEMetrics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trainerGetTrainingEMetricsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trainer_GetTrainingEMetricsClient interface {
+	Recv() (*EMetrics, error)
+	grpc.ClientStream
+}
+
+type trainerGetTrainingEMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trainerGetTrainingEMetricsClient) Recv() (*EMetrics, error) {
+	m := new(EMetrics)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trainerClient) GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*Frameworks, error) {
+	out := new(Frameworks)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetVersions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) CreateExperiment(ctx context.Context, in *CreateExperimentRequest, opts ...grpc.CallOption) (*CreateExperimentResponse, error) {
+	out := new(CreateExperimentResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/CreateExperiment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*GetExperimentResponse, error) {
+	out := new(GetExperimentResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetExperiment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) GetAllExperiments(ctx context.Context, in *GetAllExperimentsRequest, opts ...grpc.CallOption) (*GetAllExperimentsResponse, error) {
+	out := new(GetAllExperimentsResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetAllExperiments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) GetTrainingStatusID(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetStatusIDResponse, error) {
+	out := new(GetStatusIDResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetTrainingStatusID", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) UpdateTrainingJob(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/UpdateTrainingJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) SetTrainingJobPriority(ctx context.Context, in *PriorityRequest, opts ...grpc.CallOption) (*PriorityResponse, error) {
+	out := new(PriorityResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/SetTrainingJobPriority", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) ResumeTrainingJob(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error) {
+	out := new(ResumeResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/ResumeTrainingJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) SetTrainingJobLabels(ctx context.Context, in *LabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error) {
+	out := new(LabelsResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/SetTrainingJobLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainerClient) HaltTrainingJobs(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/HaltTrainingJobs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type trainerGetTrainedModelLogsClient struct {
-	grpc.ClientStream
+func (c *trainerClient) DeleteTrainingJobs(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/DeleteTrainingJobs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *trainerGetTrainedModelLogsClient) Recv() (*ByteStreamResponse, error) {
-	m := new(ByteStreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *trainerClient) GetTrainingStatusHistory(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*StatusHistoryResponse, error) {
+	out := new(StatusHistoryResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetTrainingStatusHistory", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *trainerClient) GetTrainingLogs(ctx context.Context, in *Query, opts ...grpc.CallOption) (Trainer_GetTrainingLogsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Trainer_serviceDesc.Streams[3], c.cc, "/grpc.trainer.v2.Trainer/GetTrainingLogs", opts...)
+func (c *trainerClient) WatchTrainingJob(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (Trainer_WatchTrainingJobClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Trainer_serviceDesc.Streams[5], c.cc, "/grpc.trainer.v2.Trainer/WatchTrainingJob", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &trainerGetTrainingLogsClient{stream}
+	x := &trainerWatchTrainingJobClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -2131,29 +3770,29 @@ func (c *trainerClient) GetTrainingLogs(ctx context.Context, in *Query, opts ...
 	return x, nil
 }
 
-type Trainer_GetTrainingLogsClient interface {
-	Recv() (*LogLine, error)
+type Trainer_WatchTrainingJobClient interface {
+	Recv() (*StatusEvent, error)
 	grpc.ClientStream
 }
 
-type trainerGetTrainingLogsClient struct {
+type trainerWatchTrainingJobClient struct {
 	grpc.ClientStream
 }
 
-func (x *trainerGetTrainingLogsClient) Recv() (*LogLine, error) {
-	m := new(LogLine)
+func (x *trainerWatchTrainingJobClient) Recv() (*StatusEvent, error) {
+	m := new(StatusEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *trainerClient) GetTrainingEMetrics(ctx context.Context, in *Query, opts ...grpc.CallOption) (Trainer_GetTrainingEMetricsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Trainer_serviceDesc.Streams[4], c.cc, "/grpc.trainer.v2.Trainer/GetTrainingEMetrics", opts...)
+func (c *trainerClient) WatchUserTrainingJobs(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Trainer_WatchUserTrainingJobsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Trainer_serviceDesc.Streams[6], c.cc, "/grpc.trainer.v2.Trainer/WatchUserTrainingJobs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &trainerGetTrainingEMetricsClient{stream}
+	x := &trainerWatchUserTrainingJobsClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -2163,53 +3802,44 @@ func (c *trainerClient) GetTrainingEMetrics(ctx context.Context, in *Query, opts
 	return x, nil
 }
 
-type Trainer_GetTrainingEMetricsClient interface {
-	Recv() (*EMetrics, error)
+type Trainer_WatchUserTrainingJobsClient interface {
+	Recv() (*StatusEvent, error)
 	grpc.ClientStream
 }
 
-type trainerGetTrainingEMetricsClient struct {
+type trainerWatchUserTrainingJobsClient struct {
 	grpc.ClientStream
 }
 
-func (x *trainerGetTrainingEMetricsClient) Recv() (*EMetrics, error) {
-	m := new(EMetrics)
+func (x *trainerWatchUserTrainingJobsClient) Recv() (*StatusEvent, error) {
+	m := new(StatusEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *trainerClient) GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*Frameworks, error) {
-	out := new(Frameworks)
-	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetVersions", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *trainerClient) GetTrainingStatusID(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetStatusIDResponse, error) {
-	out := new(GetStatusIDResponse)
-	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetTrainingStatusID", in, out, c.cc, opts...)
+func (c *trainerClient) PurgeTrainingJobs(ctx context.Context, in *PurgeRequest, opts ...grpc.CallOption) (*PurgeResponse, error) {
+	out := new(PurgeResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/PurgeTrainingJobs", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *trainerClient) UpdateTrainingJob(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/UpdateTrainingJob", in, out, c.cc, opts...)
+func (c *trainerClient) RenderTrainingJob(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*RenderResponse, error) {
+	out := new(RenderResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/RenderTrainingJob", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *trainerClient) ResumeTrainingJob(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error) {
-	out := new(ResumeResponse)
-	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/ResumeTrainingJob", in, out, c.cc, opts...)
+func (c *trainerClient) GetUsage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	out := new(UsageResponse)
+	err := grpc.Invoke(ctx, "/grpc.trainer.v2.Trainer/GetUsage", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2240,14 +3870,44 @@ type TrainerServer interface {
 	// Get evaluation metrics records, based on query
 	GetTrainingEMetrics(*Query, Trainer_GetTrainingEMetricsServer) error
 	GetVersions(context.Context, *GetVersionsRequest) (*Frameworks, error)
+	// Creates an experiment that runs a training job for each set of values of its parameter space.
+	CreateExperiment(context.Context, *CreateExperimentRequest) (*CreateExperimentResponse, error)
+	// Reads an experiment with a given ID, including its trials and the best run so far.
+	GetExperiment(context.Context, *GetExperimentRequest) (*GetExperimentResponse, error)
+	// Returns all experiments for a given user.
+	GetAllExperiments(context.Context, *GetAllExperimentsRequest) (*GetAllExperimentsResponse, error)
 	// For internal use only!
 	GetTrainingStatusID(context.Context, *GetRequest) (*GetStatusIDResponse, error)
 	// For internal use only!
 	// Updates an existing training status
 	// TODO we should not have this but until we fix the status update handling properly, we have no other choice.
 	UpdateTrainingJob(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	// Not implemented, to be removed (for GA)
+	// For internal use only!
+	// Changes the priority of a training job that is still waiting in the queue
+	SetTrainingJobPriority(context.Context, *PriorityRequest) (*PriorityResponse, error)
+	// Resumes a halted training job from the last checkpoint in its result store
 	ResumeTrainingJob(context.Context, *ResumeRequest) (*ResumeResponse, error)
+	// Replaces the labels of a training job
+	SetTrainingJobLabels(context.Context, *LabelsRequest) (*LabelsResponse, error)
+	// Halts the training jobs of a user whose labels match a label selector
+	HaltTrainingJobs(context.Context, *BulkRequest) (*BulkResponse, error)
+	// Deletes the training jobs of a user whose labels match a label selector
+	DeleteTrainingJobs(context.Context, *BulkRequest) (*BulkResponse, error)
+	// Returns the status transitions of a training job, oldest first
+	GetTrainingStatusHistory(context.Context, *GetRequest) (*StatusHistoryResponse, error)
+	// Streams the status transitions of a training job, starting with the ones it already went through.
+	// The stream ends when the job has finished.
+	WatchTrainingJob(*GetRequest, Trainer_WatchTrainingJobServer) error
+	// Streams the status transitions of all training jobs of a user from the time of the call on
+	WatchUserTrainingJobs(*WatchRequest, Trainer_WatchUserTrainingJobsServer) error
+	// For internal use only!
+	// Purges the training jobs selected by the retention policy, or only reports them if dry_run is set
+	PurgeTrainingJobs(context.Context, *PurgeRequest) (*PurgeResponse, error)
+	// Validates a training job like CreateTrainingJob and returns the Kubernetes objects the LCM would create for it,
+	// with the values of secrets redacted. Nothing is stored, uploaded or deployed.
+	RenderTrainingJob(context.Context, *CreateRequest) (*RenderResponse, error)
+	// Returns the resources consumed by finished training jobs, aggregated by user or by the value of a label
+	GetUsage(context.Context, *UsageRequest) (*UsageResponse, error)
 }
 
 func RegisterTrainerServer(s *grpc.Server, srv TrainerServer) {
@@ -2467,6 +4127,60 @@ func _Trainer_GetVersions_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trainer_CreateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).CreateExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/CreateExperiment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).CreateExperiment(ctx, req.(*CreateExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_GetExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).GetExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/GetExperiment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).GetExperiment(ctx, req.(*GetExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_GetAllExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllExperimentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).GetAllExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/GetAllExperiments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).GetAllExperiments(ctx, req.(*GetAllExperimentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trainer_GetTrainingStatusID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRequest)
 	if err := dec(in); err != nil {
@@ -2503,6 +4217,24 @@ func _Trainer_UpdateTrainingJob_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trainer_SetTrainingJobPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).SetTrainingJobPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/SetTrainingJobPriority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).SetTrainingJobPriority(ctx, req.(*PriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Trainer_ResumeTrainingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResumeRequest)
 	if err := dec(in); err != nil {
@@ -2521,6 +4253,174 @@ func _Trainer_ResumeTrainingJob_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trainer_SetTrainingJobLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).SetTrainingJobLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/SetTrainingJobLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).SetTrainingJobLabels(ctx, req.(*LabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_HaltTrainingJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).HaltTrainingJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/HaltTrainingJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).HaltTrainingJobs(ctx, req.(*BulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_DeleteTrainingJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).DeleteTrainingJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/DeleteTrainingJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).DeleteTrainingJobs(ctx, req.(*BulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_GetTrainingStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).GetTrainingStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/GetTrainingStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).GetTrainingStatusHistory(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_WatchTrainingJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrainerServer).WatchTrainingJob(m, &trainerWatchTrainingJobServer{stream})
+}
+
+type Trainer_WatchTrainingJobServer interface {
+	Send(*StatusEvent) error
+	grpc.ServerStream
+}
+
+type trainerWatchTrainingJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *trainerWatchTrainingJobServer) Send(m *StatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trainer_WatchUserTrainingJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrainerServer).WatchUserTrainingJobs(m, &trainerWatchUserTrainingJobsServer{stream})
+}
+
+type Trainer_WatchUserTrainingJobsServer interface {
+	Send(*StatusEvent) error
+	grpc.ServerStream
+}
+
+type trainerWatchUserTrainingJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *trainerWatchUserTrainingJobsServer) Send(m *StatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trainer_PurgeTrainingJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).PurgeTrainingJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/PurgeTrainingJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).PurgeTrainingJobs(ctx, req.(*PurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_RenderTrainingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).RenderTrainingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/RenderTrainingJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).RenderTrainingJob(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trainer_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.trainer.v2.Trainer/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServer).GetUsage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Trainer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "grpc.trainer.v2.Trainer",
 	HandlerType: (*TrainerServer)(nil),
@@ -2549,6 +4449,18 @@ var _Trainer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersions",
 			Handler:    _Trainer_GetVersions_Handler,
 		},
+		{
+			MethodName: "CreateExperiment",
+			Handler:    _Trainer_CreateExperiment_Handler,
+		},
+		{
+			MethodName: "GetExperiment",
+			Handler:    _Trainer_GetExperiment_Handler,
+		},
+		{
+			MethodName: "GetAllExperiments",
+			Handler:    _Trainer_GetAllExperiments_Handler,
+		},
 		{
 			MethodName: "GetTrainingStatusID",
 			Handler:    _Trainer_GetTrainingStatusID_Handler,
@@ -2557,10 +4469,42 @@ var _Trainer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTrainingJob",
 			Handler:    _Trainer_UpdateTrainingJob_Handler,
 		},
+		{
+			MethodName: "SetTrainingJobPriority",
+			Handler:    _Trainer_SetTrainingJobPriority_Handler,
+		},
 		{
 			MethodName: "ResumeTrainingJob",
 			Handler:    _Trainer_ResumeTrainingJob_Handler,
 		},
+		{
+			MethodName: "SetTrainingJobLabels",
+			Handler:    _Trainer_SetTrainingJobLabels_Handler,
+		},
+		{
+			MethodName: "HaltTrainingJobs",
+			Handler:    _Trainer_HaltTrainingJobs_Handler,
+		},
+		{
+			MethodName: "DeleteTrainingJobs",
+			Handler:    _Trainer_DeleteTrainingJobs_Handler,
+		},
+		{
+			MethodName: "GetTrainingStatusHistory",
+			Handler:    _Trainer_GetTrainingStatusHistory_Handler,
+		},
+		{
+			MethodName: "PurgeTrainingJobs",
+			Handler:    _Trainer_PurgeTrainingJobs_Handler,
+		},
+		{
+			MethodName: "RenderTrainingJob",
+			Handler:    _Trainer_RenderTrainingJob_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Trainer_GetUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2588,6 +4532,16 @@ var _Trainer_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Trainer_GetTrainingEMetrics_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchTrainingJob",
+			Handler:       _Trainer_WatchTrainingJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchUserTrainingJobs",
+			Handler:       _Trainer_WatchUserTrainingJobs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "trainer.proto",
 }
@@ -2595,166 +4549,279 @@ var _Trainer_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("trainer.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x68, 0xac, 0xaf, 0xa7, 0x58, 0xd6, 0x76, 0x1c, 0x47, 0x51, 0xbe, 0x9c, 0xd9, 0x64,
-	0x71, 0x65, 0x77, 0xbd, 0xc4, 0xb0, 0x4b, 0xd6, 0x95, 0x50, 0xa5, 0x58, 0xb2, 0xe2, 0xac, 0x6c,
-	0x39, 0x23, 0x25, 0xc0, 0x56, 0x51, 0xaa, 0xd1, 0xa8, 0xad, 0x4c, 0x32, 0x5f, 0xcc, 0xb4, 0x92,
-	0x68, 0xb9, 0x41, 0x15, 0x45, 0x71, 0xdd, 0x03, 0x27, 0xee, 0xfc, 0x03, 0x40, 0x15, 0x07, 0xae,
-	0xdc, 0xb9, 0x70, 0xe3, 0x4f, 0xe0, 0xc6, 0x81, 0x1b, 0xd5, 0x1f, 0xf3, 0x25, 0x8d, 0x2c, 0x1b,
-	0x9b, 0xe2, 0xd6, 0xfd, 0xfa, 0xbd, 0xdf, 0x74, 0xbf, 0x7e, 0x5f, 0xfd, 0x24, 0x58, 0x21, 0x9e,
-	0x66, 0xd8, 0xd8, 0xdb, 0x72, 0x3d, 0x87, 0x38, 0x68, 0x75, 0xe4, 0xb9, 0xfa, 0x56, 0x40, 0x7b,
-	0xbb, 0xad, 0xfc, 0x39, 0x03, 0x2b, 0xbb, 0x1e, 0xd6, 0x08, 0x56, 0xf1, 0xcf, 0xc6, 0xd8, 0x27,
-	0xe8, 0x2a, 0xe4, 0xc7, 0x3e, 0xf6, 0xfa, 0xc6, 0xb0, 0x2a, 0x6d, 0x48, 0x9b, 0x45, 0x35, 0x47,
-	0xa7, 0xfb, 0x43, 0xf4, 0x15, 0x54, 0x2c, 0x67, 0x88, 0xcd, 0xfe, 0x10, 0x1f, 0x1b, 0xb6, 0x41,
-	0x0c, 0xc7, 0xae, 0x66, 0x36, 0xa4, 0xcd, 0xd2, 0xf6, 0xc6, 0xd6, 0x14, 0xec, 0xd6, 0x01, 0x65,
-	0x6c, 0x84, 0x7c, 0xea, 0xaa, 0x95, 0x24, 0xa0, 0xcf, 0xa1, 0xc0, 0xd8, 0x0d, 0x7b, 0x54, 0x95,
-	0x19, 0xc8, 0xb5, 0x19, 0x90, 0x9e, 0x60, 0x50, 0x43, 0x56, 0xb4, 0x03, 0x30, 0xd4, 0x88, 0xe6,
-	0x13, 0xc7, 0xc3, 0x7e, 0x75, 0x79, 0x43, 0xde, 0x2c, 0x6d, 0xd7, 0x66, 0x04, 0x1b, 0x01, 0x8b,
-	0x1a, 0xe3, 0x46, 0x47, 0x80, 0xf0, 0x5b, 0xcd, 0x1c, 0x6b, 0x74, 0x03, 0x7d, 0x0b, 0x13, 0xcf,
-	0xd0, 0xfd, 0x6a, 0x96, 0x7d, 0xfc, 0xce, 0x0c, 0x46, 0xf3, 0xa0, 0xf9, 0x9e, 0x78, 0x9a, 0x4e,
-	0x99, 0xbb, 0x2e, 0xd6, 0xd5, 0x0f, 0x22, 0xe1, 0x03, 0x2e, 0xab, 0xfc, 0x31, 0x03, 0x95, 0x69,
-	0x3e, 0x84, 0x60, 0x99, 0x4c, 0x5c, 0x2c, 0x94, 0xc7, 0xc6, 0xe8, 0x3a, 0x14, 0x0d, 0x4b, 0x1b,
-	0xe1, 0x3e, 0xd1, 0x46, 0xd5, 0x1c, 0x5b, 0x28, 0x30, 0x42, 0x4f, 0x1b, 0xa1, 0x32, 0x64, 0x0c,
-	0xae, 0xc9, 0xa2, 0x9a, 0x31, 0x6c, 0x74, 0x0f, 0xca, 0xa6, 0x61, 0xe3, 0xbe, 0xe9, 0x38, 0x6f,
-	0xb4, 0x57, 0x58, 0x1b, 0x32, 0x05, 0x65, 0xd5, 0x15, 0x4a, 0x6d, 0x07, 0x44, 0x74, 0x0b, 0x00,
-	0xbf, 0xc5, 0x36, 0xe9, 0x4d, 0x5c, 0xa1, 0x8a, 0xa2, 0x1a, 0xa3, 0xa0, 0x26, 0xe4, 0x46, 0x9e,
-	0x33, 0x76, 0xe9, 0x11, 0xa9, 0x9a, 0x3e, 0x5d, 0x78, 0xc4, 0xad, 0x16, 0xe3, 0x6f, 0xda, 0xc4,
-	0x9b, 0xa8, 0x42, 0xb8, 0xd6, 0x85, 0x52, 0x8c, 0x8c, 0x2a, 0x20, 0xbf, 0xc1, 0x13, 0x71, 0x38,
-	0x3a, 0x44, 0x5b, 0x90, 0xa5, 0x8a, 0xc1, 0xc2, 0x16, 0xaa, 0x29, 0x9f, 0x61, 0x00, 0x2a, 0x67,
-	0xdb, 0xc9, 0x3c, 0x94, 0x94, 0x7f, 0x66, 0x20, 0x2f, 0xc8, 0x68, 0x0d, 0xb2, 0x1e, 0x1e, 0xe1,
-	0xf7, 0x02, 0x93, 0x4f, 0xd0, 0xc7, 0xb0, 0x6c, 0x61, 0xa2, 0x09, 0xd0, 0xab, 0x29, 0xa0, 0x07,
-	0x98, 0x68, 0x2a, 0x63, 0x42, 0x8f, 0x20, 0xc7, 0xb0, 0xfd, 0xaa, 0xcc, 0x8e, 0x7a, 0x77, 0xde,
-	0x1e, 0xb6, 0x5e, 0x32, 0x36, 0x71, 0x42, 0x2e, 0x43, 0xa5, 0x31, 0x31, 0xac, 0xd0, 0x9e, 0xe6,
-	0x4b, 0x37, 0x19, 0x9b, 0x90, 0xe6, 0x32, 0xb5, 0xe7, 0x50, 0x8a, 0x81, 0xa6, 0xe8, 0xe7, 0x93,
-	0xa4, 0x7e, 0xd6, 0x53, 0xd0, 0xeb, 0xf6, 0x24, 0xa6, 0x1d, 0x0a, 0x19, 0xfb, 0xd2, 0x45, 0x40,
-	0x2a, 0xdb, 0x90, 0xe3, 0x1a, 0x63, 0xe6, 0x69, 0x58, 0x98, 0xd9, 0x14, 0x35, 0x4f, 0xc3, 0xc2,
-	0xf4, 0x0a, 0xfc, 0xf1, 0xc0, 0x18, 0x32, 0x67, 0x28, 0xaa, 0x7c, 0xa2, 0x3c, 0x80, 0x2c, 0xc3,
-	0x49, 0xb5, 0xe8, 0xb5, 0xf8, 0x16, 0x8a, 0xe2, 0x53, 0xca, 0xaf, 0x24, 0x28, 0xd0, 0xaf, 0xec,
-	0xdb, 0xc7, 0x0e, 0xba, 0x0d, 0xa5, 0xc0, 0x6f, 0xa3, 0x60, 0x02, 0x01, 0x69, 0x7f, 0x18, 0x8f,
-	0x34, 0x99, 0x44, 0xa4, 0x89, 0xef, 0x51, 0x16, 0x7b, 0x5c, 0x87, 0x9c, 0x67, 0xd8, 0x43, 0xfc,
-	0xbe, 0xba, 0xcc, 0xa8, 0x62, 0x36, 0x67, 0xef, 0x6d, 0xc8, 0xb7, 0x9d, 0x51, 0xdb, 0xb0, 0x31,
-	0xfa, 0x54, 0x58, 0x92, 0x34, 0x27, 0xca, 0x04, 0xfb, 0x15, 0xb6, 0x84, 0x60, 0x99, 0xfa, 0x99,
-	0xd8, 0x11, 0x1b, 0x2b, 0xbf, 0x91, 0x40, 0xa6, 0x8a, 0x78, 0x10, 0x53, 0x44, 0x79, 0xfb, 0xe6,
-	0x0c, 0x54, 0xdd, 0x9e, 0xb0, 0xd8, 0x43, 0x1d, 0xf0, 0x44, 0x3d, 0xed, 0x40, 0x21, 0xe0, 0x43,
-	0x00, 0xb9, 0x6e, 0x4f, 0xdd, 0x3f, 0x6c, 0x55, 0x96, 0x50, 0x19, 0xe0, 0x59, 0xb7, 0x73, 0x28,
-	0xe6, 0x12, 0xca, 0x83, 0xbc, 0x7f, 0xd8, 0xab, 0x64, 0x50, 0x11, 0xb2, 0x7b, 0xed, 0x4e, 0xbd,
-	0x57, 0x91, 0x95, 0x7f, 0x67, 0xa0, 0xd0, 0x14, 0x11, 0xe8, 0xac, 0x87, 0x7b, 0x1c, 0x9a, 0x7a,
-	0x86, 0x99, 0xfa, 0xbd, 0x14, 0xcb, 0xe1, 0xc8, 0x69, 0xb6, 0x4e, 0x43, 0x0e, 0x8b, 0x0a, 0xa6,
-	0x36, 0xc0, 0xa6, 0xb0, 0xa0, 0x18, 0x85, 0xc2, 0x0b, 0x3f, 0x5c, 0x5e, 0x04, 0x9f, 0xe2, 0x88,
-	0xb5, 0xce, 0x22, 0xbb, 0xbf, 0x9f, 0xb4, 0xfb, 0xb5, 0xb4, 0x0b, 0x88, 0x3b, 0x52, 0x67, 0x91,
-	0x6f, 0x9e, 0x11, 0x50, 0xf9, 0x97, 0x04, 0xd9, 0xe7, 0x63, 0xec, 0x4d, 0x50, 0x1d, 0xc0, 0xc7,
-	0x9a, 0xa7, 0xbf, 0xea, 0x45, 0x06, 0x31, 0x9b, 0x44, 0x18, 0xef, 0x56, 0x37, 0x64, 0x54, 0x63,
-	0x42, 0xe1, 0xdd, 0xc9, 0xa7, 0xbb, 0x3b, 0x6a, 0xe8, 0x86, 0xad, 0x63, 0x66, 0xff, 0xd4, 0xd0,
-	0xe9, 0x04, 0xd5, 0xa0, 0xe0, 0x6a, 0x23, 0xec, 0x1b, 0xdf, 0x60, 0xe6, 0x01, 0x59, 0x35, 0x9c,
-	0xd3, 0xf3, 0xba, 0x8e, 0xcf, 0xf2, 0x8d, 0xac, 0xd2, 0xa1, 0xf2, 0x05, 0x40, 0xb4, 0x19, 0x54,
-	0x80, 0xe5, 0x5e, 0x53, 0x3d, 0xa8, 0x2c, 0x51, 0x1b, 0x3c, 0x6c, 0x76, 0x7b, 0xcd, 0x46, 0x45,
-	0xa2, 0xa6, 0x76, 0x50, 0xef, 0xed, 0x3e, 0xad, 0x64, 0xa8, 0xf9, 0xd5, 0xdb, 0xed, 0x8a, 0xac,
-	0x3c, 0x80, 0x72, 0x50, 0x24, 0xf8, 0xae, 0x63, 0xfb, 0x78, 0xa1, 0x73, 0x2b, 0xff, 0x90, 0x60,
-	0xe5, 0x85, 0x3b, 0x8c, 0x15, 0x16, 0xff, 0x7d, 0x3c, 0xf8, 0x0c, 0x72, 0x3e, 0xd1, 0xc8, 0xd8,
-	0x67, 0xba, 0x2a, 0xa7, 0xa4, 0x83, 0x2e, 0x5b, 0x56, 0x05, 0x1b, 0x4d, 0xa1, 0x7c, 0xd4, 0xb7,
-	0xb0, 0xef, 0x6b, 0xa3, 0x40, 0x69, 0x2b, 0x9c, 0x7a, 0xc0, 0x89, 0xe8, 0x26, 0x00, 0xf6, 0x3c,
-	0xc7, 0xeb, 0xeb, 0xce, 0x10, 0x8b, 0x00, 0x52, 0x64, 0x94, 0x5d, 0x67, 0x88, 0xd1, 0x0d, 0x28,
-	0x32, 0x73, 0x24, 0x9a, 0xe5, 0x8a, 0xac, 0x1d, 0x11, 0xa8, 0x4e, 0x82, 0xf3, 0x9d, 0x56, 0x27,
-	0x7b, 0x00, 0x2d, 0x4c, 0xce, 0xad, 0x0f, 0xe5, 0x73, 0x28, 0x31, 0x1c, 0xf1, 0xdd, 0x8f, 0x40,
-	0x7e, 0xed, 0x0c, 0x44, 0x0c, 0x98, 0xb5, 0xe1, 0x67, 0xce, 0x40, 0xa5, 0x0c, 0x4a, 0x1b, 0x3e,
-	0x68, 0x61, 0x22, 0x54, 0x15, 0x08, 0xff, 0x20, 0xd4, 0x2d, 0x97, 0xbf, 0x3d, 0xb7, 0x0c, 0x4b,
-	0xea, 0x58, 0xd9, 0x83, 0xcb, 0x21, 0xda, 0x7e, 0x23, 0xc4, 0xfb, 0x2c, 0x81, 0xb7, 0xf8, 0xae,
-	0x94, 0xef, 0x43, 0xb5, 0x85, 0x89, 0x88, 0x0b, 0x5d, 0xe2, 0xd1, 0x82, 0x2f, 0x00, 0xab, 0x42,
-	0x3e, 0xa8, 0xd3, 0xb8, 0x7a, 0x82, 0xa9, 0x72, 0x0f, 0x56, 0x5b, 0x98, 0xf4, 0xb0, 0x1f, 0xa9,
-	0x81, 0x66, 0x0d, 0xec, 0x93, 0x30, 0x4d, 0x61, 0x9f, 0x28, 0x9b, 0xb0, 0xd2, 0xc2, 0xa4, 0x6e,
-	0x9a, 0x8b, 0xaa, 0x5b, 0x65, 0x07, 0xca, 0x01, 0xa7, 0xc0, 0xdb, 0x84, 0xe5, 0xd7, 0xce, 0x80,
-	0x7e, 0x59, 0x9e, 0xab, 0x57, 0xc6, 0xa1, 0xb4, 0xa0, 0xf4, 0x54, 0x33, 0x2f, 0xe0, 0x62, 0x27,
-	0x70, 0x89, 0x03, 0x9d, 0xd2, 0xa2, 0x2e, 0xce, 0x65, 0x94, 0x7d, 0x58, 0x51, 0xb1, 0x3f, 0xb6,
-	0xce, 0xef, 0xae, 0xca, 0xcf, 0xa1, 0x1c, 0x40, 0xfd, 0x5f, 0xce, 0xd1, 0xc0, 0x26, 0xbe, 0x80,
-	0xb0, 0x43, 0x3d, 0x3c, 0x80, 0x3a, 0xad, 0x87, 0xff, 0x4d, 0x82, 0x7c, 0x90, 0x9b, 0x13, 0xe1,
-	0x43, 0x9a, 0x0a, 0x1f, 0x61, 0x51, 0x95, 0x89, 0x15, 0x55, 0x37, 0xa0, 0x68, 0x10, 0xec, 0xb1,
-	0x37, 0x86, 0x28, 0xfa, 0x23, 0x42, 0xac, 0xca, 0x9d, 0x57, 0xa7, 0x9e, 0x94, 0x5c, 0xbf, 0x5c,
-	0x94, 0x0b, 0x53, 0x2b, 0x15, 0x96, 0xf5, 0x7e, 0x29, 0x83, 0xfc, 0xcc, 0x19, 0x9c, 0xe3, 0x16,
-	0xd3, 0x9e, 0x8e, 0xf2, 0x45, 0x3c, 0x1d, 0x97, 0x4f, 0xff, 0x74, 0x8c, 0x02, 0x5d, 0xf6, 0x4c,
-	0x81, 0x6e, 0xea, 0xcd, 0x99, 0x3b, 0xd3, 0x9b, 0xf3, 0x0a, 0xe4, 0x5e, 0x3b, 0x03, 0xaa, 0x90,
-	0x3c, 0xd7, 0xea, 0x6b, 0x67, 0xb0, 0x3f, 0x44, 0xdb, 0x51, 0x5c, 0x2b, 0xcc, 0x79, 0x35, 0x89,
-	0xbb, 0x8c, 0x22, 0xde, 0x9f, 0x24, 0x58, 0x9d, 0xd2, 0x0d, 0x35, 0x22, 0x5b, 0xb3, 0xc2, 0xca,
-	0x9c, 0x8e, 0xd1, 0x06, 0x94, 0x86, 0xd8, 0xd7, 0x3d, 0xc3, 0x0d, 0x5f, 0xe8, 0x45, 0x35, 0x4e,
-	0xa2, 0x51, 0x55, 0x77, 0x6c, 0x82, 0x6d, 0xc2, 0x2e, 0xe1, 0x92, 0x1a, 0x4c, 0x69, 0x35, 0x61,
-	0x3a, 0x3a, 0xb7, 0x3f, 0x9e, 0x31, 0xc3, 0x39, 0x7a, 0x08, 0xc5, 0x63, 0x4f, 0xb3, 0xf0, 0x3b,
-	0xc7, 0x7b, 0x23, 0x54, 0x38, 0xab, 0x85, 0xbd, 0x80, 0x43, 0x8d, 0x98, 0x95, 0xdf, 0x49, 0x50,
-	0x0c, 0x17, 0x52, 0xf7, 0x5c, 0x85, 0xfc, 0x5b, 0xec, 0xf9, 0xd1, 0x7e, 0x83, 0x69, 0xf2, 0xe5,
-	0x2c, 0x4f, 0xbd, 0x9c, 0x9b, 0x50, 0xe6, 0x8b, 0x89, 0x4d, 0x97, 0xb6, 0x6f, 0xcd, 0xec, 0x6b,
-	0x9f, 0xb2, 0xb5, 0x05, 0x97, 0xba, 0x62, 0xc4, 0xa7, 0xca, 0x2f, 0x24, 0x58, 0x49, 0x30, 0x50,
-	0x3d, 0x78, 0x78, 0x64, 0xf8, 0xc4, 0x0b, 0x5c, 0x24, 0x9c, 0x53, 0x27, 0xa5, 0x7b, 0xf6, 0x5d,
-	0x4d, 0x0f, 0x7c, 0x25, 0x22, 0xa0, 0x3b, 0x70, 0x49, 0xd3, 0x75, 0xec, 0xfb, 0x7d, 0xe2, 0xbc,
-	0xc1, 0xb6, 0xd8, 0x72, 0x89, 0xd3, 0x7a, 0x94, 0x44, 0x1d, 0x0d, 0x5b, 0x9a, 0x61, 0x06, 0x85,
-	0x1c, 0x9b, 0x28, 0x7f, 0x95, 0xa0, 0x10, 0x18, 0x20, 0xbf, 0x21, 0xcb, 0xd2, 0xec, 0xc0, 0xcb,
-	0x82, 0x29, 0xda, 0x85, 0xa2, 0x87, 0x7d, 0x67, 0xec, 0xe9, 0xac, 0x88, 0x97, 0x52, 0xab, 0x6c,
-	0x55, 0x70, 0xd0, 0x10, 0x68, 0x78, 0xd8, 0xc2, 0x36, 0xf1, 0xd5, 0x48, 0x8e, 0xd6, 0x3d, 0x86,
-	0xed, 0x8e, 0x49, 0x9f, 0x5a, 0x2a, 0x7b, 0x33, 0x17, 0xd5, 0x22, 0xa3, 0x50, 0x2b, 0xa6, 0x7e,
-	0xee, 0x8c, 0x49, 0xb8, 0x2e, 0x5a, 0x0b, 0x9c, 0xc4, 0x18, 0x6e, 0x40, 0xd1, 0xf5, 0x9c, 0x63,
-	0xc3, 0xa4, 0x2e, 0x48, 0x4d, 0xa1, 0xa0, 0x46, 0x04, 0xe5, 0x5b, 0x19, 0xca, 0x49, 0x57, 0x3a,
-	0x73, 0x51, 0x80, 0x1e, 0xc0, 0x9a, 0x3f, 0x1e, 0x58, 0x86, 0x4f, 0x8d, 0xa0, 0x1f, 0x85, 0x51,
-	0xae, 0xce, 0xcb, 0xd1, 0x5a, 0x2f, 0x0c, 0xa8, 0x0f, 0x60, 0x4d, 0x77, 0x2c, 0xd7, 0xc4, 0x24,
-	0x29, 0xc2, 0xb5, 0x7c, 0x39, 0x5a, 0x8b, 0x44, 0x1e, 0x42, 0x75, 0xe8, 0xbc, 0xb3, 0x4d, 0x47,
-	0x1b, 0xf6, 0x7d, 0xa2, 0x79, 0x24, 0x26, 0xc6, 0xab, 0xc1, 0xf5, 0x60, 0xbd, 0x4b, 0x97, 0x23,
-	0xc9, 0x2f, 0xe0, 0xaa, 0xeb, 0x39, 0xec, 0x9e, 0xa7, 0x05, 0x79, 0xa1, 0x78, 0x45, 0x2c, 0x4f,
-	0xc9, 0x6d, 0xc3, 0x15, 0x16, 0x19, 0x66, 0xa4, 0xf2, 0xe2, 0x60, 0x74, 0x71, 0x4a, 0x66, 0xb6,
-	0x98, 0x2d, 0x2c, 0x2e, 0x66, 0x8b, 0x53, 0xc5, 0xac, 0xf2, 0x87, 0x0c, 0x14, 0xc3, 0x18, 0xc5,
-	0x7a, 0x4e, 0x81, 0x6d, 0x65, 0x8c, 0x61, 0x6a, 0x36, 0xfa, 0x21, 0xe4, 0x8e, 0x0d, 0x6c, 0x0e,
-	0x83, 0xae, 0xca, 0x47, 0xf3, 0x63, 0xde, 0xd6, 0x1e, 0x63, 0x14, 0x19, 0x87, 0x4b, 0xa1, 0x67,
-	0x00, 0xba, 0x63, 0xdb, 0x58, 0x17, 0x9e, 0x49, 0x31, 0xee, 0x9f, 0x80, 0xb1, 0x1b, 0x32, 0x73,
-	0x9c, 0x98, 0x34, 0xcd, 0x5e, 0xb1, 0x4f, 0x9c, 0x25, 0x7b, 0xd5, 0x1e, 0xc3, 0xea, 0x14, 0xf2,
-	0x59, 0x93, 0xdf, 0x5a, 0x9a, 0x3f, 0x51, 0x95, 0xe9, 0xae, 0xb0, 0xe8, 0x8c, 0xca, 0xc6, 0x94,
-	0x36, 0xa2, 0xb4, 0x0c, 0xa7, 0xd1, 0x31, 0x5a, 0x87, 0x9c, 0x85, 0x2d, 0xc7, 0x9b, 0x30, 0xe3,
-	0xcd, 0xa8, 0x62, 0x86, 0x76, 0xa0, 0xc4, 0x47, 0xfd, 0xb1, 0x6d, 0x10, 0x66, 0xa6, 0xe5, 0x94,
-	0x4c, 0xd6, 0x35, 0xbe, 0xc1, 0x2f, 0x6c, 0x83, 0xa8, 0xc0, 0xb9, 0xe9, 0x98, 0xc6, 0x07, 0xaa,
-	0x33, 0x6a, 0x0b, 0x59, 0x06, 0x1a, 0x4c, 0xd1, 0x23, 0xb8, 0x24, 0x86, 0x1c, 0x36, 0xb7, 0x08,
-	0xb6, 0x24, 0xd8, 0x19, 0x2e, 0x8d, 0xff, 0x58, 0xf3, 0x6c, 0xec, 0xf9, 0xcc, 0x22, 0xb3, 0x6a,
-	0x38, 0xa7, 0x79, 0xc5, 0xd7, 0x5f, 0xe1, 0xa1, 0xeb, 0x98, 0x86, 0x3e, 0x11, 0x36, 0x18, 0x27,
-	0x51, 0x69, 0xe2, 0xb8, 0x8e, 0xe9, 0x8c, 0x26, 0xc2, 0xfe, 0xc2, 0x39, 0x52, 0xe0, 0x12, 0x7d,
-	0x77, 0x1a, 0x04, 0xeb, 0x64, 0xec, 0xe1, 0x2a, 0xb0, 0xf5, 0x04, 0x0d, 0x5d, 0x83, 0xc2, 0xc8,
-	0x1d, 0xf7, 0x99, 0x21, 0x96, 0x78, 0xd8, 0x1b, 0xb9, 0x63, 0xfa, 0x54, 0x55, 0x54, 0x58, 0x9f,
-	0xae, 0x0b, 0xce, 0x5d, 0xde, 0x75, 0xe0, 0x32, 0x0b, 0x53, 0x78, 0xc8, 0xa0, 0xcf, 0x0f, 0xf8,
-	0x7b, 0x09, 0xd6, 0xe3, 0x88, 0x6d, 0x67, 0x74, 0xfe, 0xb7, 0xef, 0x3a, 0xe4, 0x8e, 0x1d, 0xd3,
-	0x74, 0xde, 0x89, 0x40, 0x2b, 0x66, 0x2c, 0x86, 0xfb, 0x61, 0x17, 0x5b, 0xe6, 0x41, 0xd8, 0xf0,
-	0x83, 0xe2, 0x93, 0x2f, 0xfb, 0x63, 0xcb, 0xd2, 0xbc, 0x09, 0x33, 0x2e, 0xb6, 0xdc, 0xe5, 0x04,
-	0xc5, 0x86, 0x5a, 0x7c, 0xa7, 0x41, 0xb1, 0x71, 0x81, 0xbb, 0x95, 0xe3, 0xbb, 0x55, 0xba, 0x70,
-	0xb5, 0x85, 0x49, 0x5b, 0xa3, 0x8f, 0xb2, 0x8b, 0xfa, 0x98, 0xf2, 0x6b, 0x89, 0x3d, 0x1d, 0xa7,
-	0x50, 0xcf, 0xfd, 0xe4, 0x88, 0x15, 0x67, 0xf2, 0x69, 0x8b, 0xb3, 0xdf, 0x4a, 0xb0, 0xc1, 0xbb,
-	0x01, 0xff, 0x13, 0xb5, 0x7e, 0x09, 0x25, 0x1b, 0xbf, 0xeb, 0x9f, 0x76, 0x5b, 0x60, 0xe3, 0x77,
-	0xc1, 0x6f, 0x14, 0x0d, 0xb8, 0x73, 0xc2, 0xc6, 0x4e, 0xfb, 0xae, 0xd9, 0x04, 0xf4, 0x64, 0x42,
-	0x70, 0x97, 0x78, 0x58, 0xb3, 0xe2, 0x2f, 0x6e, 0x56, 0x21, 0x48, 0xac, 0x8a, 0x64, 0x63, 0xfa,
-	0x30, 0xff, 0xda, 0x70, 0x5d, 0x3c, 0xa4, 0x81, 0x7d, 0xf7, 0xd5, 0xd8, 0x7e, 0x93, 0xca, 0xb6,
-	0x06, 0xa8, 0x85, 0xc9, 0x4b, 0x5e, 0xe5, 0x05, 0x1a, 0x52, 0xfe, 0x22, 0x01, 0x84, 0x95, 0xa2,
-	0x8f, 0xbe, 0x02, 0x08, 0xab, 0xc8, 0xe0, 0x1d, 0xfe, 0xf1, 0xfc, 0x9a, 0xd3, 0x8f, 0x0d, 0x45,
-	0x0a, 0x89, 0xc4, 0x6b, 0x3a, 0xac, 0x4e, 0x2d, 0xa7, 0xe4, 0x81, 0x9d, 0x64, 0x43, 0xf0, 0xee,
-	0xfc, 0x8f, 0x35, 0x30, 0xd1, 0x0c, 0xb3, 0x6d, 0xf8, 0x24, 0x9e, 0x2d, 0x7a, 0x70, 0x39, 0x85,
-	0x03, 0x3d, 0x86, 0x82, 0x28, 0x68, 0x83, 0x63, 0xdc, 0x59, 0x84, 0xec, 0xab, 0xa1, 0x88, 0xf2,
-	0x14, 0x2a, 0xd3, 0xab, 0xf1, 0x92, 0x59, 0x4a, 0x96, 0xcc, 0x35, 0x28, 0xe0, 0xf7, 0x04, 0x7b,
-	0xb6, 0x66, 0xb2, 0x63, 0x14, 0xd4, 0x70, 0x7e, 0xff, 0x13, 0x28, 0x04, 0x91, 0x1f, 0xe5, 0x20,
-	0x73, 0xf0, 0xa4, 0xb2, 0x84, 0xf2, 0x20, 0x1f, 0x18, 0x4f, 0x2a, 0x12, 0x25, 0xb4, 0x9e, 0xf0,
-	0xb6, 0x5f, 0xcb, 0x78, 0x52, 0x91, 0xef, 0x7f, 0x2b, 0x41, 0x4e, 0x54, 0x70, 0xab, 0x50, 0x3a,
-	0xec, 0xf4, 0xfa, 0xdd, 0x5e, 0x5d, 0xed, 0x35, 0x1b, 0x95, 0x25, 0x54, 0x82, 0xfc, 0x51, 0xf3,
-	0xb0, 0xc1, 0xfb, 0xd4, 0x00, 0xb9, 0xa7, 0xf5, 0x36, 0x5d, 0xc8, 0xd2, 0xf1, 0x5e, 0x7d, 0xbf,
-	0xdd, 0x6c, 0x54, 0x80, 0x8e, 0x1b, 0xcd, 0xa3, 0x76, 0xe7, 0x27, 0x95, 0x35, 0x8a, 0xd0, 0xe8,
-	0xfc, 0xe8, 0xb0, 0xdd, 0xa9, 0x33, 0xa1, 0x5b, 0xa8, 0x0c, 0x70, 0xa4, 0x76, 0x76, 0x9b, 0xdd,
-	0x2e, 0x9d, 0x6f, 0x52, 0xc4, 0x6e, 0xaf, 0xc3, 0x3a, 0xdf, 0xdb, 0x68, 0x05, 0x8a, 0xbb, 0x9d,
-	0x83, 0xa3, 0x76, 0x93, 0x82, 0x3e, 0xa2, 0x40, 0xcf, 0x5f, 0x34, 0x5f, 0x34, 0x1b, 0x95, 0xbd,
-	0xed, 0xbf, 0x17, 0x21, 0xcf, 0x8d, 0xd9, 0x43, 0x2f, 0xe1, 0x03, 0xde, 0x98, 0x0c, 0x0a, 0x4e,
-	0xfa, 0x4e, 0x9d, 0x2d, 0xff, 0x13, 0xbf, 0x70, 0xd6, 0x6e, 0xcf, 0x5d, 0xe7, 0x76, 0xad, 0x2c,
-	0xa1, 0x03, 0xd6, 0x0d, 0x8a, 0x83, 0x5e, 0x9f, 0x11, 0x8a, 0x5a, 0x79, 0xb5, 0x1b, 0xe9, 0x8b,
-	0x21, 0xdc, 0x8f, 0x59, 0xaf, 0xac, 0x6e, 0x9a, 0x01, 0xa2, 0xff, 0xcc, 0x19, 0xf8, 0x29, 0x1b,
-	0x4d, 0x34, 0xab, 0x52, 0x36, 0x9a, 0x6c, 0x51, 0x29, 0x4b, 0x54, 0x01, 0xbc, 0x47, 0x71, 0xb2,
-	0x02, 0x12, 0x2d, 0x91, 0x14, 0xdc, 0x64, 0x9f, 0x43, 0x59, 0x42, 0x47, 0xb0, 0xfa, 0x54, 0x33,
-	0x13, 0x1a, 0x98, 0x3d, 0x64, 0xac, 0xe9, 0x55, 0xbb, 0x39, 0x67, 0x35, 0x44, 0xd4, 0x99, 0xc7,
-	0x4f, 0xbf, 0x60, 0xbf, 0xb3, 0xf0, 0xfd, 0x2f, 0xf0, 0x67, 0x1b, 0x05, 0x53, 0x61, 0x46, 0x59,
-	0xfa, 0xae, 0x84, 0x7e, 0xca, 0xdb, 0x82, 0xb1, 0x50, 0x87, 0xee, 0xa6, 0xbf, 0xf3, 0x93, 0x59,
-	0xff, 0x94, 0xf0, 0x23, 0x76, 0x8f, 0x53, 0x39, 0xde, 0x4f, 0x39, 0x44, 0x7a, 0x19, 0x50, 0xfb,
-	0x70, 0x86, 0x71, 0x36, 0xaa, 0xb2, 0x0f, 0xb5, 0xa2, 0x73, 0x18, 0xf6, 0x88, 0x7d, 0x64, 0x3d,
-	0xfd, 0xd7, 0x85, 0xda, 0x6c, 0x1a, 0x10, 0xbf, 0x7c, 0x31, 0xa0, 0x76, 0xb4, 0x63, 0xc3, 0x1e,
-	0x85, 0xbf, 0x1b, 0xcd, 0x03, 0xbb, 0x36, 0xf7, 0x17, 0x1b, 0x86, 0xf6, 0x9c, 0x35, 0x9e, 0x83,
-	0xa8, 0x8d, 0x3e, 0x4c, 0xb3, 0xcf, 0xa9, 0x98, 0x5e, 0xbb, 0x7e, 0x42, 0xc0, 0x56, 0x96, 0xd0,
-	0xd7, 0x89, 0x0d, 0x06, 0xed, 0xe4, 0x93, 0xdd, 0xed, 0x6e, 0xda, 0xe2, 0x74, 0x27, 0x9a, 0x3b,
-	0x47, 0x2c, 0xf7, 0xcd, 0x75, 0x8e, 0xc4, 0xcf, 0x14, 0x29, 0xce, 0x91, 0x6c, 0xf3, 0x73, 0x5c,
-	0xde, 0xe0, 0x3c, 0x19, 0x37, 0xd1, 0x4f, 0x4d, 0xc1, 0x4d, 0x36, 0x49, 0x95, 0xa5, 0x41, 0x8e,
-	0xfd, 0x49, 0xe3, 0x7b, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x16, 0xcf, 0xb3, 0xb5, 0x21,
-	0x00, 0x00,
+	// 4381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x4d, 0x93, 0x1b, 0xc9,
+	0x52, 0x6e, 0x7d, 0x8d, 0x94, 0x1a, 0x69, 0xe4, 0xb2, 0x3d, 0xab, 0xd5, 0xda, 0x1e, 0xbb, 0xd7,
+	0xf6, 0xce, 0x7a, 0xdf, 0x9b, 0x87, 0x67, 0xbf, 0xbc, 0x66, 0xcd, 0xc6, 0x7c, 0x68, 0xc6, 0x63,
+	0x6b, 0x3e, 0xdc, 0x92, 0x77, 0xdf, 0x5b, 0x20, 0x44, 0x4b, 0x5d, 0xa3, 0x69, 0x5b, 0xea, 0x16,
+	0xdd, 0x25, 0x7b, 0xb4, 0x9c, 0xe0, 0x40, 0x10, 0x5c, 0x21, 0x82, 0x13, 0x11, 0x1c, 0xe1, 0x44,
+	0x70, 0x80, 0x23, 0x1c, 0x08, 0x22, 0xde, 0x81, 0x03, 0xc1, 0x0f, 0x78, 0x11, 0x04, 0x77, 0x6e,
+	0x44, 0xc0, 0x85, 0x20, 0xb2, 0xaa, 0xfa, 0x4b, 0xea, 0x1e, 0x69, 0x76, 0x06, 0x6e, 0x5d, 0x59,
+	0x99, 0x59, 0x55, 0x59, 0x59, 0x99, 0x59, 0x59, 0xd9, 0x50, 0x62, 0x8e, 0x6e, 0x5a, 0xd4, 0x59,
+	0x1b, 0x3a, 0x36, 0xb3, 0xc9, 0x52, 0xcf, 0x19, 0x76, 0xd7, 0x3c, 0xd8, 0xdb, 0x75, 0xf5, 0x7f,
+	0xd2, 0x50, 0xda, 0x72, 0xa8, 0xce, 0xa8, 0x46, 0x7f, 0x77, 0x44, 0x5d, 0x46, 0xde, 0x83, 0x85,
+	0x91, 0x4b, 0x9d, 0xb6, 0x69, 0x54, 0x95, 0x3b, 0xca, 0x6a, 0x41, 0xcb, 0x61, 0x73, 0xcf, 0x20,
+	0x2f, 0xa0, 0x32, 0xb0, 0x0d, 0xda, 0x6f, 0x1b, 0xf4, 0xd8, 0xb4, 0x4c, 0x66, 0xda, 0x56, 0x35,
+	0x75, 0x47, 0x59, 0x2d, 0xae, 0xdf, 0x59, 0x9b, 0x60, 0xbb, 0xb6, 0x8f, 0x88, 0xdb, 0x3e, 0x9e,
+	0xb6, 0x34, 0x88, 0x02, 0xc8, 0xe7, 0x90, 0xe7, 0xe8, 0xa6, 0xd5, 0xab, 0xa6, 0x39, 0x93, 0xf7,
+	0xa7, 0x98, 0xb4, 0x24, 0x82, 0xe6, 0xa3, 0x92, 0x27, 0x00, 0x86, 0xce, 0x74, 0x97, 0xd9, 0x0e,
+	0x75, 0xab, 0x99, 0x3b, 0xe9, 0xd5, 0xe2, 0x7a, 0x6d, 0x8a, 0x70, 0xdb, 0x43, 0xd1, 0x42, 0xd8,
+	0xe4, 0x08, 0x08, 0x7d, 0xab, 0xf7, 0x47, 0x3a, 0x4e, 0xa0, 0x3d, 0xa0, 0xcc, 0x31, 0xbb, 0x6e,
+	0x35, 0xcb, 0x07, 0xbf, 0x3b, 0xc5, 0xa3, 0xbe, 0x5f, 0x3f, 0x65, 0x8e, 0xde, 0x45, 0xe4, 0xe6,
+	0x90, 0x76, 0xb5, 0xab, 0x01, 0xf1, 0xbe, 0xa0, 0x25, 0x35, 0xc8, 0x0f, 0x1d, 0xd3, 0x76, 0x4c,
+	0x36, 0xae, 0xe6, 0xee, 0x28, 0xab, 0x59, 0xcd, 0x6f, 0x93, 0x4d, 0xc8, 0xf5, 0xf5, 0x0e, 0xed,
+	0xbb, 0xd5, 0x05, 0x3e, 0xcb, 0x87, 0x53, 0x23, 0x44, 0xc4, 0xbe, 0xd6, 0xe0, 0xc8, 0x75, 0x8b,
+	0x39, 0x63, 0x4d, 0x52, 0x92, 0x8f, 0x60, 0xc9, 0x34, 0xe8, 0x60, 0x68, 0x33, 0x6a, 0x75, 0xc7,
+	0xed, 0x37, 0x74, 0x5c, 0xcd, 0xf3, 0x2d, 0x29, 0x87, 0xc0, 0x2f, 0xe8, 0xb8, 0xf6, 0x15, 0x14,
+	0x43, 0xf4, 0xa4, 0x02, 0x69, 0xc4, 0x15, 0xdb, 0x87, 0x9f, 0xe4, 0x3a, 0x64, 0x71, 0xf6, 0x94,
+	0x6f, 0x58, 0x41, 0x13, 0x8d, 0x27, 0xa9, 0xc7, 0x8a, 0xfa, 0x77, 0x29, 0xa8, 0x4c, 0xae, 0x95,
+	0x10, 0xc8, 0xb0, 0xf1, 0x90, 0x4a, 0x0e, 0xfc, 0x9b, 0x7c, 0x00, 0x05, 0x73, 0xa0, 0xf7, 0x68,
+	0x9b, 0xe9, 0x3d, 0xbe, 0xda, 0x82, 0x96, 0xe7, 0x80, 0x96, 0xde, 0x23, 0x65, 0x48, 0x99, 0x96,
+	0x64, 0x9e, 0x32, 0x2d, 0x72, 0x1f, 0xca, 0x7d, 0xd3, 0xa2, 0xed, 0xbe, 0x6d, 0xbf, 0xd1, 0x4f,
+	0xa8, 0x6e, 0xf0, 0x4d, 0xce, 0x6a, 0x25, 0x84, 0x36, 0x3c, 0x20, 0xb9, 0x0d, 0x40, 0xdf, 0x52,
+	0x8b, 0xb5, 0xc6, 0x43, 0xb9, 0x9d, 0x05, 0x2d, 0x04, 0x21, 0x75, 0xc8, 0xf5, 0x1c, 0x7b, 0x34,
+	0xc4, 0x6d, 0x42, 0x21, 0xfe, 0x74, 0xe6, 0x36, 0xad, 0xed, 0x72, 0x7c, 0x29, 0x47, 0x41, 0x5c,
+	0x6b, 0x42, 0x31, 0x04, 0x8e, 0x11, 0xcf, 0x5a, 0x58, 0x3c, 0xc5, 0xf5, 0x6a, 0xcc, 0x30, 0x9c,
+	0x41, 0x58, 0x70, 0xff, 0x91, 0x82, 0x05, 0x09, 0x46, 0xf1, 0x3a, 0xb4, 0x47, 0x4f, 0x25, 0x4f,
+	0xd1, 0x20, 0x9f, 0x40, 0x66, 0x40, 0x99, 0x2e, 0x99, 0xbe, 0x17, 0xc3, 0x74, 0x9f, 0x32, 0x5d,
+	0xe3, 0x48, 0xe4, 0x6b, 0xc8, 0x71, 0xde, 0x6e, 0x35, 0xcd, 0x97, 0x7a, 0x2f, 0x69, 0x0e, 0x6b,
+	0xdf, 0x72, 0x34, 0xb9, 0x42, 0x41, 0x83, 0xd4, 0x94, 0x99, 0x03, 0xff, 0x4c, 0x24, 0x53, 0xd7,
+	0x39, 0x9a, 0xa4, 0x16, 0x34, 0xb5, 0x97, 0x50, 0x0c, 0x31, 0x8d, 0x91, 0xcf, 0x4f, 0xa2, 0xf2,
+	0x59, 0x8e, 0xe1, 0xbe, 0x61, 0x8d, 0x43, 0xd2, 0x41, 0x96, 0xa1, 0x91, 0x2e, 0x83, 0xa5, 0xba,
+	0x0e, 0x39, 0x21, 0x31, 0xae, 0x9e, 0xe6, 0x80, 0x56, 0xd3, 0x52, 0x3d, 0xcd, 0x01, 0xc5, 0x2d,
+	0x70, 0x47, 0x1d, 0xd3, 0xe0, 0x07, 0xba, 0xa0, 0x89, 0x86, 0xfa, 0x08, 0xb2, 0x9c, 0x4f, 0xac,
+	0x46, 0xc7, 0x1e, 0x0a, 0xf5, 0x0f, 0x15, 0xc8, 0xe3, 0x28, 0x7b, 0xd6, 0xb1, 0x4d, 0x56, 0xa0,
+	0xe8, 0xd9, 0x9e, 0xc0, 0x20, 0x82, 0x07, 0xda, 0x33, 0xc2, 0xd6, 0x32, 0x15, 0xb1, 0x96, 0xe1,
+	0x39, 0xa6, 0xe5, 0x1c, 0x97, 0x21, 0xe7, 0x98, 0x96, 0x41, 0x4f, 0xab, 0x19, 0x0e, 0x95, 0xad,
+	0x84, 0xb9, 0x37, 0x60, 0xa1, 0x61, 0xf7, 0x1a, 0xa6, 0x45, 0xc9, 0x4f, 0xa5, 0x26, 0x29, 0x09,
+	0x96, 0xd2, 0x9b, 0xaf, 0xd4, 0x25, 0x02, 0x19, 0x3c, 0x67, 0x72, 0x46, 0xfc, 0x5b, 0xfd, 0x63,
+	0x05, 0xd2, 0x28, 0x88, 0x47, 0x21, 0x41, 0x94, 0xd7, 0x6f, 0x4d, 0xb1, 0xda, 0xb0, 0xc6, 0xdc,
+	0x7e, 0xe2, 0x01, 0x3c, 0x53, 0x4e, 0x4f, 0x20, 0xef, 0xe1, 0x11, 0x80, 0x5c, 0xb3, 0xa5, 0xed,
+	0x1d, 0xec, 0x56, 0xae, 0x90, 0x32, 0xc0, 0xf3, 0xe6, 0xe1, 0x81, 0x6c, 0x2b, 0x64, 0x01, 0xd2,
+	0x7b, 0x07, 0xad, 0x4a, 0x8a, 0x14, 0x20, 0xbb, 0xd3, 0x38, 0xdc, 0x68, 0x55, 0xd2, 0xea, 0x7f,
+	0xa7, 0x20, 0x5f, 0xf7, 0xac, 0xe8, 0x39, 0x17, 0xf7, 0xd4, 0x57, 0xf5, 0x14, 0x57, 0xf5, 0xfb,
+	0x31, 0x9a, 0x23, 0x38, 0xc7, 0xe9, 0x3a, 0x9a, 0x1c, 0x6e, 0x15, 0xb8, 0x89, 0x95, 0x1a, 0x14,
+	0x82, 0x20, 0x7b, 0x79, 0x0e, 0x33, 0xb3, 0xd8, 0xc7, 0x1c, 0xc4, 0xda, 0xe1, 0x2c, 0xbd, 0x7f,
+	0x18, 0xd5, 0xfb, 0xeb, 0x71, 0x1b, 0x10, 0x3e, 0x48, 0x87, 0xb3, 0xce, 0xe6, 0x39, 0x19, 0xaa,
+	0xff, 0xa9, 0x40, 0xf6, 0xe5, 0x88, 0x3a, 0x63, 0xb2, 0x01, 0xe0, 0x52, 0xdd, 0xe9, 0x9e, 0xb4,
+	0x02, 0x85, 0x98, 0x76, 0x84, 0x1c, 0x77, 0xad, 0xe9, 0x23, 0x6a, 0x21, 0x22, 0x7f, 0xef, 0xd2,
+	0xf3, 0xed, 0x1d, 0x2a, 0xba, 0x69, 0x75, 0x69, 0x35, 0x23, 0x15, 0x1d, 0x1b, 0xdc, 0x8d, 0xea,
+	0x3d, 0xea, 0x9a, 0x3f, 0xd0, 0x6a, 0x56, 0xba, 0x51, 0xd9, 0xc6, 0xf5, 0x0e, 0x6d, 0x97, 0xfb,
+	0x9b, 0xb4, 0x86, 0x9f, 0xea, 0x17, 0x00, 0xc1, 0x64, 0x48, 0x1e, 0x32, 0xad, 0xba, 0xb6, 0x5f,
+	0xb9, 0x82, 0x3a, 0x78, 0x50, 0x6f, 0xb6, 0xea, 0xdb, 0x15, 0x05, 0x55, 0x6d, 0x7f, 0xa3, 0xb5,
+	0xf5, 0xac, 0x92, 0x42, 0xf5, 0xdb, 0x68, 0x34, 0x2a, 0x69, 0xf5, 0x11, 0x94, 0x3d, 0x8f, 0xeb,
+	0x0e, 0x6d, 0xcb, 0xa5, 0x33, 0x0f, 0xb7, 0xfa, 0x2b, 0x05, 0x4a, 0xaf, 0x86, 0x46, 0x28, 0x38,
+	0xfa, 0xf1, 0xf6, 0xe0, 0x67, 0x90, 0x73, 0x99, 0xce, 0x46, 0x2e, 0x97, 0x55, 0x39, 0xc6, 0x1d,
+	0x34, 0x79, 0xb7, 0x26, 0xd1, 0xd0, 0x85, 0x8a, 0xaf, 0xf6, 0x80, 0xba, 0xae, 0xde, 0xf3, 0x84,
+	0x56, 0x12, 0xd0, 0x7d, 0x01, 0x24, 0xb7, 0x00, 0xa8, 0xe3, 0xd8, 0x4e, 0xbb, 0x6b, 0x1b, 0x54,
+	0x1a, 0x90, 0x02, 0x87, 0x6c, 0xd9, 0x06, 0x25, 0x37, 0xa1, 0xc0, 0xd5, 0x91, 0xe9, 0x83, 0xa1,
+	0xf4, 0xda, 0x01, 0x00, 0x65, 0xe2, 0xad, 0x6f, 0x5e, 0x99, 0x1c, 0xc0, 0xd2, 0x91, 0x8c, 0x71,
+	0xe6, 0x16, 0x4a, 0x38, 0x4e, 0x4a, 0x45, 0xe3, 0x24, 0xf5, 0x10, 0x2a, 0x01, 0xbf, 0x39, 0x27,
+	0x71, 0x26, 0xc3, 0x8f, 0x60, 0xf1, 0x68, 0xe4, 0xf4, 0xc2, 0xf1, 0xac, 0xe1, 0x8c, 0xdb, 0xce,
+	0xc8, 0xe2, 0x8c, 0xf2, 0x5a, 0xce, 0x70, 0xc6, 0xda, 0xc8, 0x52, 0x0f, 0xa0, 0x24, 0x11, 0xe5,
+	0xb0, 0x4f, 0xa1, 0xe0, 0x8d, 0xe1, 0x56, 0x15, 0x7e, 0xfa, 0x57, 0xa6, 0x76, 0x89, 0x93, 0x18,
+	0x7e, 0x68, 0x1a, 0x50, 0xa8, 0x1d, 0x28, 0x47, 0x3b, 0x2f, 0xa0, 0x2d, 0xe8, 0x29, 0xa8, 0xee,
+	0xda, 0x96, 0xb4, 0x50, 0xb2, 0xa5, 0xfe, 0x52, 0x81, 0xc5, 0x57, 0xae, 0x1e, 0x59, 0x5d, 0x7c,
+	0xb4, 0x8e, 0x11, 0x18, 0x1a, 0xb4, 0xb6, 0x4b, 0xfb, 0xb4, 0xcb, 0x6c, 0x47, 0x8e, 0x50, 0xe2,
+	0xd0, 0xa6, 0x04, 0x62, 0x88, 0xd9, 0xb5, 0x07, 0xc3, 0x3e, 0x65, 0xd4, 0x68, 0xeb, 0xc7, 0x8c,
+	0x3a, 0xd2, 0x63, 0x95, 0x7d, 0xf0, 0x06, 0x42, 0xc9, 0xc7, 0x50, 0x09, 0x10, 0x3b, 0xf4, 0xd8,
+	0x76, 0xa8, 0xf4, 0x62, 0x01, 0x83, 0x4d, 0x0e, 0x26, 0xf7, 0xa0, 0xcc, 0x0d, 0x6a, 0xbb, 0x33,
+	0x6e, 0x0b, 0x33, 0x2b, 0xd4, 0x72, 0x91, 0x43, 0x37, 0xc7, 0x3c, 0x54, 0x55, 0x77, 0xa0, 0x24,
+	0x57, 0x22, 0xc5, 0xff, 0x39, 0x2c, 0x50, 0x8b, 0x39, 0x26, 0xf5, 0x84, 0xff, 0xc1, 0x94, 0xf0,
+	0x39, 0x81, 0x30, 0xb8, 0x1e, 0xae, 0xea, 0x00, 0x04, 0xe0, 0x18, 0xfb, 0x78, 0x33, 0xbc, 0xab,
+	0x42, 0x59, 0x02, 0x00, 0xf9, 0x0c, 0xb2, 0x23, 0x7e, 0xb8, 0x84, 0x05, 0xbb, 0x3d, 0x35, 0xa4,
+	0x46, 0x5d, 0x7b, 0xe4, 0x74, 0xa9, 0x98, 0xab, 0x40, 0x56, 0xfb, 0x50, 0x8a, 0xc0, 0x31, 0x38,
+	0xee, 0x0d, 0x47, 0xed, 0x13, 0x7b, 0xe4, 0xb8, 0x7c, 0x70, 0x45, 0xcb, 0xf7, 0x86, 0xa3, 0x67,
+	0xd8, 0xc6, 0xce, 0xae, 0xdf, 0x99, 0x12, 0x9d, 0x5d, 0xaf, 0xf3, 0x2e, 0x2c, 0x0e, 0xe8, 0xc0,
+	0x76, 0xc6, 0xb2, 0x3f, 0xcd, 0xfb, 0x8b, 0x02, 0xc6, 0x51, 0xd4, 0x17, 0x50, 0xd6, 0xa8, 0x65,
+	0x50, 0xc7, 0x17, 0xd5, 0x57, 0xb0, 0x60, 0x77, 0x5e, 0xd3, 0x2e, 0x4b, 0xd6, 0x53, 0x41, 0x41,
+	0x8d, 0x43, 0x8e, 0xa7, 0x79, 0xf8, 0x6a, 0x0b, 0xca, 0xd1, 0x2e, 0x8c, 0x16, 0xde, 0x98, 0x96,
+	0xa7, 0x3f, 0xfc, 0x1b, 0x61, 0x96, 0x3e, 0xf0, 0x23, 0x08, 0xfc, 0xc6, 0x43, 0x37, 0xd0, 0x2d,
+	0xf3, 0x98, 0xba, 0x4c, 0x6a, 0xa5, 0xdf, 0x56, 0x77, 0x00, 0x76, 0x29, 0xbb, 0xb0, 0x95, 0x54,
+	0x3f, 0x87, 0x22, 0xe7, 0x23, 0xd7, 0xf9, 0x00, 0xd2, 0xaf, 0xed, 0x4e, 0x55, 0x49, 0xf0, 0x6c,
+	0xcf, 0xed, 0x8e, 0x86, 0x08, 0x6a, 0x03, 0xae, 0xee, 0x52, 0x26, 0x0d, 0xa8, 0x47, 0xfc, 0xa5,
+	0x6f, 0x71, 0x05, 0xfd, 0x4a, 0xe2, 0x05, 0x33, 0x6a, 0x79, 0xd5, 0x1d, 0xb8, 0xe6, 0x73, 0xdb,
+	0xdb, 0xf6, 0xf9, 0xfd, 0x2c, 0xc2, 0x6f, 0xb6, 0x05, 0x57, 0x3f, 0x83, 0xea, 0x2e, 0x65, 0x32,
+	0x5a, 0x68, 0x32, 0x07, 0xed, 0x85, 0xc7, 0xac, 0x0a, 0x0b, 0xde, 0x0d, 0x54, 0x88, 0xc7, 0x6b,
+	0xaa, 0xf7, 0x61, 0x69, 0x97, 0xb2, 0x16, 0x75, 0x03, 0x31, 0x60, 0x2c, 0x89, 0x52, 0xf7, 0x82,
+	0x57, 0x94, 0xf8, 0xbf, 0xa7, 0xa0, 0xb4, 0x4b, 0xd9, 0x46, 0xbf, 0x3f, 0xd3, 0x14, 0x04, 0x13,
+	0xc7, 0x88, 0x69, 0x0e, 0xd7, 0x73, 0x13, 0x0a, 0xc7, 0x8e, 0x3e, 0xa0, 0xef, 0x6c, 0xe7, 0x8d,
+	0xdc, 0xea, 0x00, 0x80, 0xbb, 0x8b, 0xfa, 0xd0, 0x1e, 0x3a, 0xf4, 0xd8, 0x3c, 0x95, 0x5e, 0x09,
+	0x10, 0x74, 0xc4, 0x21, 0x68, 0x53, 0xdc, 0x51, 0x67, 0x60, 0xb2, 0xc0, 0xa6, 0x64, 0x85, 0x4d,
+	0xf1, 0xc1, 0xbe, 0x4d, 0x09, 0x10, 0xa5, 0x4d, 0x11, 0x9e, 0x3e, 0x60, 0x20, 0x6d, 0x0a, 0x81,
+	0x8c, 0x6b, 0x3b, 0xac, 0xba, 0x20, 0x44, 0x80, 0xdf, 0x78, 0xae, 0x30, 0x4e, 0x68, 0xf3, 0xc0,
+	0x21, 0x1f, 0x04, 0x0e, 0x4d, 0x0c, 0x1c, 0x6e, 0x01, 0xf0, 0x4e, 0x66, 0xbf, 0xa1, 0x56, 0xb5,
+	0x20, 0x16, 0x81, 0x90, 0x16, 0x02, 0x62, 0xcc, 0x23, 0xc4, 0x98, 0x47, 0xb4, 0xe9, 0x9e, 0x90,
+	0xe5, 0x5e, 0xac, 0x42, 0xe6, 0xb5, 0xdd, 0xf1, 0xce, 0x5d, 0xbc, 0x4e, 0x72, 0x0c, 0xf2, 0x00,
+	0x96, 0x2c, 0x7a, 0xca, 0xda, 0xa1, 0x69, 0x48, 0x13, 0x8c, 0xe0, 0x23, 0x6f, 0x2a, 0xea, 0x2e,
+	0x14, 0x9f, 0xe9, 0xfd, 0x4b, 0x38, 0x3c, 0x63, 0x58, 0x14, 0x8c, 0xe6, 0x75, 0xa3, 0x97, 0x16,
+	0xac, 0xa8, 0xff, 0xac, 0x70, 0x8b, 0x38, 0x1a, 0x5c, 0x42, 0xa4, 0x14, 0xcd, 0xf1, 0xa4, 0xcf,
+	0x95, 0xe3, 0xa9, 0x43, 0x59, 0x24, 0x29, 0xfa, 0x76, 0x97, 0xa7, 0x6a, 0xaa, 0x99, 0x04, 0xbb,
+	0xbe, 0x87, 0x68, 0x0d, 0x89, 0xa5, 0x95, 0xcc, 0x70, 0x53, 0xfd, 0x3d, 0x28, 0x7b, 0xab, 0xf9,
+	0xff, 0x97, 0xe5, 0x2f, 0x15, 0x28, 0x89, 0x6c, 0xce, 0xc5, 0x65, 0x19, 0x64, 0xa1, 0xd2, 0x09,
+	0x59, 0xa8, 0xc8, 0x48, 0x71, 0x59, 0xa8, 0x8b, 0x24, 0x97, 0xfe, 0x46, 0x81, 0xb2, 0x37, 0xc0,
+	0xbc, 0x82, 0xdc, 0xf2, 0xa7, 0x2c, 0xee, 0x77, 0x9f, 0x24, 0x4e, 0x59, 0x70, 0xbc, 0xec, 0x39,
+	0xef, 0x43, 0x71, 0x73, 0xd4, 0x7f, 0x73, 0x49, 0x01, 0x96, 0x5a, 0x87, 0x45, 0xc1, 0x2e, 0x88,
+	0x72, 0x1c, 0xea, 0x8e, 0xfa, 0x2c, 0x39, 0xca, 0x91, 0xf8, 0xa3, 0x3e, 0xd3, 0x3c, 0x5c, 0x75,
+	0x0b, 0x20, 0x00, 0xcf, 0x16, 0xe2, 0x75, 0xc8, 0xf2, 0x3b, 0x80, 0xb7, 0x3c, 0xde, 0x50, 0xff,
+	0x49, 0x01, 0x22, 0x94, 0xed, 0x99, 0x89, 0x07, 0x66, 0x2c, 0xa4, 0x73, 0x5e, 0xc7, 0x16, 0xbd,
+	0x54, 0xa4, 0x26, 0x2e, 0x15, 0x18, 0x27, 0x18, 0x23, 0x47, 0x9c, 0x3e, 0x11, 0x4b, 0xfa, 0xed,
+	0xcb, 0xb9, 0xd4, 0xa8, 0xef, 0xe0, 0x46, 0x64, 0x19, 0xf3, 0x2b, 0xd7, 0xd3, 0x20, 0xc6, 0x14,
+	0xda, 0xf5, 0x61, 0xc2, 0x5a, 0xc3, 0x02, 0x0a, 0x62, 0xcd, 0x8f, 0x60, 0xf1, 0x3b, 0x9d, 0x75,
+	0x4f, 0x66, 0x29, 0x87, 0xfa, 0x8f, 0x0a, 0x14, 0x05, 0xa3, 0x3a, 0x66, 0x33, 0x67, 0x4f, 0x2c,
+	0xec, 0xa3, 0xcf, 0xbf, 0x07, 0xe9, 0xc9, 0x3d, 0xb8, 0x1c, 0x39, 0xef, 0x41, 0x69, 0x9b, 0x62,
+	0x64, 0x7f, 0x71, 0xdf, 0xf4, 0x08, 0xca, 0x1e, 0xab, 0x79, 0x6f, 0x9a, 0xff, 0xaa, 0xc0, 0x82,
+	0x97, 0x23, 0x8a, 0xac, 0x56, 0x99, 0x5c, 0xad, 0x97, 0xdc, 0x4b, 0x85, 0x92, 0x7b, 0x37, 0xa1,
+	0x60, 0x32, 0x1a, 0x52, 0xc3, 0xac, 0x16, 0x00, 0xc8, 0xd7, 0x13, 0x59, 0x9e, 0x7b, 0x71, 0x99,
+	0x8b, 0xc4, 0x24, 0xcf, 0x57, 0xb3, 0x72, 0x32, 0xc9, 0xd6, 0xe5, 0x4f, 0x33, 0x90, 0x7e, 0x6e,
+	0x77, 0x2e, 0x60, 0xd2, 0xe3, 0x9e, 0x61, 0xd2, 0x97, 0xf1, 0x0c, 0x93, 0x99, 0xff, 0x19, 0x26,
+	0x08, 0xad, 0xb3, 0xe7, 0x0a, 0xad, 0x27, 0x7c, 0x7b, 0xee, 0x5c, 0xbe, 0xfd, 0x06, 0xe4, 0x5e,
+	0xdb, 0x1d, 0x14, 0x88, 0x08, 0x02, 0xb3, 0xaf, 0xed, 0xce, 0x9e, 0x41, 0xd6, 0x83, 0x48, 0x3a,
+	0x9f, 0x90, 0xbd, 0x97, 0x7b, 0xe9, 0xc7, 0xd8, 0x91, 0xfc, 0x41, 0x61, 0xe2, 0xe1, 0xe6, 0xb1,
+	0xef, 0x7f, 0xe0, 0x4e, 0x3a, 0x56, 0xaa, 0xcf, 0xed, 0xce, 0x65, 0x3b, 0x9d, 0xff, 0x52, 0x60,
+	0x69, 0x62, 0xb3, 0xfc, 0x3b, 0x98, 0x12, 0xba, 0x83, 0xdd, 0x81, 0xa2, 0x41, 0xdd, 0xae, 0x63,
+	0x0e, 0xfd, 0xe7, 0xb7, 0x82, 0x16, 0x06, 0xe1, 0xc5, 0xa2, 0x6b, 0x5b, 0x8c, 0x5a, 0xe2, 0x92,
+	0xb6, 0xa8, 0x79, 0x4d, 0x5c, 0x74, 0x24, 0x2a, 0x2a, 0x68, 0x7e, 0x9b, 0x3c, 0x0e, 0x47, 0xfc,
+	0x62, 0x4f, 0xa7, 0xb7, 0x65, 0xc7, 0xc3, 0x08, 0xdf, 0x06, 0x96, 0x21, 0x27, 0x2e, 0xc2, 0x32,
+	0xbb, 0x24, 0x5b, 0xfc, 0x12, 0xc0, 0xbf, 0xda, 0x0e, 0x7d, 0x6b, 0xba, 0x38, 0xa8, 0xd8, 0xb6,
+	0xb2, 0x00, 0x6b, 0x12, 0xaa, 0xfe, 0xb9, 0x02, 0x05, 0x9f, 0x73, 0xec, 0xa2, 0xab, 0xb0, 0xf0,
+	0x96, 0x3a, 0x6e, 0xb0, 0x60, 0xaf, 0x19, 0x7d, 0x93, 0x4a, 0x4f, 0xbc, 0x49, 0x5d, 0x52, 0x2c,
+	0xf8, 0x07, 0x0a, 0x94, 0x22, 0x08, 0x28, 0x48, 0x87, 0xf6, 0x4c, 0x97, 0x39, 0xde, 0xee, 0xfa,
+	0x6d, 0x34, 0x3b, 0x38, 0x67, 0x77, 0xa8, 0x77, 0xbd, 0x6d, 0x0e, 0x00, 0x78, 0xd9, 0xd7, 0xbb,
+	0x5d, 0xea, 0xba, 0xf2, 0x3e, 0x20, 0xa6, 0x5c, 0x14, 0x30, 0x71, 0x31, 0x41, 0xcf, 0x3d, 0xd0,
+	0xcd, 0xbe, 0x97, 0x22, 0xe5, 0x0d, 0xf5, 0x57, 0x19, 0xc8, 0xfb, 0x69, 0x25, 0xbe, 0xc5, 0x83,
+	0x81, 0xee, 0xdf, 0xd9, 0xbd, 0x26, 0xd9, 0x82, 0x82, 0x23, 0xf3, 0x12, 0xae, 0xcc, 0x07, 0xdf,
+	0x4f, 0xcc, 0x68, 0xa0, 0x51, 0x37, 0x1d, 0x3a, 0xa0, 0x16, 0x73, 0xb5, 0x80, 0x0e, 0x9d, 0x82,
+	0x69, 0x0d, 0x47, 0xac, 0x8d, 0x67, 0x8f, 0xc7, 0x8d, 0x05, 0xad, 0xc0, 0x21, 0x78, 0x2e, 0xd1,
+	0x72, 0xd9, 0x23, 0xe6, 0xf7, 0xcb, 0x47, 0x3b, 0x01, 0xe2, 0x08, 0x37, 0xa1, 0x30, 0x74, 0xec,
+	0x63, 0xb3, 0x8f, 0x46, 0x25, 0xcb, 0x53, 0x6e, 0x01, 0x00, 0xb9, 0x1b, 0x74, 0x48, 0x2d, 0xc3,
+	0x6d, 0xdb, 0x16, 0xb7, 0x00, 0x05, 0xad, 0x20, 0x21, 0x87, 0x16, 0xf9, 0x06, 0x16, 0x1d, 0xca,
+	0x9c, 0x71, 0x7b, 0x68, 0xf7, 0xcd, 0xee, 0x98, 0xeb, 0x4c, 0x71, 0xfd, 0x66, 0xcc, 0x22, 0x98,
+	0x33, 0x3e, 0xe2, 0x38, 0x5a, 0xd1, 0x09, 0x1a, 0x3c, 0x9f, 0xa2, 0x9f, 0xb6, 0xfd, 0x08, 0x44,
+	0x3c, 0x98, 0x16, 0x07, 0xfa, 0xe9, 0xb6, 0x04, 0x91, 0xcf, 0x20, 0x4d, 0xad, 0xb7, 0xd5, 0x02,
+	0x3f, 0xde, 0x6a, 0xa2, 0xe9, 0x5a, 0xab, 0x5b, 0x6f, 0xc5, 0x01, 0x47, 0x74, 0xb2, 0x8b, 0xd9,
+	0xf2, 0xae, 0x43, 0x59, 0x1b, 0x89, 0x85, 0x6d, 0x58, 0x4d, 0x26, 0x6e, 0x72, 0x5c, 0x9f, 0x45,
+	0xc1, 0xf5, 0xda, 0xb5, 0x2f, 0x20, 0xef, 0x81, 0xcf, 0x63, 0x23, 0x6a, 0xbf, 0x09, 0xe5, 0x28,
+	0xd3, 0x18, 0xea, 0x4f, 0xa3, 0x8f, 0x01, 0xd3, 0xcf, 0x3b, 0x82, 0xc3, 0x0b, 0x3a, 0xd6, 0xe8,
+	0x71, 0xd8, 0x00, 0x3d, 0x86, 0xc5, 0x70, 0x17, 0x3f, 0xd6, 0xbc, 0xed, 0x05, 0x36, 0xa2, 0xe5,
+	0x0d, 0x99, 0xf2, 0x87, 0x54, 0x7f, 0x80, 0xa2, 0x36, 0x2d, 0x7f, 0x9d, 0x31, 0x3a, 0x18, 0x32,
+	0x11, 0x52, 0x66, 0xb9, 0xfc, 0x37, 0x24, 0x08, 0x35, 0x28, 0x88, 0x3a, 0x44, 0x20, 0x86, 0xcf,
+	0xbe, 0x5e, 0xd8, 0xc1, 0xdf, 0xbd, 0x3b, 0x7a, 0xf7, 0x8d, 0x7d, 0x7c, 0xdc, 0x76, 0x69, 0xd7,
+	0xb6, 0x0c, 0x57, 0x7a, 0xf0, 0xb2, 0x04, 0x37, 0x05, 0x54, 0xfd, 0x93, 0x34, 0x94, 0xa3, 0x9e,
+	0xe6, 0xfc, 0xc1, 0xec, 0x23, 0xb8, 0xce, 0x93, 0x0d, 0x2e, 0x5a, 0x94, 0xf6, 0x64, 0x4c, 0x75,
+	0x2d, 0xe8, 0x6b, 0x79, 0x5d, 0x48, 0x22, 0x73, 0x9e, 0x51, 0x12, 0x71, 0x64, 0xaf, 0x05, 0x7d,
+	0x01, 0xc9, 0x63, 0xa8, 0x1a, 0xf6, 0x3b, 0xab, 0x6f, 0xeb, 0x46, 0xdb, 0x65, 0xba, 0xc3, 0x42,
+	0x64, 0x22, 0xee, 0x5a, 0xf6, 0xfa, 0x9b, 0xd8, 0x1d, 0x50, 0x7e, 0x01, 0xef, 0x0d, 0x1d, 0x9b,
+	0x1b, 0x8d, 0x49, 0x42, 0x61, 0x71, 0x6f, 0xc8, 0xee, 0x09, 0xba, 0x75, 0xb8, 0xc1, 0x1d, 0xe7,
+	0x14, 0xd5, 0x82, 0x5c, 0x18, 0x76, 0x4e, 0xd0, 0x4c, 0x87, 0x8d, 0xf9, 0xd9, 0x61, 0x63, 0x61,
+	0x32, 0x6c, 0xfc, 0xdb, 0x14, 0x14, 0x7c, 0x17, 0xce, 0x4b, 0x03, 0x3c, 0x43, 0x95, 0x32, 0x8d,
+	0xd8, 0x60, 0xed, 0x37, 0x20, 0x77, 0x6c, 0xd2, 0xbe, 0xe1, 0x5d, 0x53, 0x1f, 0x24, 0x87, 0x04,
+	0x6b, 0x3b, 0x1c, 0x51, 0x7a, 0x5e, 0x41, 0x45, 0x9e, 0x03, 0x74, 0x6d, 0xcb, 0xa2, 0x5d, 0x69,
+	0xe6, 0xe3, 0xaf, 0xba, 0x01, 0x8f, 0x2d, 0x1f, 0x59, 0xf0, 0x09, 0x51, 0xa3, 0x17, 0x0f, 0x0d,
+	0x71, 0xae, 0x13, 0xfa, 0x14, 0x96, 0x26, 0x38, 0x9f, 0x2b, 0x08, 0xf8, 0xb7, 0x0c, 0x5c, 0x8f,
+	0x33, 0xce, 0x28, 0xb2, 0xee, 0x50, 0x6a, 0x74, 0x4a, 0xe3, 0xdf, 0x08, 0xeb, 0x0d, 0xe5, 0x75,
+	0x21, 0xa5, 0xf1, 0x6f, 0x3c, 0xb4, 0x22, 0x6f, 0xcc, 0x95, 0x37, 0xa5, 0xc9, 0x16, 0x79, 0x02,
+	0x32, 0x9f, 0xdc, 0x1e, 0x59, 0x26, 0xe3, 0x6a, 0x5a, 0x8e, 0x09, 0xf4, 0x30, 0x6f, 0xf6, 0xca,
+	0x32, 0x99, 0x06, 0x02, 0x1b, 0xbf, 0xd1, 0xd9, 0xa0, 0xcc, 0x50, 0x17, 0xb2, 0x9c, 0xa9, 0xd7,
+	0x24, 0x5f, 0xc3, 0xa2, 0xfc, 0x14, 0x6c, 0x73, 0xb3, 0xd8, 0x16, 0x25, 0x3a, 0xe7, 0x8b, 0xd1,
+	0x08, 0xd5, 0x1d, 0x8b, 0x3a, 0x2e, 0xd7, 0xc8, 0xac, 0xe6, 0xb7, 0x31, 0xca, 0x71, 0xbb, 0x27,
+	0xd4, 0x90, 0x3e, 0x40, 0x9a, 0xf0, 0x10, 0x08, 0xa9, 0x99, 0x3d, 0xb4, 0xfb, 0x76, 0x6f, 0x2c,
+	0xf5, 0xcf, 0x6f, 0x13, 0x15, 0x16, 0xf1, 0x79, 0xd0, 0x64, 0xb4, 0xcb, 0x46, 0x0e, 0x95, 0x89,
+	0xbd, 0x08, 0x8c, 0xbc, 0x0f, 0x98, 0x9e, 0x6f, 0x73, 0x45, 0x2c, 0x0a, 0x1f, 0xda, 0x1b, 0x8e,
+	0xf8, 0x8b, 0xe2, 0x6f, 0x41, 0xc9, 0xb2, 0x0d, 0x1a, 0x5c, 0xeb, 0x17, 0xb9, 0x3a, 0x7d, 0x39,
+	0x97, 0x1f, 0x5d, 0x3b, 0xb0, 0x0d, 0xea, 0xdd, 0xfd, 0x85, 0x6e, 0x2d, 0x5a, 0x21, 0x10, 0x79,
+	0x0a, 0x45, 0x66, 0xf7, 0xe5, 0x35, 0xc4, 0xad, 0x96, 0x12, 0x52, 0x00, 0x2d, 0x1f, 0x47, 0x0b,
+	0xe3, 0xd7, 0xbe, 0x81, 0xab, 0x53, 0x23, 0x9c, 0x4b, 0xc7, 0x4e, 0x00, 0x02, 0xde, 0x31, 0x94,
+	0x35, 0xc8, 0xdb, 0x43, 0xec, 0xf6, 0x73, 0x07, 0x7e, 0x3b, 0xe0, 0x9a, 0x0e, 0x71, 0x45, 0xa5,
+	0xa3, 0xc7, 0xc7, 0xb4, 0xcb, 0xa4, 0xf9, 0x93, 0x2d, 0xf5, 0xaf, 0x15, 0x78, 0x4f, 0x3c, 0xb8,
+	0xd6, 0x4f, 0x87, 0xd4, 0x31, 0x51, 0x3e, 0x33, 0x93, 0x2a, 0x71, 0xef, 0x0e, 0xeb, 0x90, 0xe9,
+	0xe8, 0x6e, 0xf2, 0x0b, 0x4d, 0xa4, 0x8e, 0x4a, 0xe3, 0xb8, 0xe4, 0x53, 0xc8, 0xb8, 0x43, 0xda,
+	0xad, 0x66, 0x12, 0xae, 0x27, 0xc1, 0x94, 0x78, 0x6d, 0x17, 0x47, 0x56, 0xbf, 0x81, 0xea, 0xf4,
+	0x84, 0xe5, 0x6d, 0xf5, 0x43, 0x28, 0x51, 0x1f, 0x1a, 0xcc, 0x7b, 0x31, 0x00, 0xee, 0x19, 0x6a,
+	0x0b, 0xae, 0xef, 0x52, 0x36, 0xbd, 0xdc, 0x79, 0x88, 0x93, 0xaf, 0xce, 0x2d, 0xb8, 0x31, 0xc1,
+	0x55, 0xce, 0xe9, 0xd7, 0x01, 0x02, 0x0e, 0xf2, 0x91, 0xe3, 0x83, 0x33, 0x96, 0xaa, 0x85, 0xd0,
+	0xd5, 0x4f, 0xf9, 0xe3, 0xc4, 0x46, 0xbf, 0x1f, 0xf4, 0xbb, 0x33, 0xd3, 0x1a, 0xdf, 0xc3, 0xfb,
+	0x31, 0x44, 0xfe, 0xf3, 0x69, 0x31, 0xe0, 0x9f, 0x9c, 0xdd, 0x0a, 0xcd, 0x27, 0x8c, 0xaf, 0xfe,
+	0x43, 0x0a, 0xca, 0xd1, 0x6d, 0x21, 0xdb, 0x90, 0x77, 0x99, 0xa3, 0x33, 0xda, 0x1b, 0x4b, 0x6f,
+	0xbe, 0x3a, 0x63, 0x27, 0xd7, 0x9a, 0x12, 0x5f, 0xf3, 0x29, 0xc9, 0x37, 0xf8, 0x12, 0x80, 0x17,
+	0x0c, 0x46, 0x1d, 0x2f, 0xed, 0x33, 0xad, 0x11, 0xcf, 0xc6, 0x43, 0xea, 0x1c, 0x79, 0x78, 0x5a,
+	0x88, 0x04, 0xdd, 0x1d, 0x86, 0x34, 0xcc, 0x31, 0xf5, 0xbe, 0x17, 0x89, 0x14, 0x06, 0xfa, 0x69,
+	0x8b, 0x03, 0xbc, 0x88, 0x07, 0x09, 0xfa, 0x7d, 0x2a, 0x02, 0x77, 0x11, 0xf1, 0x1c, 0x49, 0x10,
+	0x5e, 0xaf, 0xc4, 0xfb, 0x9b, 0xf9, 0x96, 0x26, 0x5e, 0xaf, 0x0e, 0x3d, 0x0c, 0x2d, 0x40, 0x56,
+	0x1f, 0x42, 0xde, 0x5b, 0x12, 0xd6, 0x3a, 0xec, 0x6a, 0x7b, 0xdb, 0xa2, 0xd6, 0x41, 0xdb, 0x38,
+	0xd8, 0x3e, 0xdc, 0xaf, 0x28, 0x08, 0x6d, 0xec, 0x35, 0x5b, 0x95, 0x94, 0xfa, 0x03, 0x94, 0xa3,
+	0xab, 0x88, 0xbd, 0x4d, 0x2d, 0xfb, 0xa9, 0x0f, 0x11, 0x78, 0xc9, 0x16, 0xda, 0x82, 0x81, 0x69,
+	0xc9, 0xf7, 0x47, 0xfc, 0xe4, 0x10, 0x5d, 0x3c, 0xf0, 0x20, 0x44, 0x3f, 0x45, 0x67, 0x60, 0x5a,
+	0x8c, 0xf6, 0xe4, 0x8b, 0x4e, 0x5e, 0xf3, 0x9a, 0x6a, 0x1b, 0x0a, 0xfe, 0xfc, 0x85, 0x1f, 0xc2,
+	0x9b, 0xb6, 0xa7, 0x3e, 0xa2, 0x35, 0x51, 0x7b, 0x93, 0x9a, 0xaa, 0xbd, 0xc1, 0x17, 0x46, 0xd3,
+	0x32, 0x07, 0xf8, 0x9e, 0x93, 0xe6, 0xfc, 0xfd, 0xb6, 0xfa, 0x2f, 0x69, 0x80, 0x60, 0xaf, 0x2f,
+	0x76, 0xa4, 0x7c, 0xb9, 0xa4, 0x43, 0x72, 0xf9, 0x31, 0x26, 0x83, 0x7c, 0x09, 0x59, 0x97, 0xe9,
+	0x4c, 0x6c, 0x6a, 0x5c, 0xf5, 0x4c, 0x40, 0xc5, 0xe3, 0x4e, 0xaa, 0x09, 0x7c, 0xb2, 0x06, 0x39,
+	0xa9, 0x4f, 0x22, 0x09, 0xb2, 0x1c, 0x73, 0x93, 0x30, 0xf5, 0xbe, 0x26, 0xb1, 0xc8, 0x2a, 0x54,
+	0x3a, 0xd4, 0x65, 0xed, 0x70, 0xd2, 0x48, 0xde, 0xa7, 0x11, 0xde, 0x0a, 0x12, 0x47, 0xb7, 0x00,
+	0x38, 0xa6, 0x30, 0xd5, 0x79, 0xbe, 0x79, 0x05, 0x84, 0xf0, 0x94, 0x55, 0x62, 0xb8, 0x5b, 0x38,
+	0x7f, 0xb8, 0x0b, 0x89, 0xe1, 0xae, 0xfa, 0x21, 0x64, 0xf9, 0x72, 0x49, 0x11, 0x16, 0xb4, 0x57,
+	0x07, 0x07, 0xa2, 0x34, 0xac, 0x04, 0x85, 0xad, 0xc3, 0xfd, 0xa3, 0x46, 0x9d, 0x57, 0xe9, 0xa8,
+	0x7f, 0x95, 0x82, 0x2c, 0x5f, 0x25, 0x7a, 0x16, 0x51, 0x17, 0x27, 0x6e, 0x0b, 0xa2, 0x41, 0x76,
+	0x62, 0x0e, 0xee, 0x83, 0x78, 0x39, 0xad, 0xf9, 0x3a, 0x2f, 0x23, 0xc3, 0xf0, 0xf9, 0x9d, 0xc8,
+	0xb5, 0xa5, 0xcf, 0x48, 0xbe, 0x66, 0xe6, 0xbb, 0x33, 0xf8, 0x9e, 0x30, 0xcb, 0xc5, 0x2b, 0x1a,
+	0x98, 0x8d, 0x38, 0xd1, 0x5d, 0x29, 0xf8, 0x9c, 0xd0, 0xdf, 0x13, 0xdd, 0xe5, 0x72, 0xc7, 0xd8,
+	0x70, 0x62, 0x8e, 0xe7, 0xf2, 0xdb, 0x1a, 0x2c, 0x4f, 0x26, 0xf3, 0x2e, 0x9c, 0x93, 0x3d, 0x84,
+	0x6b, 0x5c, 0x6f, 0xa8, 0xc1, 0x59, 0x5f, 0x9c, 0xe1, 0x5f, 0x2a, 0xb0, 0x1c, 0xe6, 0xd8, 0xb0,
+	0x7b, 0x17, 0x66, 0x8a, 0xc6, 0xe4, 0xd8, 0xee, 0xf7, 0xed, 0x77, 0xd2, 0xe4, 0xc8, 0x16, 0x4f,
+	0x53, 0xb8, 0x7e, 0x19, 0xb7, 0x30, 0x17, 0x05, 0xd3, 0xf5, 0x32, 0xc6, 0xa2, 0xdb, 0x1d, 0x0d,
+	0x06, 0xba, 0x33, 0xae, 0x66, 0xbc, 0xee, 0xa6, 0x00, 0xa8, 0x16, 0xd4, 0xc2, 0x33, 0x95, 0x54,
+	0x97, 0x39, 0xdb, 0x74, 0x78, 0xb6, 0x6a, 0x13, 0xde, 0xdb, 0xa5, 0xac, 0xa1, 0x33, 0xea, 0xb2,
+	0xcb, 0x1a, 0x4c, 0xfd, 0x23, 0x05, 0xaa, 0xd3, 0x5c, 0x2f, 0xfc, 0x62, 0x19, 0xca, 0xa8, 0xa6,
+	0xe7, 0xcc, 0xa8, 0xaa, 0x7f, 0xa6, 0xc0, 0x1d, 0x51, 0x4a, 0xf6, 0x7f, 0x22, 0xd6, 0xaf, 0xa0,
+	0x68, 0xd1, 0x77, 0xed, 0x79, 0xa7, 0x05, 0x16, 0x7d, 0x27, 0xbf, 0xd5, 0x6d, 0xb8, 0x7b, 0xc6,
+	0xc4, 0xe6, 0x7d, 0x8c, 0x58, 0x05, 0xb2, 0x39, 0x66, 0xb4, 0xc9, 0x1c, 0xaa, 0x0f, 0xc2, 0x85,
+	0x19, 0x3c, 0x09, 0xa6, 0xf0, 0x4c, 0x2b, 0xff, 0xc6, 0xfa, 0x8d, 0xef, 0xcd, 0xe1, 0x90, 0x1a,
+	0x78, 0xdd, 0xdc, 0x3a, 0x19, 0x59, 0x6f, 0x62, 0xd1, 0xae, 0x03, 0xd9, 0xa5, 0xec, 0x5b, 0x91,
+	0xc8, 0xf4, 0x24, 0xa4, 0xfe, 0xbd, 0x02, 0xe0, 0x27, 0x43, 0x5d, 0xf2, 0x02, 0xc0, 0xcf, 0xb4,
+	0x7a, 0x11, 0xd5, 0x27, 0xc9, 0x79, 0x59, 0x37, 0xf4, 0x29, 0xcd, 0x60, 0x40, 0x5e, 0xeb, 0xc2,
+	0xd2, 0x44, 0x77, 0x8c, 0x05, 0x7a, 0x12, 0x4d, 0x20, 0xdd, 0x4b, 0x1e, 0x6c, 0x9b, 0x32, 0xdd,
+	0xec, 0x37, 0x4c, 0x97, 0x85, 0xed, 0x54, 0x0b, 0xae, 0xc5, 0x60, 0x90, 0xa7, 0x90, 0x97, 0x39,
+	0x5b, 0x6f, 0x19, 0x77, 0x67, 0x71, 0x76, 0x35, 0x9f, 0x44, 0x7d, 0x06, 0x95, 0xc9, 0xde, 0x70,
+	0x56, 0x58, 0x89, 0x66, 0x85, 0x6b, 0x90, 0xa7, 0xa7, 0x8c, 0x3a, 0x96, 0x2e, 0x82, 0x8c, 0xbc,
+	0xe6, 0xb7, 0x1f, 0xfe, 0x04, 0xf2, 0xde, 0x7d, 0x94, 0xe4, 0x20, 0xb5, 0xbf, 0x59, 0xb9, 0x82,
+	0x25, 0xa2, 0xfb, 0xe6, 0x66, 0x45, 0x41, 0xc0, 0xee, 0xa6, 0xa8, 0x19, 0xdd, 0x35, 0x37, 0x2b,
+	0xe9, 0x87, 0x7f, 0xa1, 0x40, 0x4e, 0xe6, 0x95, 0x96, 0xa0, 0x78, 0x70, 0xd8, 0x6a, 0x37, 0x5b,
+	0x1b, 0x1a, 0x7a, 0xaf, 0x2b, 0xe8, 0xd9, 0x8e, 0xea, 0x07, 0xdb, 0xa2, 0xc8, 0x19, 0x20, 0xf7,
+	0x6c, 0xa3, 0x81, 0x1d, 0x59, 0xfc, 0xde, 0xd9, 0xd8, 0x6b, 0xd4, 0xb7, 0x2b, 0x80, 0xdf, 0xdb,
+	0xf5, 0xa3, 0xc6, 0xe1, 0x2f, 0x2a, 0xd7, 0x91, 0xc3, 0xf6, 0xe1, 0x77, 0x07, 0x8d, 0xc3, 0x0d,
+	0x4e, 0x74, 0x1b, 0x2b, 0xa5, 0x8f, 0xb4, 0xc3, 0xad, 0x7a, 0xb3, 0x89, 0xed, 0x55, 0xe4, 0xd8,
+	0x6c, 0x1d, 0xf2, 0xb2, 0xe9, 0xf5, 0xa8, 0xaf, 0xfc, 0x1a, 0x19, 0xbd, 0x7c, 0x55, 0x7f, 0x55,
+	0xdf, 0xae, 0xec, 0x20, 0xde, 0x77, 0x1b, 0x7b, 0x2d, 0xc4, 0x3b, 0x5a, 0xff, 0xfd, 0x6b, 0xb0,
+	0x20, 0x34, 0xdb, 0x21, 0xdf, 0xc2, 0x55, 0x71, 0x81, 0xf1, 0xc2, 0x01, 0x7c, 0x69, 0x9a, 0x71,
+	0x61, 0xaa, 0xad, 0x24, 0xf6, 0x0b, 0x25, 0x57, 0xaf, 0x90, 0x7d, 0x5e, 0x05, 0x13, 0x66, 0x3a,
+	0x1d, 0xd6, 0x07, 0xe5, 0x5f, 0xb5, 0x9b, 0xf1, 0x9d, 0x3e, 0xbb, 0x9f, 0xf3, 0xfa, 0xaa, 0x8d,
+	0x7e, 0xdf, 0xe3, 0xe8, 0x3e, 0xc7, 0x7a, 0x99, 0xdb, 0x71, 0x64, 0x41, 0x7d, 0x53, 0x6d, 0x25,
+	0xb1, 0xdf, 0xe7, 0xfc, 0x2d, 0x5c, 0x15, 0xaf, 0x8c, 0x67, 0x0b, 0x20, 0xf2, 0xa8, 0x59, 0x5b,
+	0x49, 0xec, 0xf7, 0xf9, 0x1e, 0xc1, 0x12, 0x56, 0xd6, 0x84, 0xb9, 0x4e, 0x2f, 0x32, 0x54, 0xc4,
+	0x53, 0xbb, 0x95, 0xd0, 0xeb, 0x73, 0xec, 0xf2, 0xe3, 0x3f, 0xf9, 0xe4, 0xf3, 0xd1, 0xcc, 0x17,
+	0x3c, 0xc9, 0x7f, 0xfa, 0x51, 0x6a, 0xc2, 0xe6, 0xa8, 0x57, 0x7e, 0x4d, 0x21, 0xbf, 0x2d, 0x4a,
+	0xc9, 0x42, 0x76, 0x8f, 0xdc, 0x8b, 0xcf, 0x58, 0x47, 0x43, 0x80, 0x39, 0xd9, 0xf7, 0xf8, 0x3e,
+	0x4e, 0x38, 0x7c, 0x37, 0x66, 0x11, 0xf1, 0x31, 0x41, 0x6d, 0xfa, 0xed, 0x7d, 0xda, 0xc4, 0xf2,
+	0x81, 0x76, 0x83, 0x75, 0x98, 0x56, 0x8f, 0x0f, 0xb2, 0x1c, 0x5f, 0xa7, 0x5e, 0x9b, 0xf6, 0x09,
+	0xf2, 0x1f, 0x0a, 0xce, 0xa8, 0x11, 0xcc, 0xd8, 0xb4, 0x7a, 0xfe, 0x1f, 0x08, 0x49, 0xcc, 0xde,
+	0x4f, 0xac, 0xfd, 0xe7, 0xdc, 0x5e, 0x42, 0x31, 0x64, 0xc2, 0xc9, 0x87, 0x71, 0xfa, 0x39, 0x61,
+	0xe0, 0x6b, 0x1f, 0x9c, 0x61, 0xbd, 0xd5, 0x2b, 0xc4, 0x84, 0xca, 0x64, 0x0a, 0x82, 0xac, 0x26,
+	0x1c, 0xd0, 0xa9, 0x3c, 0x43, 0xed, 0xe3, 0x39, 0x30, 0x7d, 0x0d, 0xfc, 0x1d, 0x5e, 0x3f, 0x18,
+	0x1a, 0xe7, 0x7e, 0xdc, 0xfc, 0xa7, 0x07, 0x79, 0x30, 0x0b, 0xcd, 0x1f, 0xa1, 0x0f, 0x57, 0xa7,
+	0xb2, 0x05, 0xe4, 0xe3, 0x84, 0x53, 0x3c, 0x9d, 0x86, 0xa8, 0x3d, 0x9c, 0x07, 0xd5, 0x1f, 0xed,
+	0xfb, 0xc8, 0xde, 0x7a, 0xd5, 0x9b, 0x67, 0x5b, 0xaa, 0x7b, 0x71, 0x9d, 0x93, 0x85, 0x9f, 0xc2,
+	0xae, 0x84, 0x62, 0x88, 0x44, 0xbb, 0x12, 0xf9, 0x57, 0xa0, 0xb6, 0x92, 0xd8, 0xef, 0xf3, 0x6d,
+	0xc3, 0x72, 0x33, 0x62, 0x58, 0xbd, 0x52, 0x78, 0x32, 0x7d, 0x02, 0x27, 0xaa, 0xee, 0x6b, 0x77,
+	0xcf, 0xc0, 0x08, 0x4f, 0x5c, 0x14, 0xb2, 0x9d, 0x3d, 0xf1, 0x48, 0xe9, 0x5e, 0x6d, 0x25, 0xb1,
+	0xdf, 0xe7, 0xfb, 0x0b, 0xb8, 0x1e, 0x9d, 0xb8, 0x78, 0xf8, 0x8e, 0x61, 0x1d, 0xa9, 0x2f, 0xab,
+	0xad, 0x24, 0xf6, 0xfb, 0xac, 0x5f, 0x42, 0x65, 0xc2, 0xd6, 0xba, 0x31, 0xc6, 0x36, 0x54, 0xa2,
+	0x55, 0xbb, 0x95, 0xd0, 0xeb, 0xb3, 0x6c, 0x02, 0x99, 0x72, 0x0b, 0x17, 0x66, 0xaa, 0xf3, 0xd8,
+	0x3b, 0xaa, 0x6f, 0xb2, 0x6a, 0xe8, 0x6c, 0xa5, 0x7b, 0x70, 0x76, 0xc9, 0x51, 0x54, 0x14, 0xbc,
+	0xdc, 0xe8, 0x02, 0x9e, 0x37, 0x54, 0x84, 0xc4, 0x6d, 0xd6, 0xcf, 0xe1, 0x06, 0x67, 0xf9, 0xca,
+	0xa5, 0x4e, 0x44, 0x1a, 0xd3, 0xeb, 0x0d, 0x57, 0x3a, 0xcd, 0xc1, 0xb9, 0x05, 0x57, 0xf9, 0xef,
+	0x0f, 0x33, 0xb8, 0x86, 0xff, 0xcd, 0xa8, 0xdd, 0x4e, 0xea, 0x8e, 0x2a, 0xb0, 0x65, 0x44, 0x26,
+	0xfb, 0x23, 0x42, 0x9a, 0x68, 0xfd, 0xbc, 0x7a, 0x85, 0xbc, 0x80, 0xfc, 0x2e, 0x65, 0xa2, 0x78,
+	0xff, 0x56, 0xfc, 0x7f, 0x06, 0xc9, 0x93, 0x8c, 0xfc, 0xb7, 0xa0, 0x5e, 0xe9, 0xe4, 0xf8, 0xcf,
+	0xd5, 0x9f, 0xfe, 0xef, 0x00, 0x42, 0x4d, 0xc6, 0x5d, 0x6d, 0x3d, 0x00, 0x00,
 }
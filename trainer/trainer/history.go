@@ -0,0 +1,113 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/mgo.v2"
+)
+
+// GetTrainingStatusHistory returns the status transitions of a training with the time spent in each status
+func (s *trainerService) GetTrainingStatusHistory(ctx context.Context, req *grpc_trainer_v2.GetRequest) (*grpc_trainer_v2.StatusHistoryResponse, error) {
+	logr := logger.LocLogger(logWith(req.TrainingId, req.UserId))
+	logr.Debugf("GetTrainingStatusHistory called for training %s", req.TrainingId)
+
+	tr, err := s.repo.Find(req.TrainingId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, gerrf(codes.NotFound, "Training with id %s not found.", req.TrainingId)
+		}
+		logr.WithError(err).Errorf("Cannot retrieve training record")
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if tr.UserID != req.UserId {
+		msg := fmt.Sprint("User does not have permission to read training data")
+		logr.Error(msg)
+		return nil, gerrf(codes.PermissionDenied, msg)
+	}
+
+	entries := statusHistory(tr, s.jobHistoryRepo.GetJobStatusHistory(req.TrainingId), time.Now())
+	return &grpc_trainer_v2.StatusHistoryResponse{
+		TrainingId: req.TrainingId,
+		Entries:    entries,
+	}, nil
+}
+
+// statusHistory turns the job history of a training into its status transitions. Repeated updates with the same
+// status, such as the ones of the individual learners, are merged into the first of them.
+func statusHistory(tr *TrainingRecord, history []*JobHistoryEntry, now time.Time) []*grpc_trainer_v2.StatusHistoryEntry {
+	var entries []*grpc_trainer_v2.StatusHistoryEntry
+	if tr.TrainingStatus != nil && tr.TrainingStatus.SubmissionTimestamp != "" &&
+		(len(history) == 0 || history[0].Timestamp > tr.TrainingStatus.SubmissionTimestamp) {
+		// trainings submitted before their submission was recorded in the job history
+		entries = append(entries, &grpc_trainer_v2.StatusHistoryEntry{
+			Status:    grpc_trainer_v2.Status_QUEUED,
+			Timestamp: tr.TrainingStatus.SubmissionTimestamp,
+		})
+	}
+	for _, e := range history {
+		last := len(entries) - 1
+		if last >= 0 && entries[last].Status == e.Status {
+			if entries[last].ErrorCode == "" {
+				entries[last].ErrorCode = e.ErrorCode
+			}
+			if entries[last].StatusMessage == "" {
+				entries[last].StatusMessage = e.StatusMessage
+			}
+			continue
+		}
+		entries = append(entries, &grpc_trainer_v2.StatusHistoryEntry{
+			Status:        e.Status,
+			Timestamp:     e.Timestamp,
+			StatusMessage: e.StatusMessage,
+			ErrorCode:     e.ErrorCode,
+		})
+	}
+
+	for i, e := range entries {
+		start, err := strconv.ParseInt(e.Timestamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		var end int64
+		if i+1 < len(entries) {
+			end, err = strconv.ParseInt(entries[i+1].Timestamp, 10, 64)
+			if err != nil {
+				continue
+			}
+		} else if !isFinalStatus(e.Status) {
+			end = now.UnixNano() / int64(time.Millisecond)
+		}
+		if end > start {
+			e.Duration = end - start
+		}
+	}
+	return entries
+}
+
+// isFinalStatus returns true if a training does not leave the status on its own
+func isFinalStatus(status grpc_trainer_v2.Status) bool {
+	return status == grpc_trainer_v2.Status_COMPLETED || status == grpc_trainer_v2.Status_FAILED ||
+		status == grpc_trainer_v2.Status_HALTED
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestStatusHistory(t *testing.T) {
+	tr := createParentRecord("history", "alice", grpc_trainer_v2.Status_FAILED)
+	tr.TrainingStatus.SubmissionTimestamp = "1500000000000"
+	history := []*JobHistoryEntry{
+		{TrainingID: "history", Timestamp: "1500000005000", Status: grpc_trainer_v2.Status_PENDING},
+		{TrainingID: "history", Timestamp: "1500000010000", Status: grpc_trainer_v2.Status_DOWNLOADING},
+		{TrainingID: "history", Timestamp: "1500000070000", Status: grpc_trainer_v2.Status_PROCESSING},
+		// the second learner reports the same status
+		{TrainingID: "history", Timestamp: "1500000071000", Status: grpc_trainer_v2.Status_PROCESSING},
+		{TrainingID: "history", Timestamp: "1500000100000", Status: grpc_trainer_v2.Status_FAILED,
+			ErrorCode: "C201", StatusMessage: "Training failed"},
+	}
+
+	entries := statusHistory(tr, history, time.Unix(1600000000, 0))
+	var statuses []grpc_trainer_v2.Status
+	var durations []int64
+	for _, e := range entries {
+		statuses = append(statuses, e.Status)
+		durations = append(durations, e.Duration)
+	}
+	// the submission was not recorded, so the training was queued until it became pending
+	assert.Equal(t, []grpc_trainer_v2.Status{
+		grpc_trainer_v2.Status_QUEUED,
+		grpc_trainer_v2.Status_PENDING,
+		grpc_trainer_v2.Status_DOWNLOADING,
+		grpc_trainer_v2.Status_PROCESSING,
+		grpc_trainer_v2.Status_FAILED,
+	}, statuses)
+	assert.Equal(t, []int64{5000, 5000, 60000, 30000, 0}, durations)
+	assert.Equal(t, "C201", entries[4].ErrorCode)
+	assert.Equal(t, "Training failed", entries[4].StatusMessage)
+
+	// the current status of an unfinished training lasts until now
+	entries = statusHistory(tr, history[:3], time.Unix(1500000100, 0))
+	assert.EqualValues(t, 30000, entries[3].Duration)
+}
+
+func TestGetTrainingStatusHistory(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	resp, err := s.CreateTrainingJob(context.Background(), createDependentRequest("parent"))
+	assert.NoError(t, err)
+	_, err = s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "parent",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_FAILED,
+		ErrorCode:  "C201",
+	})
+	assert.NoError(t, err)
+
+	history, err := s.GetTrainingStatusHistory(context.Background(), &grpc_trainer_v2.GetRequest{
+		TrainingId: resp.TrainingId,
+		UserId:     "alice",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, resp.TrainingId, history.TrainingId)
+	if assert.NotEmpty(t, history.Entries) {
+		assert.Equal(t, grpc_trainer_v2.Status_WAITING, history.Entries[0].Status)
+		assert.Equal(t, grpc_trainer_v2.Status_FAILED, history.Entries[len(history.Entries)-1].Status)
+	}
+
+	_, err = s.GetTrainingStatusHistory(context.Background(), &grpc_trainer_v2.GetRequest{
+		TrainingId: resp.TrainingId,
+		UserId:     "bob",
+	})
+	assert.Equal(t, codes.PermissionDenied, grpcCode(err))
+
+	_, err = s.GetTrainingStatusHistory(context.Background(), &grpc_trainer_v2.GetRequest{
+		TrainingId: "missing",
+		UserId:     "alice",
+	})
+	assert.Equal(t, codes.NotFound, grpcCode(err))
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// maximum length of an idempotency key
+const maxIdempotencyKeyLength = 255
+
+// how long creating a training may take, after which a key whose training does not exist is taken over by a replay
+const idempotencyClaimTimeout = 2 * modelFetchTimeout
+
+// IdempotencyRecord maps the idempotency key of a create request to the training it created, in the Mongo collection
+// "idempotency_keys"
+type IdempotencyRecord struct {
+	ID         bson.ObjectId `bson:"_id,omitempty" json:"id"`
+	UserID     string        `bson:"user_id" json:"user_id"`
+	Key        string        `bson:"key" json:"key"`
+	TrainingID string        `bson:"training_id" json:"training_id"`
+	// a date rather than a timestamp string, for the TTL index
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+type idempotencyRepository interface {
+	// Claim records that the key of the user creates trainingID. If the key was already claimed after notBefore, it
+	// returns that claim and false instead.
+	Claim(userID string, key string, trainingID string, notBefore time.Time) (*IdempotencyRecord, bool, error)
+	// Replace records that the key of the user creates trainingID instead of oldTrainingID. It returns false if the key
+	// no longer belongs to oldTrainingID.
+	Replace(userID string, key string, oldTrainingID string, trainingID string) (bool, error)
+	// Release forgets the claim of a key whose training could not be created, so that it can be retried.
+	Release(userID string, key string, trainingID string) error
+	Close()
+}
+
+type idempotencyKeysRepository struct {
+	session    *mgo.Session
+	database   string
+	collection string
+}
+
+// inMemIdempotencyRepository is an idempotencyRepository kept in memory, for running the trainer without mongo.
+type inMemIdempotencyRepository struct {
+	mtx     sync.Mutex
+	records map[string]*IdempotencyRecord // by user and key
+}
+
+// newIdempotencyRepository creates a new repo for idempotency keys, which mongo removes once they are older than ttl.
+func newIdempotencyRepository(mongoURI string, database string, username string, password string,
+	cert string, collection string, ttl time.Duration) (idempotencyRepository, error) {
+	log := logger.LocLogger(log.StandardLogger().WithField("module", "idempotencyRepository"))
+	log.Debugf("Creating mongo idempotency repository for %s, collection %s:", mongoURI, collection)
+
+	session, err := ConnectMongo(mongoURI, database, username, password, cert)
+	if err != nil {
+		return nil, err
+	}
+	collectionObj := session.DB(database).C(collection)
+
+	repo := &idempotencyKeysRepository{
+		session:    session,
+		database:   collectionObj.Database.Name,
+		collection: collection,
+	}
+
+	// create index
+	if err := collectionObj.EnsureIndex(mgo.Index{Key: []string{"user_id", "key"}, Unique: true}); err != nil {
+		log.WithError(err).Errorf("Cannot create the unique index of idempotency keys")
+		return nil, err
+	}
+	collectionObj.EnsureIndex(mgo.Index{Key: []string{"created_at"}, ExpireAfter: ttl})
+
+	return repo, nil
+}
+
+// newInMemIdempotencyRepository creates a new in-memory repo for idempotency keys.
+func newInMemIdempotencyRepository() idempotencyRepository {
+	return &inMemIdempotencyRepository{records: make(map[string]*IdempotencyRecord)}
+}
+
+func (r *idempotencyKeysRepository) Claim(userID string, key string, trainingID string, notBefore time.Time) (*IdempotencyRecord, bool, error) {
+	sess := r.session.Clone()
+	defer sess.Close()
+	c := sess.DB(r.database).C(r.collection)
+
+	record := &IdempotencyRecord{UserID: userID, Key: key, TrainingID: trainingID, CreatedAt: time.Now()}
+	err := c.Insert(record)
+	if err == nil {
+		return record, true, nil
+	}
+	if !mgo.IsDup(err) {
+		return nil, false, err
+	}
+
+	// take over an expired key mongo has not removed yet
+	err = c.Update(bson.M{"user_id": userID, "key": key, "created_at": bson.M{"$lt": notBefore}},
+		bson.M{"$set": bson.M{"training_id": trainingID, "created_at": record.CreatedAt}})
+	if err == nil {
+		return record, true, nil
+	}
+	if err != mgo.ErrNotFound {
+		return nil, false, err
+	}
+
+	existing := &IdempotencyRecord{}
+	if err := c.Find(bson.M{"user_id": userID, "key": key}).One(existing); err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+func (r *idempotencyKeysRepository) Replace(userID string, key string, oldTrainingID string, trainingID string) (bool, error) {
+	sess := r.session.Clone()
+	defer sess.Close()
+	err := sess.DB(r.database).C(r.collection).Update(bson.M{"user_id": userID, "key": key, "training_id": oldTrainingID},
+		bson.M{"$set": bson.M{"training_id": trainingID, "created_at": time.Now()}})
+	if err == mgo.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *idempotencyKeysRepository) Release(userID string, key string, trainingID string) error {
+	sess := r.session.Clone()
+	defer sess.Close()
+	err := sess.DB(r.database).C(r.collection).Remove(bson.M{"user_id": userID, "key": key, "training_id": trainingID})
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+func (r *idempotencyKeysRepository) Close() {
+	log.Debugf("Closing mongo session")
+	defer r.session.Close()
+}
+
+func (r *inMemIdempotencyRepository) Claim(userID string, key string, trainingID string, notBefore time.Time) (*IdempotencyRecord, bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	id := userID + "\x00" + key
+	if existing := r.records[id]; existing != nil && !existing.CreatedAt.Before(notBefore) {
+		record := *existing
+		return &record, false, nil
+	}
+	record := &IdempotencyRecord{UserID: userID, Key: key, TrainingID: trainingID, CreatedAt: time.Now()}
+	r.records[id] = record
+	return record, true, nil
+}
+
+func (r *inMemIdempotencyRepository) Replace(userID string, key string, oldTrainingID string, trainingID string) (bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	existing := r.records[userID+"\x00"+key]
+	if existing == nil || existing.TrainingID != oldTrainingID {
+		return false, nil
+	}
+	existing.TrainingID = trainingID
+	existing.CreatedAt = time.Now()
+	return true, nil
+}
+
+func (r *inMemIdempotencyRepository) Release(userID string, key string, trainingID string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	id := userID + "\x00" + key
+	if existing := r.records[id]; existing != nil && existing.TrainingID == trainingID {
+		delete(r.records, id)
+	}
+	return nil
+}
+
+func (r *inMemIdempotencyRepository) Close() {
+}
+
+// idempotencyTTL returns how long an idempotency key is remembered
+func idempotencyTTL() time.Duration {
+	return time.Duration(viper.GetInt(idempotencyTTLKey)) * time.Second
+}
+
+// claimIdempotencyKey claims the idempotency key of a create request for the training id. If the key was used before,
+// it returns the training created then and false.
+func (s *trainerService) claimIdempotencyKey(req *grpc_trainer_v2.CreateRequest, id string, logr *logger.LocLoggingEntry) (string, bool, error) {
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return "", false, gerrf(codes.InvalidArgument, "Idempotency key must not be longer than %d characters", maxIdempotencyKeyLength)
+	}
+	existing, claimed, err := s.idempotencyRepo.Claim(req.UserId, req.IdempotencyKey, id, time.Now().Add(-idempotencyTTL()))
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot claim idempotency key")
+		return "", false, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if claimed {
+		return id, true, nil
+	}
+
+	// the key is claimed before the training is stored, so its training is missing while it is being created or if
+	// the trainer stopped before storing it
+	_, err = s.repo.Find(existing.TrainingID)
+	if err == nil {
+		return existing.TrainingID, false, nil
+	}
+	if err != mgo.ErrNotFound {
+		logr.WithError(err).Errorf("Cannot find training %s of idempotency key", existing.TrainingID)
+		return "", false, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if time.Since(existing.CreatedAt) < idempotencyClaimTimeout {
+		return "", false, gerrf(codes.Aborted, "A training with the same idempotency key is still being created, retry later")
+	}
+	logr.Warnf("Training %s of idempotency key was never created, taking the key over", existing.TrainingID)
+	replaced, err := s.idempotencyRepo.Replace(req.UserId, req.IdempotencyKey, existing.TrainingID, id)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot take over idempotency key")
+		return "", false, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if !replaced {
+		// another request took the key over first
+		return "", false, gerrf(codes.Aborted, "A training with the same idempotency key is still being created, retry later")
+	}
+	return id, true, nil
+}
+
+// releaseIdempotencyKey forgets the idempotency key of a create request that failed
+func (s *trainerService) releaseIdempotencyKey(req *grpc_trainer_v2.CreateRequest, id string, logr *logger.LocLoggingEntry) {
+	if err := s.idempotencyRepo.Release(req.UserId, req.IdempotencyKey, id); err != nil {
+		logr.WithError(err).Errorf("Cannot release idempotency key of training %s", id)
+	}
+}
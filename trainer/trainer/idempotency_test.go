@@ -0,0 +1,112 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateTrainingJobIdempotencyKey(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	// the new trainings wait for a running training, so that they are not sent to the LCM
+	assert.NoError(t, s.repo.Store(createParentRecord("running", "alice", grpc_trainer_v2.Status_PROCESSING)))
+	assert.NoError(t, s.repo.Store(createParentRecord("running-bob", "bob", grpc_trainer_v2.Status_PROCESSING)))
+
+	create := func(userID string, key string, dependsOn string) (*grpc_trainer_v2.CreateResponse, error) {
+		req := createDependentRequest(dependsOn)
+		req.UserId = userID
+		req.IdempotencyKey = key
+		return s.CreateTrainingJob(context.Background(), req)
+	}
+
+	first, err := create("alice", "key-1", "running")
+	assert.NoError(t, err)
+	replay, err := create("alice", "key-1", "running")
+	assert.NoError(t, err)
+	assert.Equal(t, first.TrainingId, replay.TrainingId)
+	trainings, _ := s.repo.FindAll("alice")
+	assert.Len(t, trainings, 2)
+
+	// keys are per user
+	other, err := create("bob", "key-1", "running-bob")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.TrainingId, other.TrainingId)
+
+	// the key of a failed request can be used again
+	_, err = create("alice", "key-2", "missing")
+	assert.Error(t, err)
+	second, err := create("alice", "key-2", "running")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.TrainingId, second.TrainingId)
+
+	// expired keys create new trainings
+	viper.Set(idempotencyTTLKey, 0)
+	defer viper.Set(idempotencyTTLKey, 86400)
+	third, err := create("alice", "key-1", "running")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.TrainingId, third.TrainingId)
+
+	_, err = create("alice", strings.Repeat("k", maxIdempotencyKeyLength+1), "running")
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
+
+func TestCreateTrainingJobIdempotencyKeyOfMissingTraining(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	assert.NoError(t, s.repo.Store(createParentRecord("running", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	create := func() (*grpc_trainer_v2.CreateResponse, error) {
+		req := createDependentRequest("running")
+		req.UserId = "alice"
+		req.IdempotencyKey = "key-1"
+		return s.CreateTrainingJob(context.Background(), req)
+	}
+
+	// a request claimed the key but its training is still being created
+	record, claimed, err := s.idempotencyRepo.Claim("alice", "key-1", "training-lost", time.Now())
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	_, err = create()
+	assert.Equal(t, codes.Aborted, grpcCode(err))
+
+	// the trainer stopped before storing the training, so a replay creates it
+	record.CreatedAt = time.Now().Add(-idempotencyClaimTimeout)
+	created, err := create()
+	assert.NoError(t, err)
+	assert.NotEqual(t, "training-lost", created.TrainingId)
+	replay, err := create()
+	assert.NoError(t, err)
+	assert.Equal(t, created.TrainingId, replay.TrainingId)
+	trainings, _ := s.repo.FindAll("alice")
+	assert.Len(t, trainings, 2)
+
+	// a key that was taken over in the meantime is not taken over again
+	replaced, err := s.idempotencyRepo.Replace("alice", "key-1", "training-lost", "training-other")
+	assert.NoError(t, err)
+	assert.False(t, replaced)
+}
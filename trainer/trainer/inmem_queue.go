@@ -0,0 +1,188 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// inMemJobQueue is a JobQueue kept in memory, for running the trainer without mongo.
+// It has the same ordering and locking semantics as TrainingJobQueue, within a single process.
+type inMemJobQueue struct {
+	mtx     sync.Mutex
+	entries []Entry // in submission order
+
+	lockMtx        sync.Mutex
+	lockExpires    time.Time // zero if the queue is not locked
+	lockRetries    int
+	lockRetryDelay time.Duration
+	lockExpiration time.Duration
+}
+
+// newInMemJobQueue creates a new in-memory queue for training jobs
+func newInMemJobQueue() *inMemJobQueue {
+	return &inMemJobQueue{
+		entries:        make([]Entry, 0),
+		lockRetries:    lockRetries,
+		lockRetryDelay: lockRetryDelay * time.Second,
+		lockExpiration: lockExpiration * time.Second,
+	}
+}
+
+// Enqueue adds a training job id with the given priority to the queue
+func (q *inMemJobQueue) Enqueue(id string, priority int32) error {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	q.entries = append(q.entries, Entry{
+		ID:         bson.NewObjectId(),
+		TrainingID: id,
+		Submitted:  time.Now(),
+		Priority:   priority,
+	})
+	return nil
+}
+
+// Dequeue returns a single training job id and removes it from the queue
+func (q *inMemJobQueue) Dequeue() (string, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	entry := nextEntry(q.entries, getPriorityAgingInterval(), time.Now())
+	if entry == nil {
+		return "", fmt.Errorf("queue is empty")
+	}
+	id := entry.TrainingID
+	q.remove(id)
+	return id, nil
+}
+
+// Peek returns a single training job id and leaves it in the queue
+func (q *inMemJobQueue) Peek() (string, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	entry := nextEntry(q.entries, getPriorityAgingInterval(), time.Now())
+	if entry == nil {
+		return "", fmt.Errorf("queue is empty")
+	}
+	return entry.TrainingID, nil
+}
+
+// PeekN returns up to n entries in the order they would be dequeued and leaves them in the queue
+func (q *inMemJobQueue) PeekN(n int) ([]Entry, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	entries := make([]Entry, len(q.entries))
+	copy(entries, q.entries)
+	entries = orderEntries(entries, getPriorityAgingInterval(), time.Now())
+	if len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries, nil
+}
+
+// Delete removes a training job id from any position in the queue
+func (q *inMemJobQueue) Delete(id string) (bool, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	return q.remove(id), nil
+}
+
+// SetPriority changes the priority of a training job that is waiting in the queue
+func (q *inMemJobQueue) SetPriority(id string, priority int32) (bool, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for i := range q.entries {
+		if q.entries[i].TrainingID == id {
+			q.entries[i].Priority = priority
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Size returns the number of elements in the queue.
+func (q *inMemJobQueue) Size() (int, error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	return len(q.entries), nil
+}
+
+// Empty returns whether the queue has any jobs
+func (q *inMemJobQueue) Empty() (bool, error) {
+	size, err := q.Size()
+	return size == 0, err
+}
+
+// Lock acquires the queue lock, waiting for it to be released or to expire
+func (q *inMemJobQueue) Lock() error {
+	for i := 0; i < q.lockRetries; i++ {
+		if q.tryLock() {
+			return nil
+		}
+		time.Sleep(q.lockRetryDelay)
+	}
+	return fmt.Errorf("failed to acquire lock after %d attempts", q.lockRetries)
+}
+
+// Unlock releases the queue lock
+func (q *inMemJobQueue) Unlock() error {
+	q.lockMtx.Lock()
+	defer q.lockMtx.Unlock()
+
+	if q.lockExpires.IsZero() {
+		return fmt.Errorf("queue is not locked")
+	}
+	q.lockExpires = time.Time{}
+	return nil
+}
+
+// Close does nothing for an in-memory queue
+func (q *inMemJobQueue) Close() {
+}
+
+func (q *inMemJobQueue) tryLock() bool {
+	q.lockMtx.Lock()
+	defer q.lockMtx.Unlock()
+
+	now := time.Now()
+	if !q.lockExpires.IsZero() && now.Before(q.lockExpires) {
+		return false
+	}
+	q.lockExpires = now.Add(q.lockExpiration)
+	return true
+}
+
+// remove deletes the entry with the given training id, the caller must hold q.mtx
+func (q *inMemJobQueue) remove(id string) bool {
+	for i := range q.entries {
+		if q.entries[i].TrainingID == id {
+			q.entries = append(q.entries[:i], q.entries[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInMemQueue(t *testing.T) {
+	queue := newInMemJobQueue()
+	t1 := "training job 1"
+	t2 := "training job 2"
+	t3 := "training job 3"
+
+	queue.Enqueue(t1, 0)
+	queue.Enqueue(t2, 0)
+
+	empty, e := queue.Empty()
+	assert.Equal(t, false, empty)
+	assert.Equal(t, nil, e)
+
+	queue.Enqueue(t3, 0)
+	deleted, _ := queue.Delete(t2)
+	assert.True(t, deleted)
+	deleted, _ = queue.Delete(t2)
+	assert.False(t, deleted)
+
+	id1, e1 := queue.Dequeue()
+	assert.Equal(t, t1, id1)
+	assert.Equal(t, nil, e1)
+
+	id3, e3 := queue.Peek()
+	assert.Equal(t, t3, id3)
+	assert.Equal(t, nil, e3)
+
+	queue.Dequeue()
+
+	id4, e4 := queue.Dequeue()
+	assert.Equal(t, "", id4)
+	assert.Equal(t, fmt.Errorf("queue is empty"), e4)
+
+	empty, e = queue.Empty()
+	assert.Equal(t, true, empty)
+	assert.Equal(t, nil, e)
+}
+
+func TestInMemQueuePriority(t *testing.T) {
+	queue := newInMemJobQueue()
+	queue.Enqueue("low", 0)
+	queue.Enqueue("high", 5)
+
+	id, _ := queue.Peek()
+	assert.Equal(t, "high", id)
+
+	updated, _ := queue.SetPriority("low", 10)
+	assert.True(t, updated)
+	updated, _ = queue.SetPriority("missing", 10)
+	assert.False(t, updated)
+
+	entries, _ := queue.PeekN(5)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "low", entries[0].TrainingID)
+		assert.Equal(t, "high", entries[1].TrainingID)
+	}
+}
+
+func TestInMemQueueLock(t *testing.T) {
+	queue := newInMemJobQueue()
+	queue.lockRetries = 2
+	queue.lockRetryDelay = 10 * time.Millisecond
+	queue.lockExpiration = 100 * time.Millisecond
+
+	assert.NoError(t, queue.Lock())
+	assert.Error(t, queue.Lock())
+	assert.NoError(t, queue.Unlock())
+	assert.Error(t, queue.Unlock())
+
+	// an expired lock can be taken over
+	assert.NoError(t, queue.Lock())
+	time.Sleep(150 * time.Millisecond)
+	assert.NoError(t, queue.Lock())
+}
@@ -0,0 +1,451 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"sort"
+	"sync"
+
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// inMemTrainingsRepository is a repository kept in memory, for running the trainer without mongo.
+// Records are copied on the way in and out, so callers see the same behavior as with trainingsRepository:
+// changes to a record are only persisted by calling Store(), and lookups of missing records return mgo.ErrNotFound.
+type inMemTrainingsRepository struct {
+	mtx     sync.RWMutex
+	records []*TrainingRecord // in insertion order
+}
+
+// inMemJobHistoryRepository is a jobHistoryRepository kept in memory.
+type inMemJobHistoryRepository struct {
+	mtx     sync.RWMutex
+	entries []*JobHistoryEntry
+}
+
+// newInMemTrainingsRepository creates a new in-memory training repo.
+func newInMemTrainingsRepository() repository {
+	return &inMemTrainingsRepository{}
+}
+
+// newInMemJobHistoryRepository creates a new in-memory repo for job status history entries.
+func newInMemJobHistoryRepository() jobHistoryRepository {
+	return &inMemJobHistoryRepository{}
+}
+
+// copyRecord round-trips the record through bson, the same way it would be stored in and loaded from mongo
+func copyRecord(tr *TrainingRecord) (*TrainingRecord, error) {
+	data, err := bson.Marshal(tr)
+	if err != nil {
+		return nil, err
+	}
+	c := &TrainingRecord{}
+	if err := bson.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *inMemTrainingsRepository) Store(t *TrainingRecord) error {
+	c, err := copyRecord(t)
+	if err != nil {
+		logWith(t.TrainingID, t.UserID).Errorf("Error storing training record: %s", err.Error())
+		return err
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if t.ID == "" {
+		// like an insert into mongo, this does not update the ID of the caller's record
+		c.ID = bson.NewObjectId()
+		r.records = append(r.records, c)
+		return nil
+	}
+	for i, existing := range r.records {
+		if existing.ID == t.ID {
+			r.records[i] = c
+			return nil
+		}
+	}
+	logWith(t.TrainingID, t.UserID).Errorf("Error storing training record: %s", mgo.ErrNotFound.Error())
+	return mgo.ErrNotFound
+}
+
+// find returns the first record that has not been deleted for the training, the caller must hold r.mtx
+func (r *inMemTrainingsRepository) find(trainingID string) *TrainingRecord {
+	for _, tr := range r.records {
+		if tr.TrainingID == trainingID && !tr.Deleted {
+			return tr
+		}
+	}
+	return nil
+}
+
+func (r *inMemTrainingsRepository) Find(trainingID string) (*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	tr := r.find(trainingID)
+	if tr == nil {
+		logWithTraining(trainingID).Debugf("Cannot retrieve training record")
+		return nil, mgo.ErrNotFound
+	}
+	return copyRecord(tr)
+}
+
+func (r *inMemTrainingsRepository) FindTrainingStatus(trainingID string) (*grpc_trainer_v2.TrainingStatus, error) {
+	tr, err := r.Find(trainingID)
+	if err != nil {
+		return nil, err
+	}
+	if tr.TrainingStatus == nil {
+		return &grpc_trainer_v2.TrainingStatus{}, nil
+	}
+	return tr.TrainingStatus, nil
+}
+
+func (r *inMemTrainingsRepository) FindTrainingStatusID(trainingID string) (grpc_trainer_v2.Status, error) {
+	tr, err := r.Find(trainingID)
+	if err != nil {
+		return -1, err
+	}
+	if tr.TrainingStatus != nil {
+		return tr.TrainingStatus.Status, nil
+	}
+	return grpc_trainer_v2.Status_NOT_STARTED, nil
+}
+
+func (r *inMemTrainingsRepository) FindTrainingSummaryMetricsString(trainingID string) (string, error) {
+	tr, err := r.Find(trainingID)
+	if err != nil {
+		return "", err
+	}
+	if tr.TrainingStatus != nil {
+		return tr.Metrics.String(), nil
+	}
+	return "", nil
+}
+
+func (r *inMemTrainingsRepository) FindAll(userID string) ([]*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*TrainingRecord
+	for _, tr := range r.records {
+		if tr.UserID == userID && !tr.Deleted {
+			c, err := copyRecord(tr)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, c)
+		}
+	}
+
+	// newest submission first, comparing the timestamps as strings like mongo does
+	submitted := func(tr *TrainingRecord) string {
+		if tr.TrainingStatus == nil {
+			return ""
+		}
+		return tr.TrainingStatus.SubmissionTimestamp
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return submitted(result[i]) > submitted(result[j])
+	})
+	return result, nil
+}
+
+func (r *inMemTrainingsRepository) FindTrainings(q *trainingsQuery) ([]*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*TrainingRecord
+	for _, tr := range r.records {
+		if q.matches(tr) {
+			c, err := copyRecord(tr)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return q.before(q.sortKey(result[i]), result[i].TrainingID, q.sortKey(result[j]), result[j].TrainingID)
+	})
+	if q.Limit > 0 && len(result) > q.Limit {
+		result = result[:q.Limit]
+	}
+	return result, nil
+}
+
+func (r *inMemTrainingsRepository) FindCurrentlyRunningTrainings(limit int) ([]*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	// most recently created records first, and only the fields selected by trainingsRepository
+	var result []*TrainingRecord
+	for i := len(r.records) - 1; i >= 0; i-- {
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		tr := r.records[i]
+		if tr.Deleted {
+			continue
+		}
+		c, err := copyRecord(tr)
+		if err != nil {
+			return nil, err
+		}
+		selected := &TrainingRecord{
+			ID:             c.ID,
+			TrainingID:     c.TrainingID,
+			UserID:         c.UserID,
+			TrainingStatus: c.TrainingStatus,
+		}
+		if c.Training != nil {
+			selected.Training = &grpc_trainer_v2.Training{Resources: c.Training.Resources}
+		}
+		result = append(result, selected)
+	}
+	return result, nil
+}
+
+func (r *inMemTrainingsRepository) FindWaitingDependents(trainingID string) ([]*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*TrainingRecord
+	for _, tr := range r.records {
+		if tr.Deleted || tr.Training == nil || tr.TrainingStatus == nil || tr.TrainingStatus.Status != grpc_trainer_v2.Status_WAITING {
+			continue
+		}
+		for _, parentID := range tr.Training.DependsOn {
+			if parentID == trainingID {
+				c, err := copyRecord(tr)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, c)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemTrainingsRepository) FindDeletedBefore(timestamp string, limit int) ([]*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*TrainingRecord
+	for _, tr := range r.records {
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		if !tr.Deleted {
+			continue
+		}
+		deleted := tr.DeletedTimestamp
+		if deleted == "" && tr.TrainingStatus != nil {
+			deleted = tr.TrainingStatus.SubmissionTimestamp
+		}
+		if deleted == "" || deleted >= timestamp {
+			continue
+		}
+		c, err := copyRecord(tr)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+func (r *inMemTrainingsRepository) FindFinishedBefore(timestamp string, keepLabel string, limit int) ([]*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*TrainingRecord
+	for _, tr := range r.records {
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+		if tr.Deleted || tr.TrainingStatus == nil {
+			continue
+		}
+		status := tr.TrainingStatus.Status
+		if status != grpc_trainer_v2.Status_COMPLETED && status != grpc_trainer_v2.Status_FAILED {
+			continue
+		}
+		completed := tr.TrainingStatus.CompletionTimestamp
+		if completed == "" || completed >= timestamp {
+			continue
+		}
+		if keepLabel != "" && tr.Labels[keepLabel] == "true" {
+			continue
+		}
+		c, err := copyRecord(tr)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+func (r *inMemTrainingsRepository) FindAllStatuses(userID string) ([]*TrainingRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*TrainingRecord
+	for _, tr := range r.records {
+		if tr.UserID == userID && !tr.Deleted {
+			c := &TrainingRecord{TrainingID: tr.TrainingID}
+			if tr.TrainingStatus != nil {
+				c.TrainingStatus = &grpc_trainer_v2.TrainingStatus{Status: tr.TrainingStatus.Status}
+			}
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemTrainingsRepository) Delete(trainingID string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	// Perform a soft delete that retains the same details as trainingsRepository.Delete()
+	existing := r.find(trainingID)
+	if existing == nil {
+		logWithTraining(trainingID).Debugf("Unable to find training record for (soft-)deletion, ID %s", trainingID)
+		return mgo.ErrNotFound
+	}
+
+	var resources *grpc_trainer_v2.ResourceRequirements
+	var status grpc_trainer_v2.TrainingStatus
+	var framework *grpc_trainer_v2.Framework
+	if existing.Training != nil {
+		resources = existing.Training.Resources
+	}
+	if existing.TrainingStatus != nil {
+		status = *existing.TrainingStatus
+	}
+	if existing.ModelDefinition != nil {
+		framework = existing.ModelDefinition.Framework
+	}
+	newRecord := &TrainingRecord{
+		ID:         existing.ID,
+		TrainingID: trainingID,
+		UserID:     existing.UserID,
+		JobID:      existing.JobID,
+		ModelDefinition: &grpc_trainer_v2.ModelDefinition{
+			Framework: framework,
+		},
+		Training: &grpc_trainer_v2.Training{
+			Resources: resources,
+		},
+		TrainingStatus: &grpc_trainer_v2.TrainingStatus{
+			Status:                 status.Status,
+			ErrorCode:              status.ErrorCode,
+			SubmissionTimestamp:    status.SubmissionTimestamp,
+			CompletionTimestamp:    status.CompletionTimestamp,
+			DownloadStartTimestamp: status.DownloadStartTimestamp,
+			ProcessStartTimestamp:  status.ProcessStartTimestamp,
+			StoreStartTimestamp:    status.StoreStartTimestamp,
+		},
+		Deleted:          true,
+		DeletedTimestamp: trainerClient.CurrentTimestampAsString(),
+	}
+	for i, tr := range r.records {
+		if tr == existing {
+			r.records[i] = newRecord
+		}
+	}
+	return nil
+}
+
+func (r *inMemTrainingsRepository) Purge(trainingID string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var kept []*TrainingRecord
+	for _, tr := range r.records {
+		if tr.TrainingID != trainingID {
+			kept = append(kept, tr)
+		}
+	}
+	r.records = kept
+	return nil
+}
+
+func (r *inMemTrainingsRepository) Close() {
+}
+
+func (r *inMemJobHistoryRepository) RecordJobStatus(e *JobHistoryEntry) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	// like the upsert in trainingsRepository, an identical entry is only recorded once
+	for _, existing := range r.entries {
+		if existing.TrainingID == e.TrainingID && existing.Timestamp == e.Timestamp && existing.Status == e.Status &&
+			existing.StatusMessage == e.StatusMessage && existing.ErrorCode == e.ErrorCode {
+			return nil
+		}
+	}
+	c := *e
+	c.ID = bson.NewObjectId()
+	r.entries = append(r.entries, &c)
+	return nil
+}
+
+func (r *inMemJobHistoryRepository) GetJobStatusHistory(trainingID string) []*JobHistoryEntry {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*JobHistoryEntry
+	for _, e := range r.entries {
+		if trainingID == "" || e.TrainingID == trainingID {
+			c := *e
+			result = append(result, &c)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp < result[j].Timestamp
+	})
+	return result
+}
+
+func (r *inMemJobHistoryRepository) DeleteJobStatusHistory(trainingID string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var kept []*JobHistoryEntry
+	for _, e := range r.entries {
+		if e.TrainingID != trainingID {
+			kept = append(kept, e)
+		}
+	}
+	r.entries = kept
+	return nil
+}
+
+func (r *inMemJobHistoryRepository) Close() {
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/mgo.v2"
+)
+
+func TestInMemRepository(t *testing.T) {
+	r := newInMemTrainingsRepository()
+
+	tr := &TrainingRecord{
+		TrainingID: "training-1",
+		UserID:     "user-1",
+		Training: &grpc_trainer_v2.Training{
+			Command: "python train.py",
+			Resources: &grpc_trainer_v2.ResourceRequirements{
+				Gpus: 1,
+			},
+		},
+		TrainingStatus: &grpc_trainer_v2.TrainingStatus{
+			Status:              grpc_trainer_v2.Status_QUEUED,
+			SubmissionTimestamp: "1000",
+		},
+	}
+	assert.NoError(t, r.Store(tr))
+
+	// changes are only visible after storing the record again
+	found, err := r.Find("training-1")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, found.ID)
+	found.TrainingStatus.Status = grpc_trainer_v2.Status_PENDING
+	status, _ := r.FindTrainingStatusID("training-1")
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, status)
+
+	assert.NoError(t, r.Store(found))
+	status, _ = r.FindTrainingStatusID("training-1")
+	assert.Equal(t, grpc_trainer_v2.Status_PENDING, status)
+
+	_, err = r.Find("missing")
+	assert.Equal(t, mgo.ErrNotFound, err)
+
+	assert.NoError(t, r.Store(&TrainingRecord{
+		TrainingID:     "training-2",
+		UserID:         "user-1",
+		TrainingStatus: &grpc_trainer_v2.TrainingStatus{SubmissionTimestamp: "2000"},
+	}))
+	all, err := r.FindAll("user-1")
+	assert.NoError(t, err)
+	if assert.Len(t, all, 2) {
+		assert.Equal(t, "training-2", all[0].TrainingID)
+		assert.Equal(t, "training-1", all[1].TrainingID)
+	}
+	statuses, err := r.FindAllStatuses("user-1")
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	for _, tr := range statuses {
+		if tr.TrainingID == "training-1" {
+			assert.Equal(t, grpc_trainer_v2.Status_PENDING, tr.TrainingStatus.Status)
+			assert.Empty(t, tr.TrainingStatus.SubmissionTimestamp, "only the status is loaded")
+			assert.Empty(t, tr.UserID)
+		}
+	}
+
+	running, err := r.FindCurrentlyRunningTrainings(10)
+	assert.NoError(t, err)
+	if assert.Len(t, running, 2) {
+		assert.Equal(t, "training-2", running[0].TrainingID)
+		assert.Equal(t, "training-1", running[1].TrainingID)
+		assert.Empty(t, running[1].Training.Command)
+		assert.EqualValues(t, 1, running[1].Training.Resources.Gpus)
+	}
+	running, _ = r.FindCurrentlyRunningTrainings(1)
+	assert.Len(t, running, 1)
+
+	// deleted records are no longer returned
+	assert.NoError(t, r.Delete("training-1"))
+	_, err = r.Find("training-1")
+	assert.Equal(t, mgo.ErrNotFound, err)
+	all, _ = r.FindAll("user-1")
+	assert.Len(t, all, 1)
+	assert.Equal(t, mgo.ErrNotFound, r.Delete("training-1"))
+}
+
+func TestInMemJobHistoryRepository(t *testing.T) {
+	r := newInMemJobHistoryRepository()
+
+	e1 := &JobHistoryEntry{TrainingID: "training-1", Timestamp: "2000", Status: grpc_trainer_v2.Status_PENDING}
+	e2 := &JobHistoryEntry{TrainingID: "training-1", Timestamp: "1000", Status: grpc_trainer_v2.Status_QUEUED}
+	e3 := &JobHistoryEntry{TrainingID: "training-2", Timestamp: "1500", Status: grpc_trainer_v2.Status_QUEUED}
+	for _, e := range []*JobHistoryEntry{e1, e2, e3, e1} {
+		assert.NoError(t, r.RecordJobStatus(e))
+	}
+
+	history := r.GetJobStatusHistory("training-1")
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, grpc_trainer_v2.Status_QUEUED, history[0].Status)
+		assert.Equal(t, grpc_trainer_v2.Status_PENDING, history[1].Status)
+	}
+	assert.Len(t, r.GetJobStatusHistory(""), 3)
+}
@@ -0,0 +1,244 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/mgo.v2"
+)
+
+// maxLabels is the number of labels a training can have
+const maxLabels = 20
+
+var (
+	// labels are copied onto the learner pods, so they have to be valid Kubernetes labels. Keys may not contain
+	// dots, since they are also field names in mongo.
+	labelKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_]{0,61}[A-Za-z0-9])?$`)
+	labelValueRegexp = regexp.MustCompile(`^([A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?)?$`)
+
+	// labels that FfDL sets on the Kubernetes objects of a training itself
+	reservedLabels = map[string]bool{
+		"training_id": true,
+		"user_id":     true,
+		"gpu_type":    true,
+		"app":         true,
+		"service":     true,
+	}
+)
+
+// validateLabels returns a message describing what is wrong with the labels of a training, or ""
+func validateLabels(labels map[string]string) string {
+	if len(labels) > maxLabels {
+		return fmt.Sprintf("A training can have at most %d labels", maxLabels)
+	}
+	// check the keys in order, so the message does not change between calls
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if !labelKeyRegexp.MatchString(k) {
+			return fmt.Sprintf("Label key '%s' must be at most 63 letters, digits, '-' or '_', starting and ending with a letter or digit", k)
+		}
+		if reservedLabels[k] {
+			return fmt.Sprintf("Label key '%s' is reserved", k)
+		}
+		if !labelValueRegexp.MatchString(labels[k]) {
+			return fmt.Sprintf("Value of label '%s' must be at most 63 letters, digits, '-', '_' or '.', starting and ending with a letter or digit", k)
+		}
+	}
+	return ""
+}
+
+// labelRequirement is a single term of a label selector
+type labelRequirement struct {
+	Key string
+	// Value is compared if Exists is true, otherwise the requirement is that the label is not set
+	Value    string
+	Exists   bool
+	Negated  bool
+	HasValue bool
+}
+
+// parseLabelSelector parses comma separated terms of the form key=value, key==value, key!=value, key or !key.
+// A training matches the selector if it matches all of its terms.
+func parseLabelSelector(selector string) ([]labelRequirement, error) {
+	var reqs []labelRequirement
+	if strings.TrimSpace(selector) == "" {
+		return reqs, nil
+	}
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		var r labelRequirement
+		switch {
+		case strings.Contains(term, "!="):
+			parts := strings.SplitN(term, "!=", 2)
+			r = labelRequirement{Key: parts[0], Value: parts[1], Exists: true, Negated: true, HasValue: true}
+		case strings.Contains(term, "=="):
+			parts := strings.SplitN(term, "==", 2)
+			r = labelRequirement{Key: parts[0], Value: parts[1], Exists: true, HasValue: true}
+		case strings.Contains(term, "="):
+			parts := strings.SplitN(term, "=", 2)
+			r = labelRequirement{Key: parts[0], Value: parts[1], Exists: true, HasValue: true}
+		case strings.HasPrefix(term, "!"):
+			r = labelRequirement{Key: strings.TrimPrefix(term, "!")}
+		default:
+			r = labelRequirement{Key: term, Exists: true}
+		}
+		r.Key = strings.TrimSpace(r.Key)
+		r.Value = strings.TrimSpace(r.Value)
+		if !labelKeyRegexp.MatchString(r.Key) || !labelValueRegexp.MatchString(r.Value) {
+			return nil, fmt.Errorf("invalid term '%s' in label selector", term)
+		}
+		reqs = append(reqs, r)
+	}
+	return reqs, nil
+}
+
+// matches returns true if the labels meet the requirement
+func (r labelRequirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch {
+	case !r.Exists:
+		return !ok
+	case !r.HasValue:
+		return ok
+	case r.Negated:
+		// like in Kubernetes, trainings without the label match key!=value
+		return !ok || value != r.Value
+	default:
+		return ok && value == r.Value
+	}
+}
+
+// SetTrainingJobLabels replaces the labels of a training. The labels of the learner pods of a running training are
+// not changed.
+func (s *trainerService) SetTrainingJobLabels(ctx context.Context, req *grpc_trainer_v2.LabelsRequest) (*grpc_trainer_v2.LabelsResponse, error) {
+	logr := logger.LocLogger(logWith(req.TrainingId, req.UserId))
+	logr.Debugf("SetTrainingJobLabels called for training %s", req.TrainingId)
+
+	if msg := validateLabels(req.Labels); msg != "" {
+		return nil, gerrf(codes.InvalidArgument, msg)
+	}
+
+	tr, err := s.repo.Find(req.TrainingId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, gerrf(codes.NotFound, "Training with id %s not found.", req.TrainingId)
+		}
+		logr.WithError(err).Errorf("Cannot retrieve training record")
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if tr.UserID != req.UserId {
+		msg := fmt.Sprint("User does not have permission to update training data")
+		logr.Error(msg)
+		return nil, gerrf(codes.PermissionDenied, msg)
+	}
+
+	tr.Labels = req.Labels
+	if err := s.repo.Store(tr); err != nil {
+		logr.WithError(err).Errorf("Failed to store labels of training %s", req.TrainingId)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	// the usage of a finished training is grouped by its current labels
+	if err := s.usageRepo.SetLabels(req.TrainingId, req.Labels); err != nil {
+		logr.WithError(err).Errorf("Failed to store labels of the usage of training %s", req.TrainingId)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	return &grpc_trainer_v2.LabelsResponse{TrainingId: req.TrainingId, Labels: req.Labels}, nil
+}
+
+// findLabeledTrainings returns the trainings of a user selected by the label selector of a bulk request
+func (s *trainerService) findLabeledTrainings(req *grpc_trainer_v2.BulkRequest) ([]*TrainingRecord, error) {
+	// an empty selector would select all trainings of the user
+	if strings.TrimSpace(req.LabelSelector) == "" {
+		return nil, gerrf(codes.InvalidArgument, "A label selector is required")
+	}
+	q, msg := newTrainingsQuery(&grpc_trainer_v2.GetAllRequest{UserId: req.UserId, LabelSelector: req.LabelSelector})
+	if msg != "" {
+		return nil, gerrf(codes.InvalidArgument, msg)
+	}
+	trainings, err := s.repo.FindTrainings(q)
+	if err != nil {
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	return trainings, nil
+}
+
+// HaltTrainingJobs halts the trainings of a user whose labels match a selector, like halting each of them. Trainings
+// that finished already are left alone.
+func (s *trainerService) HaltTrainingJobs(ctx context.Context, req *grpc_trainer_v2.BulkRequest) (*grpc_trainer_v2.BulkResponse, error) {
+	logr := logger.LocLogger(logWith("", req.UserId))
+	logr.Debugf("HaltTrainingJobs called with label selector %s", req.LabelSelector)
+
+	trainings, err := s.findLabeledTrainings(req)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot find the trainings to halt")
+		return nil, err
+	}
+	resp := &grpc_trainer_v2.BulkResponse{}
+	for _, tr := range trainings {
+		if tr.TrainingStatus != nil && isFinalStatus(tr.TrainingStatus.Status) {
+			continue
+		}
+		result := &grpc_trainer_v2.BulkResult{TrainingId: tr.TrainingID}
+		_, err := s.UpdateTrainingJob(ctx, &grpc_trainer_v2.UpdateRequest{
+			TrainingId:    tr.TrainingID,
+			UserId:        req.UserId,
+			Status:        grpc_trainer_v2.Status_HALTED,
+			StatusMessage: "Halted by user",
+		})
+		if err != nil {
+			logr.WithError(err).Errorf("Cannot halt training %s", tr.TrainingID)
+			result.Error = grpcErrorDesc(err)
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+// DeleteTrainingJobs deletes the trainings of a user whose labels match a selector, like deleting each of them.
+func (s *trainerService) DeleteTrainingJobs(ctx context.Context, req *grpc_trainer_v2.BulkRequest) (*grpc_trainer_v2.BulkResponse, error) {
+	logr := logger.LocLogger(logWith("", req.UserId))
+	logr.Debugf("DeleteTrainingJobs called with label selector %s", req.LabelSelector)
+
+	trainings, err := s.findLabeledTrainings(req)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot find the trainings to delete")
+		return nil, err
+	}
+	resp := &grpc_trainer_v2.BulkResponse{}
+	for _, tr := range trainings {
+		result := &grpc_trainer_v2.BulkResult{TrainingId: tr.TrainingID}
+		_, err := s.DeleteTrainingJob(ctx, &grpc_trainer_v2.DeleteRequest{TrainingId: tr.TrainingID, UserId: req.UserId})
+		if err != nil {
+			logr.WithError(err).Errorf("Cannot delete training %s", tr.TrainingID)
+			result.Error = grpcErrorDesc(err)
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
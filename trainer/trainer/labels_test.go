@@ -0,0 +1,225 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidateLabels(t *testing.T) {
+	assert.Empty(t, validateLabels(nil))
+	assert.Empty(t, validateLabels(map[string]string{"team": "vision", "dataset": "v3.1", "ticket": ""}))
+	assert.NotEmpty(t, validateLabels(map[string]string{"team.name": "vision"}))
+	assert.NotEmpty(t, validateLabels(map[string]string{"team": "vision/cv"}))
+	assert.NotEmpty(t, validateLabels(map[string]string{"training_id": "x"}))
+
+	many := make(map[string]string)
+	for i := 0; i <= maxLabels; i++ {
+		many[fmt.Sprintf("label-%d", i)] = "x"
+	}
+	assert.NotEmpty(t, validateLabels(many))
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	reqs, err := parseLabelSelector("team=vision, dataset!=v2,ticket,!draft,env==prod")
+	assert.NoError(t, err)
+	assert.Equal(t, []labelRequirement{
+		{Key: "team", Value: "vision", Exists: true, HasValue: true},
+		{Key: "dataset", Value: "v2", Exists: true, Negated: true, HasValue: true},
+		{Key: "ticket", Exists: true},
+		{Key: "draft"},
+		{Key: "env", Value: "prod", Exists: true, HasValue: true},
+	}, reqs)
+
+	labels := map[string]string{"team": "vision", "dataset": "v3", "ticket": "123", "env": "prod"}
+	for _, r := range reqs {
+		assert.True(t, r.matches(labels), r.Key)
+	}
+	assert.False(t, reqs[0].matches(map[string]string{"team": "nlp"}))
+	assert.True(t, reqs[1].matches(nil))
+	assert.False(t, reqs[3].matches(map[string]string{"draft": ""}))
+
+	reqs, err = parseLabelSelector(" ")
+	assert.NoError(t, err)
+	assert.Empty(t, reqs)
+
+	_, err = parseLabelSelector("team=vision,")
+	assert.Error(t, err)
+	_, err = parseLabelSelector("team=vision/cv")
+	assert.Error(t, err)
+}
+
+func TestGetAllTrainingsJobsLabelSelector(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	for id, labels := range map[string]map[string]string{
+		"a": {"team": "vision", "dataset": "v3"},
+		"b": {"team": "vision", "dataset": "v2"},
+		"c": {"team": "nlp"},
+		"d": nil,
+	} {
+		tr := createListedRecord(id, "model-"+id, "tensorflow", 1500000000000, grpc_trainer_v2.Status_COMPLETED)
+		tr.Labels = labels
+		assert.NoError(t, s.repo.Store(tr))
+	}
+
+	for selector, expected := range map[string][]string{
+		"team=vision,dataset=v3":  {"a"},
+		"team=vision,dataset!=v3": {"b"},
+		"dataset!=v3":             {"d", "c", "b"},
+		"!team":                   {"d"},
+		"team":                    {"c", "b", "a"},
+	} {
+		resp, err := s.GetAllTrainingsJobs(context.Background(), &grpc_trainer_v2.GetAllRequest{
+			UserId:        "alice",
+			LabelSelector: selector,
+		})
+		assert.NoError(t, err, selector)
+		assert.Equal(t, expected, listedIDs(resp), selector)
+	}
+
+	resp, err := s.GetAllTrainingsJobs(context.Background(), &grpc_trainer_v2.GetAllRequest{UserId: "alice", Sort: "name"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "vision", "dataset": "v3"}, resp.Jobs[0].Labels)
+
+	_, err = s.GetAllTrainingsJobs(context.Background(), &grpc_trainer_v2.GetAllRequest{
+		UserId:        "alice",
+		LabelSelector: "team=vision,team=nlp",
+	})
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
+
+func TestSetTrainingJobLabels(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	tr := createParentRecord("labeled", "alice", grpc_trainer_v2.Status_PROCESSING)
+	tr.Labels = map[string]string{"team": "vision", "dataset": "v2"}
+	assert.NoError(t, s.repo.Store(tr))
+
+	resp, err := s.SetTrainingJobLabels(context.Background(), &grpc_trainer_v2.LabelsRequest{
+		TrainingId: "labeled",
+		UserId:     "alice",
+		Labels:     map[string]string{"team": "vision", "dataset": "v3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "v3", resp.Labels["dataset"])
+	tr, err = s.repo.Find("labeled")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "vision", "dataset": "v3"}, tr.Labels)
+
+	_, err = s.SetTrainingJobLabels(context.Background(), &grpc_trainer_v2.LabelsRequest{
+		TrainingId: "labeled",
+		UserId:     "bob",
+		Labels:     map[string]string{"team": "nlp"},
+	})
+	assert.Equal(t, codes.PermissionDenied, grpcCode(err))
+
+	_, err = s.SetTrainingJobLabels(context.Background(), &grpc_trainer_v2.LabelsRequest{
+		TrainingId: "labeled",
+		UserId:     "alice",
+		Labels:     map[string]string{"user_id": "bob"},
+	})
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+
+	_, err = s.SetTrainingJobLabels(context.Background(), &grpc_trainer_v2.LabelsRequest{
+		TrainingId: "missing",
+		UserId:     "alice",
+	})
+	assert.Equal(t, codes.NotFound, grpcCode(err))
+}
+
+func TestBulkTrainingJobs(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	s.lcm = &fakeLCM{}
+	s.tds = &fakeTDS{}
+	for id, status := range map[string]grpc_trainer_v2.Status{
+		"running":   grpc_trainer_v2.Status_PROCESSING,
+		"completed": grpc_trainer_v2.Status_COMPLETED,
+		"other":     grpc_trainer_v2.Status_PROCESSING,
+	} {
+		tr := createParentRecord(id, "alice", status)
+		tr.Labels = map[string]string{"team": "vision"}
+		if id == "other" {
+			tr.Labels["team"] = "nlp"
+		}
+		assert.NoError(t, s.repo.Store(tr))
+	}
+	bob := createParentRecord("bob", "bob", grpc_trainer_v2.Status_PROCESSING)
+	bob.Labels = map[string]string{"team": "vision"}
+	assert.NoError(t, s.repo.Store(bob))
+
+	// finished trainings are not halted
+	resp, err := s.HaltTrainingJobs(context.Background(), &grpc_trainer_v2.BulkRequest{UserId: "alice", LabelSelector: "team=vision"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*grpc_trainer_v2.BulkResult{{TrainingId: "running"}}, resp.Results)
+	for id, expected := range map[string]grpc_trainer_v2.Status{
+		"running": grpc_trainer_v2.Status_HALTED,
+		"other":   grpc_trainer_v2.Status_PROCESSING,
+		"bob":     grpc_trainer_v2.Status_PROCESSING,
+	} {
+		tr, err := s.repo.Find(id)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, tr.TrainingStatus.Status, id)
+	}
+
+	resp, err = s.DeleteTrainingJobs(context.Background(), &grpc_trainer_v2.BulkRequest{UserId: "alice", LabelSelector: "team=vision"})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Results, 2) {
+		for _, r := range resp.Results {
+			assert.Empty(t, r.Error, r.TrainingId)
+			_, err := s.repo.Find(r.TrainingId)
+			assert.Error(t, err, r.TrainingId)
+		}
+	}
+	for _, id := range []string{"other", "bob"} {
+		_, err := s.repo.Find(id)
+		assert.NoError(t, err, id)
+	}
+
+	// a selector is required, so that the trainings of a user are not all deleted by accident
+	for _, selector := range []string{"", " ", "team=vision,team=nlp"} {
+		_, err = s.DeleteTrainingJobs(context.Background(), &grpc_trainer_v2.BulkRequest{UserId: "alice", LabelSelector: selector})
+		assert.Equal(t, codes.InvalidArgument, grpcCode(err), selector)
+		_, err = s.HaltTrainingJobs(context.Background(), &grpc_trainer_v2.BulkRequest{UserId: "alice", LabelSelector: selector})
+		assert.Equal(t, codes.InvalidArgument, grpcCode(err), selector)
+	}
+}
+
+func TestCreateTrainingJobLabels(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	// the job waits for its parent, so it is stored without going through the queue
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	req := createDependentRequest("parent")
+	req.Labels = map[string]string{"team": "vision"}
+	resp, err := s.CreateTrainingJob(context.Background(), req)
+	assert.NoError(t, err)
+	tr, err := s.repo.Find(resp.TrainingId)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "vision"}, tr.Labels)
+
+	req = createDependentRequest("parent")
+	req.Labels = map[string]string{"team name": "vision"}
+	_, err = s.CreateTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+)
+
+// maximum number of environment variables a user may set for a training, including the ones set from secrets
+const maxLearnerEnvVars = 100
+
+var (
+	envVarName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// names of kubernetes secrets and their keys
+	secretName = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+	secretKey  = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+)
+
+// reservedEnvVars are the variables FfDL sets in the learner container, which users cannot set
+var reservedEnvVars = map[string]bool{
+	"MODEL_DIR":              true,
+	"DATA_DIR":               true,
+	"RESULT_DIR":             true,
+	"RESULT_BUCKET_DIR":      true,
+	"LOG_DIR":                true,
+	"CHECKPOINT_DIR":         true,
+	"RESUME_CHECKPOINT_DIR":  true,
+	"RESUME_FROM_CHECKPOINT": true,
+	"JOB_STATE_DIR":          true,
+	"TRAINING_JOB":           true,
+	"TRAINING_COMMAND":       true,
+	"TRAINING_ID":            true,
+	"LEARNER_ID":             true,
+	"GPU_COUNT":              true,
+	"NUM_LEARNERS":           true,
+	"LEARNER_NAME_PREFIX":    true,
+	"MASTER_ADDR":            true,
+	"MASTER_PORT":            true,
+	"WORLD_SIZE":             true,
+	"RANK":                   true,
+	"PATH":                   true,
+	"HOME":                   true,
+	"PYTHONPATH":             true,
+	inputDataIDsEnvVar:       true,
+	outputDataIDsEnvVar:      true,
+
+	// the GPUs of the learner are selected by the device plugin and the container runtime
+	"NVIDIA_VISIBLE_DEVICES":     true,
+	"NVIDIA_DRIVER_CAPABILITIES": true,
+	"CUDA_VISIBLE_DEVICES":       true,
+}
+
+// reservedEnvVarPrefixes are the prefixes of variables FfDL sets or removes in the learner container
+var reservedEnvVarPrefixes = []string{
+	"HP_",
+	"DATA_DIR_",
+	"RESULT_DIR_",
+	"DATA_STORE_",
+	"RESULT_STORE_",
+	"DOWNWARD_API_",
+	"DLAAS_",
+	"ALERTMANAGER",
+	"ETCD",
+	"GRAFANA",
+	"HOSTNAME",
+	"KUBERNETES",
+	"MONGO",
+	"PUSHGATEWAY",
+}
+
+// isReservedEnvVar returns whether a variable is on the denylist of variables users cannot set, which the deployment
+// can extend with the comma separated names in learner.env.denylist
+func isReservedEnvVar(name string) bool {
+	if reservedEnvVars[name] {
+		return true
+	}
+	for _, prefix := range reservedEnvVarPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	for _, denied := range strings.Split(viper.GetString(learnerEnvDenylistKey), ",") {
+		if strings.TrimSpace(denied) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateLearnerEnv returns a message describing what is wrong with the environment variables a user sets for the
+// learner of a training, or "".
+func validateLearnerEnv(env map[string]string, secretEnv map[string]*grpc_trainer_v2.SecretKeyRef) string {
+	if len(env)+len(secretEnv) > maxLearnerEnvVars {
+		return fmt.Sprintf("A training can set at most %d environment variables", maxLearnerEnvVars)
+	}
+
+	var names []string
+	for name := range env {
+		names = append(names, name)
+	}
+	for name := range secretEnv {
+		if _, ok := env[name]; ok {
+			return fmt.Sprintf("Environment variable '%s' is set in both env and secret_env", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !envVarName.MatchString(name) {
+			return fmt.Sprintf("'%s' is not a valid environment variable name", name)
+		}
+		if isReservedEnvVar(name) {
+			return fmt.Sprintf("Environment variable '%s' is reserved and cannot be set", name)
+		}
+		if ref, ok := secretEnv[name]; ok {
+			if ref == nil || !secretName.MatchString(ref.Secret) || len(ref.Secret) > 253 {
+				return fmt.Sprintf("Environment variable '%s' does not reference a valid secret name", name)
+			}
+			if !secretKey.MatchString(ref.Key) || len(ref.Key) > 253 {
+				return fmt.Sprintf("Environment variable '%s' does not reference a valid secret key", name)
+			}
+		}
+	}
+	return ""
+}
+
+// learnerSecretEnvVars returns the secret references of a training as the LCM expects them
+func learnerSecretEnvVars(secretEnv map[string]*grpc_trainer_v2.SecretKeyRef) map[string]*service.SecretKeyRef {
+	if len(secretEnv) == 0 {
+		return nil
+	}
+	refs := make(map[string]*service.SecretKeyRef, len(secretEnv))
+	for name, ref := range secretEnv {
+		refs[name] = &service.SecretKeyRef{Secret: ref.Secret, Key: ref.Key}
+	}
+	return refs
+}
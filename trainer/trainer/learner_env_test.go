@@ -0,0 +1,86 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidateLearnerEnv(t *testing.T) {
+	ref := func(secret, key string) *grpc_trainer_v2.SecretKeyRef {
+		return &grpc_trainer_v2.SecretKeyRef{Secret: secret, Key: key}
+	}
+
+	assert.Empty(t, validateLearnerEnv(nil, nil))
+	assert.Empty(t, validateLearnerEnv(
+		map[string]string{"NCCL_DEBUG": "INFO", "WANDB_MODE": "offline", "_private": ""},
+		map[string]*grpc_trainer_v2.SecretKeyRef{"WANDB_API_KEY": ref("wandb", "api_key")}))
+
+	for _, name := range []string{"DATA_DIR", "RESULT_DIR_CHECKPOINTS", "HP_LR", "DATA_STORE_APIKEY", "DLAAS_JOB_ID",
+		"KUBERNETES_SERVICE_HOST", "LEARNER_ID", "MASTER_ADDR", "RANK", "PATH", "NVIDIA_VISIBLE_DEVICES",
+		"NVIDIA_DRIVER_CAPABILITIES", "CUDA_VISIBLE_DEVICES", "1VAR", "MY-VAR", ""} {
+		assert.NotEmpty(t, validateLearnerEnv(map[string]string{name: "x"}, nil), name)
+		assert.NotEmpty(t, validateLearnerEnv(nil, map[string]*grpc_trainer_v2.SecretKeyRef{name: ref("s", "k")}), name)
+	}
+
+	// set twice
+	assert.NotEmpty(t, validateLearnerEnv(map[string]string{"TOKEN": "x"},
+		map[string]*grpc_trainer_v2.SecretKeyRef{"TOKEN": ref("s", "k")}))
+
+	for _, r := range []*grpc_trainer_v2.SecretKeyRef{nil, ref("", "k"), ref("My_Secret", "k"), ref("s", ""), ref("s", "a/b")} {
+		assert.NotEmpty(t, validateLearnerEnv(nil, map[string]*grpc_trainer_v2.SecretKeyRef{"TOKEN": r}), "%v", r)
+	}
+
+	viper.Set(learnerEnvDenylistKey, "LD_PRELOAD, CUDA_VISIBLE_DEVICES")
+	defer viper.Set(learnerEnvDenylistKey, "")
+	assert.NotEmpty(t, validateLearnerEnv(map[string]string{"CUDA_VISIBLE_DEVICES": "0"}, nil))
+	assert.Empty(t, validateLearnerEnv(map[string]string{"NCCL_DEBUG": "INFO"}, nil))
+}
+
+func TestRenderTrainingJobLearnerEnv(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	lcm := &fakeLCM{}
+	s.lcm = lcm
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_COMPLETED)))
+
+	req := createDependentRequest("parent")
+	req.Training.Env = map[string]string{"NCCL_DEBUG": "INFO"}
+	req.Training.SecretEnv = map[string]*grpc_trainer_v2.SecretKeyRef{
+		"WANDB_API_KEY": {Secret: "wandb", Key: "api_key"},
+	}
+	_, err := s.RenderTrainingJob(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.Len(t, lcm.rendered, 1) {
+		job := lcm.rendered[0]
+		assert.Equal(t, map[string]string{"NCCL_DEBUG": "INFO"}, job.LearnerEnvVars)
+		assert.Equal(t, map[string]*service.SecretKeyRef{"WANDB_API_KEY": {Secret: "wandb", Key: "api_key"}},
+			job.LearnerSecretEnvVars)
+		// the variables of the user are not shared with the helpers
+		assert.NotContains(t, job.EnvVars, "NCCL_DEBUG")
+	}
+
+	req.Training.Env = map[string]string{"DATA_DIR": "/tmp"}
+	_, err = s.RenderTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
@@ -0,0 +1,220 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+)
+
+const (
+	sortBySubmitted = "submitted"
+	sortByName      = "name"
+)
+
+// trainingsQuery selects a page of the trainings of a user, sorted by a key and then by training id
+type trainingsQuery struct {
+	UserID     string
+	Statuses   []grpc_trainer_v2.Status
+	Framework  string
+	NamePrefix string
+	// submission timestamps in the format of TrainingStatus.SubmissionTimestamp, empty if the range is open
+	SubmittedAfter  string
+	SubmittedBefore string
+	SortBy          string
+	Descending      bool
+	// maximum number of records to return, 0 for all
+	Limit int
+	// the position after which the page starts, nil for the first page
+	Cursor *pageCursor
+	// requirements on the labels, keys are not repeated
+	Labels []labelRequirement
+}
+
+// pageCursor is the position of the last training of a page, handed to the client as the next page token
+type pageCursor struct {
+	Sort       string `json:"s"`
+	Key        string `json:"k"`
+	TrainingID string `json:"id"`
+}
+
+func (q *trainingsQuery) sort() string {
+	if q.Descending {
+		return "-" + q.SortBy
+	}
+	return q.SortBy
+}
+
+// newTrainingsQuery returns the query for a GetAllRequest, or a message describing what is wrong with the request
+func newTrainingsQuery(req *grpc_trainer_v2.GetAllRequest) (*trainingsQuery, string) {
+	q := &trainingsQuery{
+		UserID:     req.UserId,
+		Statuses:   req.Status,
+		Framework:  req.Framework,
+		NamePrefix: req.NamePrefix,
+		SortBy:     sortBySubmitted,
+		Descending: true,
+		Limit:      int(req.PageSize),
+	}
+	if req.Sort != "" {
+		q.Descending = strings.HasPrefix(req.Sort, "-")
+		q.SortBy = strings.TrimPrefix(req.Sort, "-")
+		if q.SortBy != sortBySubmitted && q.SortBy != sortByName {
+			return nil, fmt.Sprintf("Cannot sort by '%s', sort by '%s' or '%s'", req.Sort, sortBySubmitted, sortByName)
+		}
+	}
+	if req.PageSize < 0 {
+		return nil, "Page size must not be negative"
+	}
+	if req.SubmittedAfter < 0 || req.SubmittedBefore < 0 {
+		return nil, "Submission times must not be negative"
+	}
+	if req.SubmittedAfter > 0 {
+		q.SubmittedAfter = strconv.FormatInt(req.SubmittedAfter, 10)
+	}
+	if req.SubmittedBefore > 0 {
+		q.SubmittedBefore = strconv.FormatInt(req.SubmittedBefore, 10)
+	}
+	labels, err := parseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, fmt.Sprintf("Invalid label selector: %s", err.Error())
+	}
+	seen := make(map[string]bool)
+	for _, l := range labels {
+		if seen[l.Key] {
+			return nil, fmt.Sprintf("Label %s is listed more than once in the label selector", l.Key)
+		}
+		seen[l.Key] = true
+	}
+	q.Labels = labels
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(req.PageToken)
+		if err != nil || cursor.Sort != q.sort() {
+			return nil, "Invalid page token, the token has to be used with the same sort order"
+		}
+		q.Cursor = cursor
+	}
+	return q, ""
+}
+
+func encodePageToken(c *pageCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	c := &pageCursor{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// sortKey returns the value of the field the query sorts by
+func (q *trainingsQuery) sortKey(tr *TrainingRecord) string {
+	switch q.SortBy {
+	case sortByName:
+		if tr.ModelDefinition != nil {
+			return tr.ModelDefinition.Name
+		}
+	default:
+		if tr.TrainingStatus != nil {
+			return tr.TrainingStatus.SubmissionTimestamp
+		}
+	}
+	return ""
+}
+
+// cursorAt returns the cursor pointing at the given training
+func (q *trainingsQuery) cursorAt(tr *TrainingRecord) *pageCursor {
+	return &pageCursor{
+		Sort:       q.sort(),
+		Key:        q.sortKey(tr),
+		TrainingID: tr.TrainingID,
+	}
+}
+
+// before returns true if a comes before b in the order of the query
+func (q *trainingsQuery) before(aKey, aID, bKey, bID string) bool {
+	if aKey == bKey {
+		aKey, bKey = aID, bID
+	}
+	if q.Descending {
+		return aKey > bKey
+	}
+	return aKey < bKey
+}
+
+// matches returns true if the training passes the filters of the query and comes after its cursor. Timestamps are
+// compared as strings, like mongo does.
+func (q *trainingsQuery) matches(tr *TrainingRecord) bool {
+	if tr.UserID != q.UserID || tr.Deleted {
+		return false
+	}
+	var status grpc_trainer_v2.Status
+	var submitted string
+	if tr.TrainingStatus != nil {
+		status = tr.TrainingStatus.Status
+		submitted = tr.TrainingStatus.SubmissionTimestamp
+	}
+	if len(q.Statuses) > 0 {
+		found := false
+		for _, s := range q.Statuses {
+			found = found || s == status
+		}
+		if !found {
+			return false
+		}
+	}
+	var name, framework string
+	if tr.ModelDefinition != nil {
+		name = tr.ModelDefinition.Name
+		if tr.ModelDefinition.Framework != nil {
+			framework = tr.ModelDefinition.Framework.Name
+		}
+	}
+	if q.Framework != "" && framework != q.Framework {
+		return false
+	}
+	if !strings.HasPrefix(name, q.NamePrefix) {
+		return false
+	}
+	if q.SubmittedAfter != "" && submitted < q.SubmittedAfter {
+		return false
+	}
+	if q.SubmittedBefore != "" && submitted >= q.SubmittedBefore {
+		return false
+	}
+	for _, l := range q.Labels {
+		if !l.matches(tr.Labels) {
+			return false
+		}
+	}
+	if q.Cursor != nil && !q.before(q.Cursor.Key, q.Cursor.TrainingID, q.sortKey(tr), tr.TrainingID) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func createListedRecord(id string, name string, framework string, submitted int64, status grpc_trainer_v2.Status) *TrainingRecord {
+	tr := createParentRecord(id, "alice", status)
+	tr.ModelDefinition.Name = name
+	tr.ModelDefinition.Framework.Name = framework
+	tr.TrainingStatus.SubmissionTimestamp = fmt.Sprintf("%d", submitted)
+	return tr
+}
+
+func listedIDs(resp *grpc_trainer_v2.GetAllResponse) []string {
+	var ids []string
+	for _, job := range resp.Jobs {
+		ids = append(ids, job.TrainingId)
+	}
+	return ids
+}
+
+func TestGetAllTrainingsJobsFiltered(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	for _, tr := range []*TrainingRecord{
+		createListedRecord("a", "mnist-1", "tensorflow", 1500000000000, grpc_trainer_v2.Status_COMPLETED),
+		createListedRecord("b", "mnist-2", "caffe", 1500000001000, grpc_trainer_v2.Status_FAILED),
+		createListedRecord("c", "cifar", "tensorflow", 1500000002000, grpc_trainer_v2.Status_PROCESSING),
+		createListedRecord("d", "mnist-3", "tensorflow", 1500000003000, grpc_trainer_v2.Status_COMPLETED),
+		createQuotaRecord("other", "bob", 1, 1, grpc_trainer_v2.Status_COMPLETED),
+	} {
+		assert.NoError(t, s.repo.Store(tr))
+	}
+
+	resp, err := s.GetAllTrainingsJobs(context.Background(), &grpc_trainer_v2.GetAllRequest{UserId: "alice"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d", "c", "b", "a"}, listedIDs(resp))
+	assert.Empty(t, resp.NextPageToken)
+
+	resp, err = s.GetAllTrainingsJobs(context.Background(), &grpc_trainer_v2.GetAllRequest{
+		UserId:          "alice",
+		Status:          []grpc_trainer_v2.Status{grpc_trainer_v2.Status_COMPLETED, grpc_trainer_v2.Status_FAILED},
+		NamePrefix:      "mnist",
+		SubmittedBefore: 1500000003000,
+		Sort:            "name",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, listedIDs(resp))
+
+	resp, err = s.GetAllTrainingsJobs(context.Background(), &grpc_trainer_v2.GetAllRequest{
+		UserId:         "alice",
+		Framework:      "tensorflow",
+		SubmittedAfter: 1500000002000,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d", "c"}, listedIDs(resp))
+}
+
+func TestGetAllTrainingsJobsPaged(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	for i, id := range []string{"a", "b", "c", "d", "e"} {
+		// b and c have the same name, the training id breaks the tie
+		name := fmt.Sprintf("model-%d", i)
+		if id == "c" {
+			name = "model-1"
+		}
+		assert.NoError(t, s.repo.Store(createListedRecord(id, name, "tensorflow", int64(1500000000000+i), grpc_trainer_v2.Status_COMPLETED)))
+	}
+
+	req := &grpc_trainer_v2.GetAllRequest{UserId: "alice", Sort: "-name", PageSize: 2}
+	var pages [][]string
+	for {
+		resp, err := s.GetAllTrainingsJobs(context.Background(), req)
+		assert.NoError(t, err)
+		pages = append(pages, listedIDs(resp))
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, [][]string{{"e", "d"}, {"c", "b"}, {"a"}}, pages)
+
+	// a token is only valid for the sort order it was issued for
+	req.Sort = "submitted"
+	_, err := s.GetAllTrainingsJobs(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+
+	_, err = s.GetAllTrainingsJobs(context.Background(), &grpc_trainer_v2.GetAllRequest{UserId: "alice", Sort: "status"})
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
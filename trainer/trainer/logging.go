@@ -38,3 +38,10 @@ func logWith(trainingID string, userID string) *log.Entry {
 	data[logger.LogkeyUserID] = userID
 	return &log.Entry{Logger: log.StandardLogger(), Data: data}
 }
+
+func logWithExperiment(experimentID string, userID string) *log.Entry {
+	data := logger.NewDlaaSLogData(logger.LogkeyTrainerService)
+	data["experiment_id"] = experimentID
+	data[logger.LogkeyUserID] = userID
+	return &log.Entry{Logger: log.StandardLogger(), Data: data}
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+)
+
+// configuredDuration returns the duration set for the given key, or 0 if it is unset or invalid
+func configuredDuration(key string) time.Duration {
+	d, err := time.ParseDuration(viper.GetString(key))
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
+// validateMaxDuration returns a message describing what is wrong with the max_duration of a training, or ""
+func validateMaxDuration(maxDuration string) string {
+	if maxDuration == "" {
+		return ""
+	}
+	d, err := time.ParseDuration(maxDuration)
+	if err != nil || d <= 0 {
+		return fmt.Sprintf("Training max_duration '%s' is not a positive duration such as '12h'", maxDuration)
+	}
+	if limit := configuredDuration(maxMaxDurationKey); limit > 0 && d > limit {
+		return fmt.Sprintf("Training max_duration must not exceed %s", limit)
+	}
+	return ""
+}
+
+// maxDuration returns the time a training may run once it left the queue, or 0 if it may run forever
+func maxDuration(t *grpc_trainer_v2.Training) time.Duration {
+	d, err := time.ParseDuration(t.MaxDuration)
+	if err != nil || d <= 0 {
+		d = configuredDuration(defaultMaxDurationKey)
+	}
+	// trainings submitted before the limit was lowered must not run longer than it either
+	if limit := configuredDuration(maxMaxDurationKey); limit > 0 && (d == 0 || d > limit) {
+		d = limit
+	}
+	return d
+}
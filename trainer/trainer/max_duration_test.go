@@ -0,0 +1,61 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestMaxDuration(t *testing.T) {
+	// sets the configuration defaults
+	newInMemTestService(t, map[string]*queueHandler{})
+
+	assert.EqualValues(t, 0, maxDuration(&grpc_trainer_v2.Training{}))
+	assert.Equal(t, 12*time.Hour, maxDuration(&grpc_trainer_v2.Training{MaxDuration: "12h"}))
+	assert.Empty(t, validateMaxDuration(""))
+	assert.Empty(t, validateMaxDuration("90m"))
+	assert.NotEmpty(t, validateMaxDuration("12"))
+	assert.NotEmpty(t, validateMaxDuration("-1h"))
+
+	viper.Set(defaultMaxDurationKey, "6h")
+	viper.Set(maxMaxDurationKey, "24h")
+	defer func() {
+		viper.Set(defaultMaxDurationKey, "0")
+		viper.Set(maxMaxDurationKey, "0")
+	}()
+	assert.Equal(t, 6*time.Hour, maxDuration(&grpc_trainer_v2.Training{}))
+	assert.Equal(t, 12*time.Hour, maxDuration(&grpc_trainer_v2.Training{MaxDuration: "12h"}))
+	assert.Equal(t, 24*time.Hour, maxDuration(&grpc_trainer_v2.Training{MaxDuration: "48h"}))
+	assert.NotEmpty(t, validateMaxDuration("48h"))
+}
+
+func TestCreateTrainingJobMaxDurationInvalid(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	req := createDependentRequest()
+	req.Training.MaxDuration = "forever"
+	_, err := s.CreateTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
@@ -0,0 +1,329 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	neturl "net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/storage"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// prefixes of the model definition locations the trainer fetches
+const (
+	gitLocationPrefix       = "git+"
+	datastoreLocationPrefix = "datastore://"
+)
+
+// how long fetching a model definition may take
+const modelFetchTimeout = 5 * time.Minute
+
+// how often the size of a git repository is checked while it is fetched
+const dirSizePollInterval = 500 * time.Millisecond
+
+// modelSource is a parsed model definition location, either a git repository or an object in a data store
+type modelSource struct {
+	gitURL string
+	gitRef string
+
+	datastoreID string
+	container   string
+	object      string
+}
+
+// parseModelLocation parses a model definition location of the form git+<url>[#<branch, tag or commit>] or
+// datastore://<datastore id>/<container>/<object>.
+func parseModelLocation(location string) (*modelSource, error) {
+	switch {
+	case strings.HasPrefix(location, gitLocationPrefix):
+		url := strings.TrimPrefix(location, gitLocationPrefix)
+		ref := "HEAD"
+		if i := strings.LastIndex(url, "#"); i >= 0 {
+			url, ref = url[:i], url[i+1:]
+		}
+		if url == "" || strings.HasPrefix(url, "-") {
+			return nil, fmt.Errorf("'%s' does not name a git repository", redactLocation(location))
+		}
+		if ref == "" || strings.HasPrefix(ref, "-") {
+			return nil, fmt.Errorf("'%s' does not name a git branch, tag or commit", redactLocation(location))
+		}
+		return &modelSource{gitURL: url, gitRef: ref}, nil
+
+	case strings.HasPrefix(location, datastoreLocationPrefix):
+		parts := strings.SplitN(strings.TrimPrefix(location, datastoreLocationPrefix), "/", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("'%s' is not of the form %s<datastore id>/<container>/<object>",
+				location, datastoreLocationPrefix)
+		}
+		return &modelSource{datastoreID: parts[0], container: parts[1], object: parts[2]}, nil
+	}
+	return nil, fmt.Errorf("'%s' must start with %s or %s", location, gitLocationPrefix, datastoreLocationPrefix)
+}
+
+// validateModelLocation returns a message describing what is wrong with the location of a model definition that is
+// not uploaded, or "".
+func validateModelLocation(location string, datastores []*grpc_trainer_v2.Datastore) string {
+	src, err := parseModelLocation(location)
+	if err != nil {
+		return fmt.Sprintf("Model definition location %s", err.Error())
+	}
+	if src.datastoreID != "" && findDatastore(src.datastoreID, datastores) == nil {
+		return fmt.Sprintf("Model definition location '%s' does not reference an existing datastore id.", location)
+	}
+	return ""
+}
+
+// fetchModelDefinition sets the content of a model definition that is given by its location. The location is kept as
+// the source of the model definition, together with the commit or object ETag that was fetched.
+func fetchModelDefinition(ctx context.Context, req *grpc_trainer_v2.CreateRequest, logr *logger.LocLoggingEntry) error {
+	m := req.ModelDefinition
+	if len(m.Content) > 0 || m.Location == "" {
+		return nil
+	}
+	src, err := parseModelLocation(m.Location)
+	if err != nil {
+		return gerrf(codes.InvalidArgument, "Model definition location %s", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, modelFetchTimeout)
+	defer cancel()
+
+	var content []byte
+	var revision string
+	if src.gitURL != "" {
+		content, revision, err = fetchGitModel(ctx, src.gitURL, src.gitRef)
+	} else {
+		content, revision, err = fetchDatastoreModel(findDatastore(src.datastoreID, req.Datastores), src.container, src.object)
+	}
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot fetch model definition from %s", redactLocation(m.Location))
+		return gerrf(codes.InvalidArgument, "Cannot fetch model definition from %s: %s", redactLocation(m.Location), err.Error())
+	}
+	logr.Infof("Fetched model definition from %s at revision %s, length: %d", redactLocation(m.Location), revision, len(content))
+
+	m.Content = content
+	// the location is stored without the user info, which may hold an access token
+	m.Source = redactLocation(m.Location)
+	m.SourceRevision = revision
+	// set again once the content is uploaded
+	m.Location = ""
+	return nil
+}
+
+// fetchGitModel returns the ZIP archive and the SHA of the commit a git ref resolves to. Only the commit is fetched,
+// without its history, unless the ref is an abbreviated commit SHA, which the repository cannot resolve by itself.
+// The repository and the archive are limited in size.
+func fetchGitModel(ctx context.Context, url string, ref string) ([]byte, string, error) {
+	dir, err := ioutil.TempDir("", "model-definition-")
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.RemoveAll(dir)
+
+	maxRepoSize := viper.GetInt64(modelGitMaxSizeKey) * 1024 * 1024
+	maxSize := viper.GetInt64(modelMaxSizeKey) * 1024 * 1024
+
+	if _, err := runGit(ctx, url, 0, "init", "--quiet", "--bare", dir); err != nil {
+		return nil, "", err
+	}
+	tooLarge := fmt.Errorf("the repository is larger than %d MB", maxRepoSize/1024/1024)
+	fetch := func(args ...string) error {
+		fetchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		exceeded := limitDirSize(fetchCtx, cancel, dir, maxRepoSize)
+		_, err := runGit(fetchCtx, url, 0, append([]string{"-C", dir, "fetch", "--quiet"}, args...)...)
+		if exceeded() || (maxRepoSize > 0 && dirSize(dir) > maxRepoSize) {
+			return tooLarge
+		}
+		return err
+	}
+
+	var commit string
+	if err := fetch("--depth", "1", "--", url, ref); err == nil {
+		commit = "FETCH_HEAD^{commit}"
+	} else if abbreviatedSHA.MatchString(ref) && err != tooLarge {
+		if err := fetch("--", url, "+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"); err != nil {
+			return nil, "", err
+		}
+		commit = ref + "^{commit}"
+	} else {
+		return nil, "", err
+	}
+	out, err := runGit(ctx, url, 0, "-C", dir, "rev-parse", "--verify", "--quiet", commit)
+	if err != nil {
+		return nil, "", fmt.Errorf("'%s' is not a branch, tag or commit of the repository", ref)
+	}
+	sha := strings.TrimSpace(string(out))
+	content, err := runGit(ctx, url, maxSize, "-C", dir, "archive", "--format=zip", sha)
+	if err != nil {
+		return nil, "", err
+	}
+	return content, sha, nil
+}
+
+// abbreviatedSHA matches refs that may be abbreviated commit SHAs
+var abbreviatedSHA = regexp.MustCompile("^[0-9a-f]{4,40}$")
+
+// runGit runs a git command for fetching the model definition at url and returns its output, which is limited to
+// maxOutput bytes unless that is 0. The url is redacted from error messages.
+func runGit(ctx context.Context, url string, maxOutput int64, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(),
+		"GIT_ALLOW_PROTOCOL="+viper.GetString(modelGitProtocolsKey),
+		"GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	var readErr error
+	if maxOutput > 0 {
+		out, readErr = ioutil.ReadAll(io.LimitReader(stdout, maxOutput+1))
+		if readErr == nil && int64(len(out)) > maxOutput {
+			cmd.Process.Kill()
+			cmd.Wait()
+			return nil, fmt.Errorf("the model definition is larger than %d MB", maxOutput/1024/1024)
+		}
+	} else {
+		out, readErr = ioutil.ReadAll(stdout)
+	}
+	if err := cmd.Wait(); err != nil {
+		command := args[0]
+		if command == "-C" {
+			command = args[2]
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s failed: %s", command, strings.Replace(msg, url, redactURL(url), -1))
+		}
+		return nil, fmt.Errorf("git %s failed: %s", command, err.Error())
+	}
+	return out, readErr
+}
+
+// limitDirSize cancels the commands working in dir once its files take up more than limit bytes, until ctx is done.
+// The returned function reports whether it did. A limit of 0 disables the check.
+func limitDirSize(ctx context.Context, cancel context.CancelFunc, dir string, limit int64) func() bool {
+	var exceeded int32
+	if limit <= 0 {
+		return func() bool { return false }
+	}
+	go func() {
+		ticker := time.NewTicker(dirSizePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if dirSize(dir) > limit {
+					atomic.StoreInt32(&exceeded, 1)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return func() bool {
+		return atomic.LoadInt32(&exceeded) == 1
+	}
+}
+
+// dirSize returns the total size of the files in dir
+func dirSize(dir string) int64 {
+	var size int64
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// redactURL removes the user info of a URL, which may hold an access token, so that it can be logged and stored
+func redactURL(rawURL string) string {
+	u, err := neturl.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	u.User = nil
+	return u.String()
+}
+
+// redactLocation removes the user info of the URL of a model definition location
+func redactLocation(location string) string {
+	if strings.HasPrefix(location, gitLocationPrefix) {
+		return gitLocationPrefix + redactURL(strings.TrimPrefix(location, gitLocationPrefix))
+	}
+	return location
+}
+
+// fetchDatastoreModel returns an object of a data store and its ETag, or the MD5 of its content if the store does not
+// provide ETags.
+func fetchDatastoreModel(ds *grpc_trainer_v2.Datastore, container string, object string) ([]byte, string, error) {
+	store, err := storage.CreateDataStore(ds.Type, ds.Connection)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := store.Connect(); err != nil {
+		return nil, "", err
+	}
+	defer store.Disconnect()
+
+	content, err := store.DownloadArchive(container, object)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(content) == 0 {
+		return nil, "", fmt.Errorf("object '%s/%s' is empty or does not exist", container, object)
+	}
+	if maxSize := viper.GetInt64(modelMaxSizeKey); maxSize > 0 && int64(len(content)) > maxSize*1024*1024 {
+		return nil, "", fmt.Errorf("the model definition is larger than %d MB", maxSize)
+	}
+	if etagger, ok := store.(storage.ETagger); ok {
+		etag, err := etagger.ObjectETag(container, object)
+		if err != nil {
+			return nil, "", err
+		}
+		return content, etag, nil
+	}
+	sum := md5.Sum(content)
+	return content, hex.EncodeToString(sum[:]), nil
+}
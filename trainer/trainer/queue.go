@@ -18,11 +18,13 @@ package trainer
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/IBM/FfDL/commons/logger"
+	"github.com/spf13/viper"
 
 	"github.com/ventu-io/go-shortid"
 
@@ -40,14 +42,17 @@ const (
 
 // JobQueue represents the functionality of a queue
 type JobQueue interface {
-	Enqueue(string) error
+	Enqueue(string, int32) error
 	Dequeue() (string, error)
 	Peek() (string, error)
+	PeekN(int) ([]Entry, error)
 	Delete(string) (bool, error)
+	SetPriority(string, int32) (bool, error)
 	Size() (int, error)
 	Empty() (bool, error)
 	Lock() error
 	Unlock() error
+	Close()
 }
 
 // TrainingJobQueue is a JobQueue backed by mongo
@@ -65,6 +70,7 @@ type Entry struct {
 	ID         bson.ObjectId `bson:"_id" json:"id"`
 	TrainingID string        `bson:"training_id" json:"training_id"`
 	Submitted  time.Time     `bson:"submitted" json:"submitted"`
+	Priority   int32         `bson:"priority" json:"priority"`
 }
 
 // LockEntry represents which trainer service currently has a lock on the queue
@@ -105,9 +111,9 @@ func newTrainingJobQueue(mongoURI string, database string, username string, pass
 	return q, nil
 }
 
-// Enqueue adds a training job id to the queue
+// Enqueue adds a training job id with the given priority to the queue
 // trainer should acquire the lock before calling Enqueue()
-func (q *TrainingJobQueue) Enqueue(id string) error {
+func (q *TrainingJobQueue) Enqueue(id string, priority int32) error {
 	logr := logger.LocLogger(log.StandardLogger().WithField("module", "trainingQueue").WithField(logger.LogkeyTrainingID, id))
 
 	sess := q.session.Clone()
@@ -119,6 +125,7 @@ func (q *TrainingJobQueue) Enqueue(id string) error {
 		ID:         bson.NewObjectId(),
 		TrainingID: id,
 		Submitted:  time.Now(),
+		Priority:   priority,
 	}
 	err := sess.DB(q.database).C(q.queueCollection).Insert(entry)
 	if err != nil {
@@ -138,11 +145,16 @@ func (q *TrainingJobQueue) Dequeue() (string, error) {
 
 	logr.Debugf("dequeue using database: %s collection: %s live servers: %s", q.database, q.queueCollection, sess.LiveServers())
 
-	// find the earliest entry
-	entry := &Entry{}
-	err := sess.DB(q.database).C(q.queueCollection).Find(nil).Sort("submitted").One(&entry)
+	// find the entry with the highest effective priority
+	var entries []Entry
+	err := sess.DB(q.database).C(q.queueCollection).Find(nil).Sort("submitted").All(&entries)
+	if err != nil {
+		logr.WithError(err).Errorf("failed to read queue entries")
+		return "", err
+	}
 
-	if err == mgo.ErrNotFound {
+	entry := nextEntry(entries, getPriorityAgingInterval(), time.Now())
+	if entry == nil {
 		logr.Debugf("queue is empty")
 		return "", fmt.Errorf("queue is empty")
 	}
@@ -165,11 +177,16 @@ func (q *TrainingJobQueue) Peek() (string, error) {
 
 	logr.Debugf("peek using database: %s collection: %s live servers: %s", q.database, q.queueCollection, sess.LiveServers())
 
-	// find the earliest entry
-	entry := &Entry{}
-	err := sess.DB(q.database).C(q.queueCollection).Find(nil).Sort("submitted").One(&entry)
+	// find the entry with the highest effective priority
+	var entries []Entry
+	err := sess.DB(q.database).C(q.queueCollection).Find(nil).Sort("submitted").All(&entries)
+	if err != nil {
+		logr.WithError(err).Errorf("failed to read queue entries")
+		return "", err
+	}
 
-	if err == mgo.ErrNotFound {
+	entry := nextEntry(entries, getPriorityAgingInterval(), time.Now())
+	if entry == nil {
 		logr.Debugf("queue is empty")
 		return "", fmt.Errorf("queue is empty")
 	}
@@ -177,6 +194,29 @@ func (q *TrainingJobQueue) Peek() (string, error) {
 	return entry.TrainingID, nil
 }
 
+// PeekN returns up to n entries in the order they would be dequeued and leaves them in the queue
+func (q *TrainingJobQueue) PeekN(n int) ([]Entry, error) {
+	logr := logger.LocLogger(log.StandardLogger().WithField("module", "trainingQueue"))
+
+	sess := q.session.Clone()
+	defer sess.Close()
+
+	logr.Debugf("peek %d using database: %s collection: %s live servers: %s", n, q.database, q.queueCollection, sess.LiveServers())
+
+	var entries []Entry
+	err := sess.DB(q.database).C(q.queueCollection).Find(nil).Sort("submitted").All(&entries)
+	if err != nil {
+		logr.WithError(err).Errorf("failed to read queue entries")
+		return nil, err
+	}
+
+	entries = orderEntries(entries, getPriorityAgingInterval(), time.Now())
+	if len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries, nil
+}
+
 // Delete removes a training job id from any position in the queue
 // trainer should acquire the lock before calling Delete()
 func (q *TrainingJobQueue) Delete(id string) (bool, error) {
@@ -204,6 +244,29 @@ func (q *TrainingJobQueue) Delete(id string) (bool, error) {
 	return true, nil
 }
 
+// SetPriority changes the priority of a training job that is waiting in the queue
+// trainer should acquire the lock before calling SetPriority()
+func (q *TrainingJobQueue) SetPriority(id string, priority int32) (bool, error) {
+	logr := logger.LocLogger(log.StandardLogger().WithField("module", "trainingQueue").WithField(logger.LogkeyTrainingID, id))
+
+	sess := q.session.Clone()
+	defer sess.Close()
+
+	logr.Debugf("set priority using database: %s collection: %s live servers: %s", q.database, q.queueCollection, sess.LiveServers())
+
+	err := sess.DB(q.database).C(q.queueCollection).Update(bson.M{"training_id": id}, bson.M{"$set": bson.M{"priority": priority}})
+	if err == mgo.ErrNotFound {
+		logr.Debugf("%s not found", id)
+		return false, nil
+	}
+	if err != nil {
+		logr.WithError(err).Errorf("failed to set priority of %s to %d", id, priority)
+		return false, err
+	}
+
+	return true, nil
+}
+
 // Size returns the number of elements in the queue.
 func (q *TrainingJobQueue) Size() (int, error) {
 	logr := logger.LocLogger(log.StandardLogger().WithField("module", "trainingQueue"))
@@ -277,6 +340,56 @@ func (q *TrainingJobQueue) Unlock() error {
 	return nil
 }
 
+// getPriorityAgingInterval returns how long a job has to wait in the queue to gain one priority level.
+// A zero or negative interval disables aging.
+func getPriorityAgingInterval() time.Duration {
+	return time.Duration(viper.GetInt(priorityAgingIntervalKey)) * time.Second
+}
+
+// effectivePriority returns the priority of the entry raised by one level for every agingInterval
+// it has been waiting, so that low priority jobs are not starved by a steady stream of high priority ones
+func effectivePriority(entry *Entry, agingInterval time.Duration, now time.Time) int64 {
+	priority := int64(entry.Priority)
+	if agingInterval > 0 && now.After(entry.Submitted) {
+		priority += int64(now.Sub(entry.Submitted) / agingInterval)
+	}
+	return priority
+}
+
+// nextEntry returns the entry that should be started next, which is the one with the highest effective priority.
+// Entries with the same effective priority are served in submission order. Returns nil if there are no entries.
+func nextEntry(entries []Entry, agingInterval time.Duration, now time.Time) *Entry {
+	var next *Entry
+	var nextPriority int64
+	for i := range entries {
+		entry := &entries[i]
+		priority := effectivePriority(entry, agingInterval, now)
+		if next == nil || priority > nextPriority || (priority == nextPriority && entry.Submitted.Before(next.Submitted)) {
+			next = entry
+			nextPriority = priority
+		}
+	}
+	return next
+}
+
+// orderEntries sorts the entries in the order they should be started, using the same rules as nextEntry
+func orderEntries(entries []Entry, agingInterval time.Duration, now time.Time) []Entry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		pi := effectivePriority(&entries[i], agingInterval, now)
+		pj := effectivePriority(&entries[j], agingInterval, now)
+		if pi != pj {
+			return pi > pj
+		}
+		return entries[i].Submitted.Before(entries[j].Submitted)
+	})
+	return entries
+}
+
+// Close closes the mongo session of the queue
+func (q *TrainingJobQueue) Close() {
+	q.session.Close()
+}
+
 // QueueName returns the name of the queue collection in mongo based on the GPU type
 func QueueName(gpuType string) string {
 	return "TRAINING_JOB_QUEUE_" + TransformResourceName(gpuType)
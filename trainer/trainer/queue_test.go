@@ -35,14 +35,14 @@ func TestQueue(t *testing.T) {
 	t2 := "training job 2"
 	t3 := "training job 3"
 
-	queue.Enqueue(t1)
-	queue.Enqueue(t2)
+	queue.Enqueue(t1, 0)
+	queue.Enqueue(t2, 0)
 
 	empty, e := queue.Empty()
 	assert.Equal(t, false, empty)
 	assert.Equal(t, nil, e)
 
-	queue.Enqueue(t3)
+	queue.Enqueue(t3, 0)
 	queue.Delete(t2)
 
 	id1, e1 := queue.Dequeue()
@@ -131,3 +131,38 @@ func TestLock(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestNextEntry(t *testing.T) {
+	now := time.Now()
+	entries := []Entry{
+		{TrainingID: "low", Priority: 0, Submitted: now.Add(-25 * time.Minute)},
+		{TrainingID: "high", Priority: 2, Submitted: now.Add(-1 * time.Minute)},
+		{TrainingID: "high-later", Priority: 2, Submitted: now},
+	}
+
+	// without aging, the highest priority wins and ties are served in submission order
+	assert.Equal(t, "high", nextEntry(entries, 0, now).TrainingID)
+
+	// with aging, the low priority job gains 2 levels and was submitted earlier
+	assert.Equal(t, "low", nextEntry(entries, 10*time.Minute, now).TrainingID)
+	assert.EqualValues(t, 2, effectivePriority(&entries[0], 10*time.Minute, now))
+
+	assert.Nil(t, nextEntry(nil, 0, now))
+}
+
+func TestOrderEntries(t *testing.T) {
+	now := time.Now()
+	entries := []Entry{
+		{TrainingID: "t1", Priority: 0, Submitted: now.Add(-3 * time.Minute)},
+		{TrainingID: "t2", Priority: 1, Submitted: now.Add(-2 * time.Minute)},
+		{TrainingID: "t3", Priority: 0, Submitted: now.Add(-1 * time.Minute)},
+		{TrainingID: "t4", Priority: 1, Submitted: now},
+	}
+
+	var ids []string
+	for _, entry := range orderEntries(entries, 0, now) {
+		ids = append(ids, entry.TrainingID)
+	}
+	assert.Equal(t, []string{"t2", "t4", "t1", "t3"}, ids)
+	assert.Equal(t, nextEntry(entries, 0, now).TrainingID, entries[0].TrainingID)
+}
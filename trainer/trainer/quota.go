@@ -0,0 +1,193 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+)
+
+// Quotas are configured in the same "name=value,name=value" format as the cluster wide gpu.limits, e.g.
+// DLAAS_QUOTA_USER_GPUS="*=4,alice=8". The name "*" sets the default for every user (or tenant) that has
+// no explicit entry. A missing or zero quota means unlimited.
+const (
+	userGpuQuotaKey   = "quota.user.gpus"
+	userJobQuotaKey   = "quota.user.jobs"
+	tenantGpuQuotaKey = "quota.tenant.gpus"
+	tenantJobQuotaKey = "quota.tenant.jobs"
+
+	// tenantMembersKey maps tenant groups to their users, e.g. "vision=alice|bob,nlp=carol"
+	tenantMembersKey = "quota.tenant.members"
+
+	defaultQuotaName = "*"
+)
+
+const (
+	// Used as the "quota" label of the quota limit counter metric.
+	userGpuQuota   = "user_gpus"
+	userJobQuota   = "user_jobs"
+	tenantGpuQuota = "tenant_gpus"
+	tenantJobQuota = "tenant_jobs"
+)
+
+// quotaViolation describes which quota prevented a training job from being started.
+type quotaViolation struct {
+	quota     string  // one of the quota label constants above
+	owner     string  // user or tenant the quota applies to
+	limit     int64   // configured limit
+	used      float32 // amount currently used by running jobs
+	requested float32 // amount requested by the blocked job
+}
+
+func (v *quotaViolation) String() string {
+	switch v.quota {
+	case userGpuQuota:
+		return fmt.Sprintf("user %s GPU quota exceeded: limit %d, in use %v, requested %v", v.owner, v.limit, v.used, v.requested)
+	case userJobQuota:
+		return fmt.Sprintf("user %s job quota exceeded: limit %d, running %v", v.owner, v.limit, v.used)
+	case tenantGpuQuota:
+		return fmt.Sprintf("tenant %s GPU quota exceeded: limit %d, in use %v, requested %v", v.owner, v.limit, v.used, v.requested)
+	case tenantJobQuota:
+		return fmt.Sprintf("tenant %s job quota exceeded: limit %d, running %v", v.owner, v.limit, v.used)
+	}
+	return v.quota
+}
+
+// quotasEnabled returns true if at least one per-user or per-tenant quota has been configured
+func quotasEnabled() bool {
+	for _, key := range []string{userGpuQuotaKey, userJobQuotaKey, tenantGpuQuotaKey, tenantJobQuotaKey} {
+		if len(parseQuotas(viper.GetString(key))) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// parseQuotas parses a comma separated list of name=limit pairs, ignoring malformed entries
+func parseQuotas(value string) map[string]int64 {
+	quotas := make(map[string]int64)
+	for _, entry := range strings.Split(value, ",") {
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		limit, err := strconv.ParseInt(strings.TrimSpace(kv[1]), 10, 0)
+		if err != nil || limit < 0 {
+			continue
+		}
+		quotas[strings.TrimSpace(kv[0])] = limit
+	}
+	return quotas
+}
+
+// getQuota returns the quota configured under key for the given user or tenant, falling back to the
+// default entry. Returns 0 if no quota applies.
+func getQuota(key string, name string) int64 {
+	quotas := parseQuotas(viper.GetString(key))
+	if limit, ok := quotas[name]; ok {
+		return limit
+	}
+	return quotas[defaultQuotaName]
+}
+
+// getTenantForUser returns the tenant group the user belongs to, or an empty string if there is none
+func getTenantForUser(userID string) string {
+	for _, entry := range strings.Split(viper.GetString(tenantMembersKey), ",") {
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		for _, member := range strings.Split(kv[1], "|") {
+			if strings.TrimSpace(member) == userID {
+				return strings.TrimSpace(kv[0])
+			}
+		}
+	}
+	return ""
+}
+
+// totalGpus returns the total number of GPUs across all learners of a training
+func totalGpus(resources *grpc_trainer_v2.ResourceRequirements) float32 {
+	if resources == nil {
+		return 0
+	}
+	if resources.Learners > 1 {
+		// resources.Gpus is GPUs used per learner
+		return resources.Gpus * float32(resources.Learners)
+	}
+	return resources.Gpus
+}
+
+// isConsumingResources returns false for trainings that don't count towards active resource usage
+func isConsumingResources(record *TrainingRecord) bool {
+	if record.TrainingStatus == nil {
+		return false
+	}
+	switch record.TrainingStatus.Status {
+	case grpc_trainer_v2.Status_COMPLETED, grpc_trainer_v2.Status_HALTED, grpc_trainer_v2.Status_FAILED, grpc_trainer_v2.Status_QUEUED,
+		grpc_trainer_v2.Status_WAITING:
+		return false
+	}
+	return true
+}
+
+// checkQuotas verifies that starting the training would not exceed any of the per-user or per-tenant quotas,
+// given the currently running trainings as returned by FindCurrentlyRunningTrainings. Returns the first quota
+// that would be exceeded, or nil.
+func checkQuotas(trainingRecord *TrainingRecord, records []*TrainingRecord) *quotaViolation {
+	userID := trainingRecord.UserID
+	tenant := getTenantForUser(userID)
+
+	var userGpus, tenantGpus float32
+	var userJobs, tenantJobs int
+	for _, record := range records {
+		if record.TrainingID == trainingRecord.TrainingID || !isConsumingResources(record) || record.Training == nil {
+			continue
+		}
+		if record.UserID == userID {
+			userGpus += totalGpus(record.Training.Resources)
+			userJobs++
+		}
+		if tenant != "" && getTenantForUser(record.UserID) == tenant {
+			tenantGpus += totalGpus(record.Training.Resources)
+			tenantJobs++
+		}
+	}
+
+	requested := totalGpus(trainingRecord.Training.Resources)
+
+	if limit := getQuota(userJobQuotaKey, userID); limit > 0 && int64(userJobs+1) > limit {
+		return &quotaViolation{quota: userJobQuota, owner: userID, limit: limit, used: float32(userJobs), requested: 1}
+	}
+	if limit := getQuota(userGpuQuotaKey, userID); limit > 0 && requested > 0 && userGpus+requested > float32(limit) {
+		return &quotaViolation{quota: userGpuQuota, owner: userID, limit: limit, used: userGpus, requested: requested}
+	}
+	if tenant == "" {
+		return nil
+	}
+	if limit := getQuota(tenantJobQuotaKey, tenant); limit > 0 && int64(tenantJobs+1) > limit {
+		return &quotaViolation{quota: tenantJobQuota, owner: tenant, limit: limit, used: float32(tenantJobs), requested: 1}
+	}
+	if limit := getQuota(tenantGpuQuotaKey, tenant); limit > 0 && requested > 0 && tenantGpus+requested > float32(limit) {
+		return &quotaViolation{quota: tenantGpuQuota, owner: tenant, limit: limit, used: tenantGpus, requested: requested}
+	}
+	return nil
+}
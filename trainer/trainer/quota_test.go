@@ -0,0 +1,144 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func createQuotaRecord(id string, userID string, gpus float32, learners int32, status grpc_trainer_v2.Status) *TrainingRecord {
+	return &TrainingRecord{
+		TrainingID: id,
+		UserID:     userID,
+		Training: &grpc_trainer_v2.Training{
+			Resources: &grpc_trainer_v2.ResourceRequirements{
+				Gpus:     gpus,
+				Learners: learners,
+				GpuType:  "nvidia-TeslaK80",
+			},
+		},
+		TrainingStatus: &grpc_trainer_v2.TrainingStatus{
+			Status: status,
+		},
+	}
+}
+
+func resetQuotas() {
+	for _, key := range []string{userGpuQuotaKey, userJobQuotaKey, tenantGpuQuotaKey, tenantJobQuotaKey, tenantMembersKey} {
+		viper.Set(key, "")
+	}
+}
+
+func TestParseQuotas(t *testing.T) {
+	quotas := parseQuotas("*=4, alice=8,bob=x,carol,dave=-1")
+	assert.Equal(t, map[string]int64{"*": 4, "alice": 8}, quotas)
+	assert.Empty(t, parseQuotas(""))
+}
+
+func TestGetQuota(t *testing.T) {
+	defer resetQuotas()
+	viper.Set(userGpuQuotaKey, "*=4,alice=8,bob=0")
+
+	assert.EqualValues(t, 8, getQuota(userGpuQuotaKey, "alice"))
+	assert.EqualValues(t, 0, getQuota(userGpuQuotaKey, "bob"))
+	assert.EqualValues(t, 4, getQuota(userGpuQuotaKey, "carol"))
+	assert.EqualValues(t, 0, getQuota(userJobQuotaKey, "alice"))
+	assert.True(t, quotasEnabled())
+
+	resetQuotas()
+	assert.False(t, quotasEnabled())
+}
+
+func TestGetTenantForUser(t *testing.T) {
+	defer resetQuotas()
+	viper.Set(tenantMembersKey, "vision=alice|bob,nlp=carol")
+
+	assert.Equal(t, "vision", getTenantForUser("alice"))
+	assert.Equal(t, "vision", getTenantForUser("bob"))
+	assert.Equal(t, "nlp", getTenantForUser("carol"))
+	assert.Equal(t, "", getTenantForUser("dave"))
+}
+
+func TestCheckUserQuotas(t *testing.T) {
+	defer resetQuotas()
+	viper.Set(userGpuQuotaKey, "*=4")
+	viper.Set(userJobQuotaKey, "*=3")
+
+	running := []*TrainingRecord{
+		createQuotaRecord("t1", "alice", 1, 2, grpc_trainer_v2.Status_PROCESSING),
+		createQuotaRecord("t2", "alice", 1, 1, grpc_trainer_v2.Status_COMPLETED),
+		createQuotaRecord("t3", "alice", 4, 1, grpc_trainer_v2.Status_QUEUED),
+		createQuotaRecord("t4", "bob", 4, 1, grpc_trainer_v2.Status_PENDING),
+	}
+
+	// alice uses 2 GPUs, so 2 more fit
+	assert.Nil(t, checkQuotas(createQuotaRecord("new", "alice", 2, 1, grpc_trainer_v2.Status_QUEUED), running))
+
+	violation := checkQuotas(createQuotaRecord("new", "alice", 3, 1, grpc_trainer_v2.Status_QUEUED), running)
+	if assert.NotNil(t, violation) {
+		assert.Equal(t, userGpuQuota, violation.quota)
+		assert.Equal(t, "alice", violation.owner)
+		assert.EqualValues(t, 2, violation.used)
+		assert.EqualValues(t, 3, violation.requested)
+	}
+
+	// the job being checked does not count towards its own usage
+	assert.Nil(t, checkQuotas(createQuotaRecord("t4", "bob", 4, 1, grpc_trainer_v2.Status_QUEUED), running))
+
+	for i := 0; i < 2; i++ {
+		running = append(running, createQuotaRecord(fmt.Sprintf("cpu%d", i), "alice", 0, 1, grpc_trainer_v2.Status_PROCESSING))
+	}
+	violation = checkQuotas(createQuotaRecord("new", "alice", 0, 1, grpc_trainer_v2.Status_QUEUED), running)
+	if assert.NotNil(t, violation) {
+		assert.Equal(t, userJobQuota, violation.quota)
+		assert.EqualValues(t, 3, violation.used)
+	}
+}
+
+func TestCheckTenantQuotas(t *testing.T) {
+	defer resetQuotas()
+	viper.Set(tenantMembersKey, "vision=alice|bob")
+	viper.Set(tenantGpuQuotaKey, "vision=6")
+	viper.Set(tenantJobQuotaKey, "*=2")
+
+	running := []*TrainingRecord{
+		createQuotaRecord("t1", "alice", 2, 1, grpc_trainer_v2.Status_PROCESSING),
+		createQuotaRecord("t2", "carol", 8, 1, grpc_trainer_v2.Status_PROCESSING),
+	}
+
+	violation := checkQuotas(createQuotaRecord("new", "bob", 6, 1, grpc_trainer_v2.Status_QUEUED), running)
+	if assert.NotNil(t, violation) {
+		assert.Equal(t, tenantGpuQuota, violation.quota)
+		assert.Equal(t, "vision", violation.owner)
+		assert.Contains(t, violation.String(), "tenant vision GPU quota exceeded")
+	}
+	assert.Nil(t, checkQuotas(createQuotaRecord("new", "bob", 4, 1, grpc_trainer_v2.Status_QUEUED), running))
+
+	running = append(running, createQuotaRecord("t3", "bob", 1, 1, grpc_trainer_v2.Status_DOWNLOADING))
+	violation = checkQuotas(createQuotaRecord("new", "alice", 1, 1, grpc_trainer_v2.Status_QUEUED), running)
+	if assert.NotNil(t, violation) {
+		assert.Equal(t, tenantJobQuota, violation.quota)
+	}
+
+	// carol is not part of any tenant, so only user quotas apply
+	assert.Nil(t, checkQuotas(createQuotaRecord("new", "carol", 8, 1, grpc_trainer_v2.Status_QUEUED), running))
+}
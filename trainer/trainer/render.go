@@ -0,0 +1,111 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/instrumentation"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/ventu-io/go-shortid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RenderTrainingJob validates a training job like CreateTrainingJob does and asks the LCM for the Kubernetes objects it
+// would create to run it. The model definition is not uploaded, and the training job is neither stored nor queued.
+func (s *trainerService) RenderTrainingJob(ctx context.Context, req *grpc_trainer_v2.CreateRequest) (*grpc_trainer_v2.RenderResponse, error) {
+	sid, _ := shortid.Generate()
+	id := fmt.Sprintf("training-%s", sid)
+
+	logr := logger.LocLogger(logWith(id, req.UserId))
+
+	cl := instrumentation.NewCallLogger(ctx, "RenderTrainingJob", logr)
+	defer cl.Returned()
+
+	if err := s.validateRequest(logr.Logger, req); err != nil {
+		return nil, err
+	}
+
+	setDefaultResourceRequirements(req.Training)
+
+	// the job is rendered with the results of its parent as input, even if it would still wait for it
+	if _, err := s.resolveDependencies(req, logr); err != nil {
+		return nil, err
+	}
+
+	outputDatastore := s.getOutputDatastore(req.Training.OutputData, req.Datastores)
+
+	// the location the model definition would be uploaded to
+	modelWithoutContent := *req.ModelDefinition
+	modelWithoutContent.Content = nil
+	if req.ModelDefinition.Content != nil && outputDatastore.Type != "mount_volume" {
+		modelWithoutContent.Location = fmt.Sprintf("%s/%s.zip", s.modelsBucket, id)
+	}
+
+	tr := &TrainingRecord{
+		TrainingID:            id,
+		UserID:                req.UserId,
+		ModelDefinition:       &modelWithoutContent,
+		Training:              req.Training,
+		Datastores:            req.Datastores,
+		EvaluationMetricsSpec: evaluationMetricsSpec(req, logr),
+		Priority:              req.Priority,
+		ResultsLocation:       resultsLocation(outputDatastore, id),
+		Labels:                req.Labels,
+	}
+
+	jobConfig, err := s.createJobConfig(tr)
+	if err != nil {
+		logr.WithError(err).Errorf("Failed to create job config")
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+
+	lcm, err := s.lcmClient()
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot create LCM service client")
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	defer lcm.Close()
+
+	lcmCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	rendered, err := lcm.Client().RenderTrainingJob(lcmCtx, jobConfig)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot render training job with id %s", id)
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			// such as a reference to a secret of another user
+			return nil, err
+		}
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	cl.Observe("rendered job in lcm")
+
+	resp := &grpc_trainer_v2.RenderResponse{}
+	for _, obj := range rendered.Objects {
+		resp.Objects = append(resp.Objects, &grpc_trainer_v2.RenderedObject{
+			Kind:     obj.Kind,
+			Name:     obj.Name,
+			Manifest: obj.Manifest,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/mgo.v2"
+)
+
+// fakeLCM renders every job as a single statefulset
+type fakeLCM struct {
+	service.LifecycleManagerClient
+	rendered []*service.JobDeploymentRequest
+	deployed []string
+	killed   []string
+	killErr  error
+}
+
+func (f *fakeLCM) Client() service.LifecycleManagerClient {
+	return f
+}
+
+func (f *fakeLCM) Close() error {
+	return nil
+}
+
+func (f *fakeLCM) DeployTrainingJob(ctx context.Context, in *service.JobDeploymentRequest, opts ...grpc.CallOption) (*service.JobDeploymentResponse, error) {
+	f.deployed = append(f.deployed, in.TrainingId)
+	return &service.JobDeploymentResponse{Name: in.Name}, nil
+}
+
+func (f *fakeLCM) KillTrainingJob(ctx context.Context, in *service.JobKillRequest, opts ...grpc.CallOption) (*service.JobKillResponse, error) {
+	f.killed = append(f.killed, in.Name)
+	return &service.JobKillResponse{}, f.killErr
+}
+
+func (f *fakeLCM) RenderTrainingJob(ctx context.Context, in *service.JobDeploymentRequest, opts ...grpc.CallOption) (*service.JobRenderResponse, error) {
+	f.rendered = append(f.rendered, in)
+	return &service.JobRenderResponse{Objects: []*service.RenderedObject{
+		{Kind: "StatefulSet", Name: "learner-" + in.Name, Manifest: "kind: StatefulSet\n"},
+	}}, nil
+}
+
+func TestRenderTrainingJob(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	lcm := &fakeLCM{}
+	s.lcm = lcm
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_COMPLETED)))
+
+	req := createDependentRequest("parent")
+	req.Labels = map[string]string{"team": "vision"}
+	resp, err := s.RenderTrainingJob(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.Len(t, lcm.rendered, 1) {
+		job := lcm.rendered[0]
+		assert.Equal(t, []*grpc_trainer_v2.RenderedObject{
+			{Kind: "StatefulSet", Name: "learner-" + job.Name, Manifest: "kind: StatefulSet\n"},
+		}, resp.Objects)
+		assert.Equal(t, "vision", job.Labels["team"])
+		assert.Equal(t, "results/parent", job.EnvVars["DATA_DIR"])
+		assert.Equal(t, s.modelsBucket+"/"+job.TrainingId+".zip", job.EnvVars["MODEL_STORE_OBJECTID"])
+
+		// nothing was stored or uploaded
+		_, err = s.repo.Find(job.TrainingId)
+		assert.Equal(t, mgo.ErrNotFound, err)
+		model, _ := s.datastore.DownloadArchive(s.modelsBucket, getModelZipFileName(job.TrainingId))
+		assert.Nil(t, model)
+	}
+
+	// invalid jobs are rejected before the LCM is asked
+	req = createDependentRequest()
+	req.Training.InputData = []string{"missing"}
+	_, err = s.RenderTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+	assert.Len(t, lcm.rendered, 1)
+}
@@ -17,8 +17,11 @@
 package trainer
 
 import (
+	"regexp"
+
 	log "github.com/sirupsen/logrus"
 	"github.com/IBM/FfDL/commons/logger"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
 	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
 
 	"gopkg.in/mgo.v2"
@@ -38,6 +41,26 @@ type TrainingRecord struct {
 	Metrics               *grpc_trainer_v2.Metrics         `bson:"metrics,omitempty" json:"metrics"`
 	Deleted               bool                             `bson:"deleted,omitempty" json:"deleted"`
 	EvaluationMetricsSpec string                           `bson:"evaluation_metrics_spec,omitempty" json:"evaluation_metrics_spec"`
+	Priority              int32                            `bson:"priority,omitempty" json:"priority"`
+	ResumeCount           int32                            `bson:"resume_count,omitempty" json:"resume_count"`
+	HyperParameters       map[string]string                `bson:"hyper_parameters,omitempty" json:"hyper_parameters"`
+	// the location of the results in the output data store, kept after the data store credentials are erased
+	ResultsLocation string `bson:"results_location,omitempty" json:"results_location"`
+	// number of times the training was queued again after a retryable failure
+	Retries int32 `bson:"retries,omitempty" json:"retries"`
+	// time in milliseconds since the epoch before which a retried training is not started
+	RetryAfter int64 `bson:"retry_after,omitempty" json:"retry_after"`
+	// time in milliseconds since the epoch the training was first blocked by the cluster wide GPU limit at the head of
+	// its queue, 0 if it is not blocked by it
+	BlockedSince int64 `bson:"blocked_since,omitempty" json:"blocked_since"`
+	// set if the training uses a custom image, whose location and credentials are erased when the training finishes
+	CustomImage bool `bson:"custom_image,omitempty" json:"custom_image"`
+	// name of the deployment of a previous attempt, which the LCM has to delete before the training is started again
+	StaleDeployment string `bson:"stale_deployment,omitempty" json:"stale_deployment"`
+	// user-defined labels
+	Labels map[string]string `bson:"labels,omitempty" json:"labels"`
+	// time in milliseconds since the epoch the training was deleted
+	DeletedTimestamp string `bson:"deleted_timestamp,omitempty" json:"deleted_timestamp"`
 }
 
 // JobHistoryEntry stores training job status history in the Mongo collection "job_history"
@@ -63,14 +86,21 @@ type repository interface {
 	FindTrainingStatusID(trainingID string) (grpc_trainer_v2.Status, error)
 	FindTrainingSummaryMetricsString(trainingID string) (string, error)
 	FindAll(userID string) ([]*TrainingRecord, error)
+	FindAllStatuses(userID string) ([]*TrainingRecord, error)
+	FindTrainings(q *trainingsQuery) ([]*TrainingRecord, error)
 	FindCurrentlyRunningTrainings(limit int) ([]*TrainingRecord, error)
+	FindWaitingDependents(trainingID string) ([]*TrainingRecord, error)
+	FindDeletedBefore(timestamp string, limit int) ([]*TrainingRecord, error)
+	FindFinishedBefore(timestamp string, keepLabel string, limit int) ([]*TrainingRecord, error)
 	Delete(trainingID string) error
+	Purge(trainingID string) error
 	Close()
 }
 
 type jobHistoryRepository interface {
 	RecordJobStatus(e *JobHistoryEntry) error
 	GetJobStatusHistory(trainingID string) []*JobHistoryEntry
+	DeleteJobStatusHistory(trainingID string) error
 	Close()
 }
 
@@ -95,6 +125,8 @@ func newTrainingsRepository(mongoURI string, database string, username string, p
 
 	// create index
 	collectionObj.EnsureIndexKey("user_id", "training_id")
+	collectionObj.EnsureIndexKey("training.depends_on")
+	collectionObj.EnsureIndexKey("user_id", "-training_status.submission_timestamp", "-training_id")
 
 	return repo, nil
 }
@@ -215,6 +247,84 @@ func (r *trainingsRepository) FindAll(userID string) ([]*TrainingRecord, error)
 	return tr, nil
 }
 
+// FindAllStatuses returns the trainings of the user with only their id and status set
+func (r *trainingsRepository) FindAllStatuses(userID string) ([]*TrainingRecord, error) {
+	var tr []*TrainingRecord
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	err := r.queryDatabase(&bson.M{"user_id": userID}, sess).Select(bson.M{"training_id": 1, "training_status.status": 1}).All(&tr)
+	if err != nil {
+		log.WithField(logger.LogkeyUserID, userID).Errorf("Cannot retrieve the status of training records: %s", err.Error())
+		return nil, err
+	}
+	return tr, nil
+}
+
+// FindTrainings returns the trainings selected by the query, in its sort order
+func (r *trainingsRepository) FindTrainings(q *trainingsQuery) ([]*TrainingRecord, error) {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	selector := bson.M{"user_id": q.UserID}
+	if len(q.Statuses) > 0 {
+		selector["training_status.status"] = bson.M{"$in": q.Statuses}
+	}
+	if q.Framework != "" {
+		selector["model_definition.framework.name"] = q.Framework
+	}
+	if q.NamePrefix != "" {
+		selector["model_definition.name"] = bson.M{"$regex": "^" + regexp.QuoteMeta(q.NamePrefix)}
+	}
+	submitted := bson.M{}
+	if q.SubmittedAfter != "" {
+		submitted["$gte"] = q.SubmittedAfter
+	}
+	if q.SubmittedBefore != "" {
+		submitted["$lt"] = q.SubmittedBefore
+	}
+	if len(submitted) > 0 {
+		selector["training_status.submission_timestamp"] = submitted
+	}
+	selectLabels(selector, q.Labels)
+
+	field := "training_status.submission_timestamp"
+	if q.SortBy == sortByName {
+		field = "model_definition.name"
+	}
+	if q.Cursor != nil {
+		op := "$gt"
+		if q.Descending {
+			op = "$lt"
+		}
+		// empty fields are not stored, a null key matches them
+		var key interface{} = q.Cursor.Key
+		if q.Cursor.Key == "" {
+			key = nil
+		}
+		selector["$or"] = []bson.M{
+			{field: bson.M{op: q.Cursor.Key}},
+			{field: key, "training_id": bson.M{op: q.Cursor.TrainingID}},
+		}
+	}
+
+	sortFields := []string{field, "training_id"}
+	if q.Descending {
+		sortFields = []string{"-" + field, "-training_id"}
+	}
+	query := r.queryDatabase(&selector, sess).Sort(sortFields...)
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
+
+	var tr []*TrainingRecord
+	if err := query.All(&tr); err != nil {
+		log.WithField(logger.LogkeyUserID, q.UserID).Errorf("Cannot retrieve training records: %s", err.Error())
+		return nil, err
+	}
+	return tr, nil
+}
+
 func (r *trainingsRepository) Delete(trainingID string) error {
 	sess := r.session.Clone()
 	defer sess.Close()
@@ -260,7 +370,8 @@ func (r *trainingsRepository) Delete(trainingID string) error {
 			ProcessStartTimestamp:  status.ProcessStartTimestamp,
 			StoreStartTimestamp:    status.StoreStartTimestamp,
 		},
-		Deleted: true,
+		Deleted:          true,
+		DeletedTimestamp: trainerClient.CurrentTimestampAsString(),
 	}
 	_, err1 := sess.DB(r.database).C(r.collection).Upsert(selector, newRecord)
 	if err1 != nil {
@@ -292,10 +403,106 @@ func (r *trainingsRepository) FindCurrentlyRunningTrainings(limit int) ([]*Train
 
 	var tr []*TrainingRecord
 	//sorting by id in descending fashion(hence the - before id), assumption being records in mongo are being created with auto generated id which has a notion of timestamp built into it
-	err := r.queryDatabase(nil, sess).Sort("-_id").Limit(limit).Select(bson.M{"training_status": 1, "training.resources": 2, "training_id": 3}).All(&tr)
+	err := r.queryDatabase(nil, sess).Sort("-_id").Limit(limit).Select(bson.M{"training_status": 1, "training.resources": 2, "training_id": 3, "user_id": 4}).All(&tr)
 	return tr, err
 }
 
+// FindWaitingDependents returns the WAITING trainings that depend on the given training
+func (r *trainingsRepository) FindWaitingDependents(trainingID string) ([]*TrainingRecord, error) {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	var tr []*TrainingRecord
+	err := r.queryDatabase(&bson.M{
+		"training.depends_on":    trainingID,
+		"training_status.status": grpc_trainer_v2.Status_WAITING,
+	}, sess).Sort("_id").All(&tr)
+	if err != nil {
+		logWithTraining(trainingID).WithError(err).Errorf("Cannot retrieve dependent training records")
+		return nil, err
+	}
+	return tr, nil
+}
+
+// FindDeletedBefore returns the soft-deleted trainings that were deleted before the given time in milliseconds since
+// the epoch, oldest first. Trainings deleted before the deletion time was recorded count from their submission.
+func (r *trainingsRepository) FindDeletedBefore(timestamp string, limit int) ([]*TrainingRecord, error) {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	var tr []*TrainingRecord
+	err := r.queryDatabase(&bson.M{
+		"deleted": true,
+		"$or": []bson.M{
+			{"deleted_timestamp": bson.M{"$lt": timestamp}},
+			{
+				"deleted_timestamp":                    bson.M{"$exists": false},
+				"training_status.submission_timestamp": bson.M{"$lt": timestamp},
+			},
+		},
+	}, sess).Sort("_id").Limit(limit).All(&tr)
+	if err != nil {
+		log.WithError(err).Errorf("Cannot retrieve deleted training records")
+		return nil, err
+	}
+	return tr, nil
+}
+
+// FindFinishedBefore returns the completed and failed trainings that finished before the given time in milliseconds
+// since the epoch, oldest first. Trainings with the label keepLabel set to "true" are left out.
+func (r *trainingsRepository) FindFinishedBefore(timestamp string, keepLabel string, limit int) ([]*TrainingRecord, error) {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	selector := bson.M{
+		"training_status.status": bson.M{"$in": []grpc_trainer_v2.Status{
+			grpc_trainer_v2.Status_COMPLETED,
+			grpc_trainer_v2.Status_FAILED,
+		}},
+		"training_status.completion_timestamp": bson.M{"$gt": "", "$lt": timestamp},
+	}
+	if keepLabel != "" {
+		selector["labels."+keepLabel] = bson.M{"$ne": "true"}
+	}
+	var tr []*TrainingRecord
+	err := r.queryDatabase(&selector, sess).Sort("_id").Limit(limit).All(&tr)
+	if err != nil {
+		log.WithError(err).Errorf("Cannot retrieve finished training records")
+		return nil, err
+	}
+	return tr, nil
+}
+
+// selectLabels adds the requirements of a label selector to a mongo selector
+func selectLabels(selector bson.M, labels []labelRequirement) {
+	for _, l := range labels {
+		field := "labels." + l.Key
+		switch {
+		case !l.Exists:
+			selector[field] = bson.M{"$exists": false}
+		case !l.HasValue:
+			selector[field] = bson.M{"$exists": true}
+		case l.Negated:
+			selector[field] = bson.M{"$ne": l.Value}
+		default:
+			selector[field] = l.Value
+		}
+	}
+}
+
+// Purge removes the records of a training for good, whether it was deleted or not
+func (r *trainingsRepository) Purge(trainingID string) error {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	_, err := sess.DB(r.database).C(r.collection).RemoveAll(bson.M{"training_id": trainingID})
+	if err != nil {
+		logWithTraining(trainingID).WithError(err).Errorf("Cannot purge training record")
+		return err
+	}
+	return nil
+}
+
 func (r *trainingsRepository) RecordJobStatus(e *JobHistoryEntry) error {
 	sess := r.session.Clone()
 	defer sess.Close()
@@ -323,6 +530,18 @@ func (r *trainingsRepository) GetJobStatusHistory(trainingID string) []*JobHisto
 	return result
 }
 
+func (r *trainingsRepository) DeleteJobStatusHistory(trainingID string) error {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	_, err := sess.DB(r.database).C(r.collection).RemoveAll(bson.M{"training_id": trainingID})
+	if err != nil {
+		logWithTraining(trainingID).WithError(err).Errorf("Cannot delete job history")
+		return err
+	}
+	return nil
+}
+
 func (r *trainingsRepository) Close() {
 	log.Debugf("Closing mongo session")
 	defer r.session.Close()
@@ -0,0 +1,185 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	tdsService "github.com/IBM/FfDL/metrics/service/grpc_training_data_v1"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// trainings that were deleted longer than retention.deleted.days ago
+	purgeReasonDeleted = "deleted"
+	// completed and failed trainings that finished longer than retention.finished.days ago
+	purgeReasonFinished = "finished"
+)
+
+// startRetentionReaper periodically purges the trainings selected by the retention policy. Every trainer replica runs
+// the reaper, purging a training twice does no harm.
+func (s *trainerService) startRetentionReaper() {
+	logr := logger.LocLogger(logEntry())
+	if viper.GetInt(retentionDeletedDaysKey) <= 0 && viper.GetInt(retentionFinishedDaysKey) <= 0 {
+		logr.Infof("No retention policy set, trainings are kept forever")
+		return
+	}
+	s.stopRetention = make(chan struct{})
+	tick := time.NewTicker(time.Duration(viper.GetInt(retentionIntervalKey)) * time.Second).C
+	go func() {
+		for {
+			select {
+			case <-tick:
+				s.purgeTrainings(viper.GetBool(retentionDryRunKey))
+			case <-s.stopRetention:
+				return
+			}
+		}
+	}()
+}
+
+// retentionCutoff returns the time in milliseconds since the epoch before which the rule with the given number of
+// days applies, or "" if the rule is disabled
+func retentionCutoff(daysKey string, now time.Time) string {
+	days := viper.GetInt(daysKey)
+	if days <= 0 {
+		return ""
+	}
+	cutoff := now.Add(-time.Duration(days) * 24 * time.Hour)
+	return fmt.Sprintf("%d", cutoff.UnixNano()/int64(time.Millisecond))
+}
+
+// findPurgeCandidates returns the trainings selected by the retention policy, each with the rule that selected it
+func (s *trainerService) findPurgeCandidates(now time.Time) ([]*TrainingRecord, []string, error) {
+	limit := viper.GetInt(retentionBatchSizeKey)
+	var records []*TrainingRecord
+	var reasons []string
+
+	if cutoff := retentionCutoff(retentionDeletedDaysKey, now); cutoff != "" {
+		deleted, err := s.repo.FindDeletedBefore(cutoff, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, tr := range deleted {
+			records = append(records, tr)
+			reasons = append(reasons, purgeReasonDeleted)
+		}
+		s.metrics.purgeCandidatesGauge.With("reason", purgeReasonDeleted).Set(float64(len(deleted)))
+	}
+	if cutoff := retentionCutoff(retentionFinishedDaysKey, now); cutoff != "" {
+		finished, err := s.repo.FindFinishedBefore(cutoff, viper.GetString(retentionKeepLabelKey), limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, tr := range finished {
+			records = append(records, tr)
+			reasons = append(reasons, purgeReasonFinished)
+		}
+		s.metrics.purgeCandidatesGauge.With("reason", purgeReasonFinished).Set(float64(len(finished)))
+	}
+	return records, reasons, nil
+}
+
+// purgeTrainings purges the trainings selected by the retention policy and returns them. With dryRun set, the
+// trainings are only reported.
+func (s *trainerService) purgeTrainings(dryRun bool) ([]*grpc_trainer_v2.PurgedTraining, error) {
+	logr := logger.LocLogger(logEntry())
+
+	records, reasons, err := s.findPurgeCandidates(time.Now())
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot find the trainings to purge")
+		return nil, err
+	}
+
+	var report []*grpc_trainer_v2.PurgedTraining
+	for i, tr := range records {
+		if dryRun {
+			logr.Infof("Dry run: training %s of user %s would be purged (%s)", tr.TrainingID, tr.UserID, reasons[i])
+		} else {
+			if err := s.purgeTraining(tr); err != nil {
+				// the training is selected again by the next run
+				continue
+			}
+			logr.Infof("Purged training %s of user %s (%s)", tr.TrainingID, tr.UserID, reasons[i])
+			s.metrics.purgeTrainingJobCounter.With("reason", reasons[i]).Add(1)
+		}
+		report = append(report, &grpc_trainer_v2.PurgedTraining{
+			TrainingId: tr.TrainingID,
+			UserId:     tr.UserID,
+			Reason:     reasons[i],
+		})
+	}
+	return report, nil
+}
+
+// purgeTraining removes everything that is kept about a training. The records in mongo are removed last, so that a
+// training is selected again by the next run if one of the other steps fails.
+func (s *trainerService) purgeTraining(tr *TrainingRecord) error {
+	logr := logger.LocLogger(logWith(tr.TrainingID, tr.UserID))
+
+	err := s.deleteJobFromTDS(&tdsService.Query{
+		Meta: &tdsService.MetaInfo{
+			TrainingId: tr.TrainingID,
+			UserId:     tr.UserID,
+		},
+	}, logr)
+	if err != nil {
+		s.metrics.purgeTrainingJobFailedCounter.With("step", "tds").Add(1)
+		return err
+	}
+
+	// only the results stored in the internal object store are removed, the user's own data stores are left alone
+	err = s.datastore.DeleteTrainedModel(fmt.Sprintf("%s/%s", s.trainedModelsBucket, tr.TrainingID))
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot delete trained model from object store")
+		s.metrics.purgeTrainingJobFailedCounter.With("step", "objectstore").Add(1)
+		return err
+	}
+	// the model definition of a deleted training is usually gone already
+	err = s.datastore.DeleteArchive(s.modelsBucket, getModelZipFileName(tr.TrainingID))
+	if err != nil {
+		logr.WithError(err).Debugf("Cannot delete model definition from object store")
+	}
+
+	if err := s.jobHistoryRepo.DeleteJobStatusHistory(tr.TrainingID); err != nil {
+		s.metrics.purgeTrainingJobFailedCounter.With("step", "mongo").Add(1)
+		return err
+	}
+	if err := s.repo.Purge(tr.TrainingID); err != nil {
+		s.metrics.purgeTrainingJobFailedCounter.With("step", "mongo").Add(1)
+		return err
+	}
+	return nil
+}
+
+// PurgeTrainingJobs runs the retention policy right away. It is meant for administrators and is not exposed through
+// the REST API.
+func (s *trainerService) PurgeTrainingJobs(ctx context.Context, req *grpc_trainer_v2.PurgeRequest) (*grpc_trainer_v2.PurgeResponse, error) {
+	logr := logger.LocLogger(logEntry())
+	logr.Debugf("PurgeTrainingJobs called with dry run %t", req.DryRun)
+
+	report, err := s.purgeTrainings(req.DryRun)
+	if err != nil {
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	return &grpc_trainer_v2.PurgeResponse{Trainings: report}, nil
+}
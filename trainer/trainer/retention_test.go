@@ -0,0 +1,160 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tdsService "github.com/IBM/FfDL/metrics/service/grpc_training_data_v1"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"gopkg.in/mgo.v2"
+)
+
+// fakeTDS records the trainings deleted from the training data service
+type fakeTDS struct {
+	tdsService.TrainingDataClient
+	deleted []string
+	err     error
+}
+
+func (f *fakeTDS) Client() tdsService.TrainingDataClient {
+	return f
+}
+
+func (f *fakeTDS) Close() error {
+	return nil
+}
+
+func (f *fakeTDS) DeleteJob(ctx context.Context, in *tdsService.Query, opts ...grpc.CallOption) (*tdsService.DeleteResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, in.Meta.TrainingId)
+	return &tdsService.DeleteResponse{Success: true}, nil
+}
+
+func daysAgo(days int) string {
+	return fmt.Sprintf("%d", time.Now().Add(-time.Duration(days)*24*time.Hour).UnixNano()/int64(time.Millisecond))
+}
+
+func createRetentionRecord(id string, status grpc_trainer_v2.Status, completedDaysAgo int) *TrainingRecord {
+	tr := createParentRecord(id, "alice", status)
+	tr.JobID = id
+	tr.TrainingStatus.SubmissionTimestamp = daysAgo(completedDaysAgo + 1)
+	tr.TrainingStatus.CompletionTimestamp = daysAgo(completedDaysAgo)
+	return tr
+}
+
+func TestPurgeTrainings(t *testing.T) {
+	viper.Set(retentionDeletedDaysKey, 7)
+	viper.Set(retentionFinishedDaysKey, 180)
+	defer viper.Set(retentionDeletedDaysKey, 0)
+	defer viper.Set(retentionFinishedDaysKey, 0)
+
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	tds := &fakeTDS{}
+	s.tds = tds
+
+	oldDeleted := createRetentionRecord("old-deleted", grpc_trainer_v2.Status_COMPLETED, 20)
+	oldDeleted.Deleted = true
+	oldDeleted.DeletedTimestamp = daysAgo(8)
+	newDeleted := createRetentionRecord("new-deleted", grpc_trainer_v2.Status_COMPLETED, 20)
+	newDeleted.Deleted = true
+	newDeleted.DeletedTimestamp = daysAgo(1)
+	kept := createRetentionRecord("kept", grpc_trainer_v2.Status_COMPLETED, 200)
+	kept.Labels = map[string]string{"keep": "true"}
+	for _, tr := range []*TrainingRecord{
+		oldDeleted,
+		newDeleted,
+		createRetentionRecord("old-completed", grpc_trainer_v2.Status_COMPLETED, 200),
+		createRetentionRecord("new-completed", grpc_trainer_v2.Status_COMPLETED, 10),
+		createRetentionRecord("old-halted", grpc_trainer_v2.Status_HALTED, 200),
+		kept,
+	} {
+		assert.NoError(t, s.repo.Store(tr))
+	}
+	assert.NoError(t, s.jobHistoryRepo.RecordJobStatus(&JobHistoryEntry{
+		TrainingID: "old-completed",
+		Timestamp:  daysAgo(200),
+		Status:     grpc_trainer_v2.Status_COMPLETED,
+	}))
+	assert.NoError(t, s.datastore.UploadArchive(s.modelsBucket, getModelZipFileName("old-completed"), []byte("model")))
+	assert.NoError(t, s.datastore.UploadArchive(s.trainedModelsBucket, "old-completed/learner-1/model.bin", []byte("1")))
+	assert.NoError(t, s.usageRepo.Record(&UsageRecord{TrainingID: "old-completed", UserID: "alice",
+		CompletionTimestamp: daysAgo(200), Usage: &grpc_trainer_v2.ResourceUsage{GpuHours: 1}}))
+
+	// a dry run only reports the trainings
+	resp, err := s.PurgeTrainingJobs(context.Background(), &grpc_trainer_v2.PurgeRequest{DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []*grpc_trainer_v2.PurgedTraining{
+		{TrainingId: "old-deleted", UserId: "alice", Reason: purgeReasonDeleted},
+		{TrainingId: "old-completed", UserId: "alice", Reason: purgeReasonFinished},
+	}, resp.Trainings)
+	_, err = s.repo.Find("old-completed")
+	assert.NoError(t, err)
+	assert.Empty(t, tds.deleted)
+
+	report, err := s.purgeTrainings(false)
+	assert.NoError(t, err)
+	assert.Equal(t, resp.Trainings, report)
+	assert.Equal(t, []string{"old-deleted", "old-completed"}, tds.deleted)
+	_, err = s.repo.Find("old-completed")
+	assert.Equal(t, mgo.ErrNotFound, err)
+	deleted, err := s.repo.FindDeletedBefore(daysAgo(0), 0)
+	assert.NoError(t, err)
+	if assert.Len(t, deleted, 1) {
+		assert.Equal(t, "new-deleted", deleted[0].TrainingID)
+	}
+	assert.Empty(t, s.jobHistoryRepo.GetJobStatusHistory("old-completed"))
+	model, _ := s.datastore.DownloadArchive(s.modelsBucket, getModelZipFileName("old-completed"))
+	assert.Nil(t, model)
+	model, _ = s.datastore.DownloadArchive(s.trainedModelsBucket, "old-completed/learner-1/model.bin")
+	assert.Nil(t, model)
+	// the usage of purged trainings is kept
+	usage, err := s.usageRepo.Find(&usageQuery{UserID: "alice"})
+	assert.NoError(t, err)
+	if assert.Len(t, usage, 1) {
+		assert.Equal(t, "old-completed", usage[0].TrainingID)
+	}
+	for _, id := range []string{"new-completed", "old-halted", "kept"} {
+		_, err = s.repo.Find(id)
+		assert.NoError(t, err, id)
+	}
+}
+
+func TestPurgeTrainingsKeepsRecordOnFailure(t *testing.T) {
+	viper.Set(retentionFinishedDaysKey, 180)
+	defer viper.Set(retentionFinishedDaysKey, 0)
+
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	s.tds = &fakeTDS{err: errors.New("training data service unavailable")}
+	assert.NoError(t, s.repo.Store(createRetentionRecord("old-completed", grpc_trainer_v2.Status_FAILED, 200)))
+
+	report, err := s.purgeTrainings(false)
+	assert.NoError(t, err)
+	assert.Empty(t, report)
+	// the next run tries again
+	_, err = s.repo.Find("old-completed")
+	assert.NoError(t, err)
+}
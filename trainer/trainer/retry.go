@@ -0,0 +1,227 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/commons/service"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/mgo.v2"
+)
+
+// defaultRetryErrorCodes are the infrastructure errors that are likely to go away when the job is started again
+const defaultRetryErrorCodes = trainerClient.ErrCodeInsufficientResources + "," + trainerClient.ErrCodeImagePull + "," +
+	trainerClient.ErrCodeDeployInterrupted + "," + trainerClient.ErrCodeK8SConnection + "," + trainerClient.ErrCodeEtcdConnection
+
+// staleDeploymentReason is the status message of a queued training whose previous deployment is still being deleted
+const staleDeploymentReason = "waiting for the deployment of the previous attempt to be deleted"
+
+// retryPolicy returns the retry policy of a training, with the server defaults filled in for the fields the user
+// did not set
+func retryPolicy(t *grpc_trainer_v2.Training) *grpc_trainer_v2.RetryPolicy {
+	policy := &grpc_trainer_v2.RetryPolicy{}
+	if t.RetryPolicy != nil {
+		*policy = *t.RetryPolicy
+	}
+	if policy.MaxAttempts == 0 {
+		policy.MaxAttempts = int32(viper.GetInt(retryAttemptsKey))
+	}
+	if len(policy.ErrorCodes) == 0 {
+		for _, code := range strings.Split(viper.GetString(retryErrorCodesKey), ",") {
+			if code = strings.TrimSpace(code); code != "" {
+				policy.ErrorCodes = append(policy.ErrorCodes, code)
+			}
+		}
+	}
+	if policy.BackoffSeconds == 0 {
+		policy.BackoffSeconds = int32(viper.GetInt(retryBackoffKey))
+	}
+	return policy
+}
+
+// validateRetryPolicy returns a message describing what is wrong with the retry policy of a training, or ""
+func validateRetryPolicy(policy *grpc_trainer_v2.RetryPolicy) string {
+	if policy == nil {
+		return ""
+	}
+	if max := int32(viper.GetInt(maxRetryAttemptsKey)); policy.MaxAttempts < 0 || policy.MaxAttempts > max {
+		return fmt.Sprintf("Retry max_attempts must be between 0 and %d", max)
+	}
+	if policy.BackoffSeconds < 0 {
+		return "Retry backoff must not be negative"
+	}
+	return ""
+}
+
+// retryBackoff returns the time to wait before the given retry, starting at 1
+func retryBackoff(policy *grpc_trainer_v2.RetryPolicy, retry int32) time.Duration {
+	backoff := time.Duration(policy.BackoffSeconds) * time.Second
+	for i := int32(1); i < retry; i++ {
+		backoff *= 2
+	}
+	return backoff
+}
+
+func isRetryable(policy *grpc_trainer_v2.RetryPolicy, errorCode string) bool {
+	for _, code := range policy.ErrorCodes {
+		if code == errorCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoffReason returns why a retried training may not be started yet, or "" once its backoff has elapsed
+func backoffReason(tr *TrainingRecord, now time.Time) string {
+	if tr.RetryAfter == 0 {
+		return ""
+	}
+	retryAfter := time.Unix(0, tr.RetryAfter*int64(time.Millisecond))
+	if !now.Before(retryAfter) {
+		return ""
+	}
+	return fmt.Sprintf("retry %d is started after %s", tr.Retries, retryAfter.UTC().Format(time.RFC3339))
+}
+
+// retryFailedJob queues a training that failed with a retryable error code again, if it has attempts left. The
+// failure and the retry are both recorded in the job history. It returns false if the failure is final.
+func (s *trainerService) retryFailedJob(tr *TrainingRecord, req *grpc_trainer_v2.UpdateRequest, logr *logger.LocLoggingEntry) bool {
+	policy := retryPolicy(tr.Training)
+	if !isRetryable(policy, req.ErrorCode) || tr.Retries+1 >= policy.MaxAttempts {
+		return false
+	}
+
+	gpuType := TransformResourceName(tr.Training.Resources.GpuType)
+	qHandler := s.queues[gpuType]
+	if qHandler == nil {
+		qHandler = s.queues["ANY"]
+	}
+
+	// the failure is recorded by the caller if the training cannot be queued again
+	retries, retryAfter, staleDeployment, status := tr.Retries, tr.RetryAfter, tr.StaleDeployment, *tr.TrainingStatus
+	rollback := func() {
+		tr.Retries, tr.RetryAfter, tr.StaleDeployment, *tr.TrainingStatus = retries, retryAfter, staleDeployment, status
+	}
+
+	tr.Retries++
+	// the next attempt runs under the same training id, so it is only started once the LCM confirmed that the
+	// deployment of the failed attempt is gone, otherwise the cleanup of the failed attempt could kill it
+	tr.StaleDeployment = tr.JobID
+	tr.RetryAfter = time.Now().Add(retryBackoff(policy, tr.Retries)).UnixNano() / int64(time.Millisecond)
+	message := fmt.Sprintf("Retrying after error %s, attempt %d of %d", req.ErrorCode, tr.Retries+1, policy.MaxAttempts)
+	tr.TrainingStatus.Status = grpc_trainer_v2.Status_QUEUED
+	tr.TrainingStatus.StatusMessage = message
+	tr.TrainingStatus.ErrorCode = ""
+
+	// store the record before enqueueing it, so the queue never sees the job as FAILED
+	if err := s.repo.Store(tr); err != nil {
+		logr.WithError(err).Errorf("Failed to store retried training %s", tr.TrainingID)
+		rollback()
+		return false
+	}
+	if err := qHandler.Enqueue(tr.TrainingID, tr.Priority); err != nil {
+		logr.WithError(err).Errorf("Failed to enqueue retried training %s", tr.TrainingID)
+		rollback()
+		if err := s.repo.Store(tr); err != nil {
+			logr.WithError(err).Errorf("Failed to roll back retried training %s", tr.TrainingID)
+		}
+		return false
+	}
+	s.metrics.enqueueJobCounter.Add(1)
+	s.metrics.retryTrainingJobCounter.With("errorcode", req.ErrorCode).Add(1)
+	logr.Infof("Training %s failed with error code %s, queued attempt %d of %d", tr.TrainingID, req.ErrorCode, tr.Retries+1, policy.MaxAttempts)
+
+	timestamp := req.Timestamp
+	if timestamp == "" {
+		timestamp = trainerClient.CurrentTimestampAsString()
+	}
+	s.recordJobStatus(tr.UserID, &JobHistoryEntry{
+		TrainingID:    tr.TrainingID,
+		Timestamp:     timestamp,
+		Status:        grpc_trainer_v2.Status_FAILED,
+		StatusMessage: req.StatusMessage,
+		ErrorCode:     req.ErrorCode,
+	})
+	s.recordJobStatus(tr.UserID, &JobHistoryEntry{
+		TrainingID:    tr.TrainingID,
+		Timestamp:     trainerClient.CurrentTimestampAsString(),
+		Status:        grpc_trainer_v2.Status_QUEUED,
+		StatusMessage: message,
+	})
+	return true
+}
+
+// cleanupStaleDeployment deletes the deployment of the previous attempt of a queued training in the background, unless
+// that is already in progress. The training may be started once the deletion cleared its StaleDeployment.
+func (s *trainerService) cleanupStaleDeployment(tr *TrainingRecord, logr *logger.LocLoggingEntry) {
+	if _, running := s.staleDeployments.LoadOrStore(tr.TrainingID, true); running {
+		return
+	}
+	go func() {
+		defer s.staleDeployments.Delete(tr.TrainingID)
+		if err := s.deleteStaleDeployment(tr.TrainingID, tr.UserID, tr.StaleDeployment, logr); err != nil {
+			logr.WithError(err).Warnf("Deployment %s of training %s is not deleted yet", tr.StaleDeployment, tr.TrainingID)
+		}
+	}()
+}
+
+// deleteStaleDeployment asks the LCM to delete the deployment with the given name, and clears the StaleDeployment of
+// the training once the LCM confirmed that the deployment is gone
+func (s *trainerService) deleteStaleDeployment(trainingID string, userID string, name string, logr *logger.LocLoggingEntry) error {
+	lcm, err := s.lcmClient()
+	if err != nil {
+		return err
+	}
+	defer lcm.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	_, err = lcm.Client().KillTrainingJob(ctx, &service.JobKillRequest{
+		Name:       name,
+		TrainingId: trainingID,
+		UserId:     userID,
+	})
+	// tolerate "not found" because it just means the deployment is already gone
+	if err != nil && grpcCode(err) != codes.NotFound {
+		return err
+	}
+	logr.Infof("Deployment %s of training %s is deleted", name, trainingID)
+
+	tr, err := s.repo.Find(trainingID)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	if tr.StaleDeployment != name {
+		return nil
+	}
+	tr.StaleDeployment = ""
+	if tr.TrainingStatus.StatusMessage == staleDeploymentReason {
+		tr.TrainingStatus.StatusMessage = ""
+	}
+	return s.repo.Store(tr)
+}
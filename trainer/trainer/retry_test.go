@@ -0,0 +1,229 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	// sets the configuration defaults
+	newInMemTestService(t, map[string]*queueHandler{})
+
+	policy := retryPolicy(&grpc_trainer_v2.Training{})
+	assert.EqualValues(t, 1, policy.MaxAttempts)
+	assert.Equal(t, []string{"S100", "S103", "S105", "S200", "S201"}, policy.ErrorCodes)
+	assert.Equal(t, time.Minute, retryBackoff(policy, 1))
+	assert.Equal(t, 4*time.Minute, retryBackoff(policy, 3))
+
+	policy = retryPolicy(&grpc_trainer_v2.Training{
+		RetryPolicy: &grpc_trainer_v2.RetryPolicy{MaxAttempts: 3, ErrorCodes: []string{"S101"}},
+	})
+	assert.EqualValues(t, 3, policy.MaxAttempts)
+	assert.True(t, isRetryable(policy, "S101"))
+	assert.False(t, isRetryable(policy, "S100"))
+
+	assert.Empty(t, validateRetryPolicy(&grpc_trainer_v2.RetryPolicy{MaxAttempts: 5}))
+	// no attempts take the default of the deployment
+	assert.Empty(t, validateRetryPolicy(&grpc_trainer_v2.RetryPolicy{MaxAttempts: 0}))
+	assert.Equal(t, "Retry max_attempts must be between 0 and 5", validateRetryPolicy(&grpc_trainer_v2.RetryPolicy{MaxAttempts: 6}))
+	assert.NotEmpty(t, validateRetryPolicy(&grpc_trainer_v2.RetryPolicy{BackoffSeconds: -1}))
+}
+
+func TestRetryFailedJob(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	tr := createParentRecord("flaky", "alice", grpc_trainer_v2.Status_PROCESSING)
+	tr.JobID = "job-1"
+	tr.Training.RetryPolicy = &grpc_trainer_v2.RetryPolicy{MaxAttempts: 2}
+	assert.NoError(t, s.repo.Store(tr))
+
+	fail := &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "flaky",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_FAILED,
+		ErrorCode:  trainerClient.ErrCodeImagePull,
+	}
+	_, err := s.UpdateTrainingJob(context.Background(), fail)
+	assert.NoError(t, err)
+
+	tr, _ = s.repo.Find("flaky")
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, tr.TrainingStatus.Status)
+	assert.EqualValues(t, 1, tr.Retries)
+	assert.Equal(t, "job-1", tr.StaleDeployment)
+	id, _ := queue.Peek()
+	assert.Equal(t, "flaky", id)
+	history := s.jobHistoryRepo.GetJobStatusHistory("flaky")
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, trainerClient.ErrCodeImagePull, history[0].ErrorCode)
+		assert.Equal(t, "Retrying after error S103, attempt 2 of 2", history[1].StatusMessage)
+	}
+
+	// the job is not started before the backoff has elapsed
+	assert.NotEmpty(t, backoffReason(tr, time.Now()))
+	assert.Empty(t, backoffReason(tr, time.Now().Add(time.Minute)))
+
+	// late updates of the failed attempt are ignored
+	_, err = s.UpdateTrainingJob(context.Background(), fail)
+	assert.NoError(t, err)
+	status, _ := s.repo.FindTrainingStatusID("flaky")
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, status)
+
+	// the last attempt fails for good
+	tr.TrainingStatus.Status = grpc_trainer_v2.Status_PROCESSING
+	assert.NoError(t, s.repo.Store(tr))
+	_, err = s.UpdateTrainingJob(context.Background(), fail)
+	assert.NoError(t, err)
+	tr, _ = s.repo.Find("flaky")
+	assert.Equal(t, grpc_trainer_v2.Status_FAILED, tr.TrainingStatus.Status)
+	assert.EqualValues(t, 1, tr.Retries)
+}
+
+// failingQueue is a queue that cannot take any more jobs
+type failingQueue struct {
+	JobQueue
+}
+
+func (q failingQueue) Enqueue(id string, priority int32) error {
+	return fmt.Errorf("queue is not available")
+}
+
+func TestRetryFailedJobEnqueueFails(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), failingQueue{newInMemJobQueue()}},
+	})
+	tr := createParentRecord("flaky", "alice", grpc_trainer_v2.Status_PROCESSING)
+	tr.Training.RetryPolicy = &grpc_trainer_v2.RetryPolicy{MaxAttempts: 2}
+	assert.NoError(t, s.repo.Store(tr))
+
+	_, err := s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "flaky",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_FAILED,
+		ErrorCode:  trainerClient.ErrCodeImagePull,
+	})
+	assert.NoError(t, err)
+
+	// the failure is final, and the attempt that was never queued is not counted
+	tr, _ = s.repo.Find("flaky")
+	assert.Equal(t, grpc_trainer_v2.Status_FAILED, tr.TrainingStatus.Status)
+	assert.Equal(t, trainerClient.ErrCodeImagePull, tr.TrainingStatus.ErrorCode)
+	assert.EqualValues(t, 0, tr.Retries)
+	assert.EqualValues(t, 0, tr.RetryAfter)
+}
+
+func TestFailedJobNotRetryable(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	tr := createParentRecord("broken", "alice", grpc_trainer_v2.Status_PROCESSING)
+	tr.Training.RetryPolicy = &grpc_trainer_v2.RetryPolicy{MaxAttempts: 3}
+	assert.NoError(t, s.repo.Store(tr))
+
+	_, err := s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "broken",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_FAILED,
+		ErrorCode:  trainerClient.ErrCodeFailLoadModel,
+	})
+	assert.NoError(t, err)
+	status, _ := s.repo.FindTrainingStatusID("broken")
+	assert.Equal(t, grpc_trainer_v2.Status_FAILED, status)
+}
+
+func TestPullJobFromQueueSkipsBackoff(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	lcm := &fakeLCM{}
+	s.lcm = lcm
+
+	retried := createDeployableRecord("retried", "alice", 1)
+	retried.Retries = 1
+	retried.RetryAfter = time.Now().Add(time.Minute).UnixNano() / int64(time.Millisecond)
+	assert.NoError(t, s.repo.Store(retried))
+	assert.NoError(t, queue.Enqueue("retried", 0))
+	assert.NoError(t, s.repo.Store(createDeployableRecord("next", "bob", 1)))
+	assert.NoError(t, queue.Enqueue("next", 0))
+
+	// the job behind the retried one is started although backfilling is disabled
+	s.pullJobFromQueue("ANY")
+	assert.Equal(t, []string{"next"}, lcm.deployed)
+	id, _ := queue.Peek()
+	assert.Equal(t, "retried", id)
+	tr, _ := s.repo.Find("retried")
+	assert.Contains(t, tr.TrainingStatus.StatusMessage, "retry 1 is started after")
+
+	// the retried job is started once its backoff has elapsed
+	tr.RetryAfter = time.Now().Add(-time.Second).UnixNano() / int64(time.Millisecond)
+	assert.NoError(t, s.repo.Store(tr))
+	s.pullJobFromQueue("ANY")
+	assert.Equal(t, []string{"next", "retried"}, lcm.deployed)
+	empty, _ := queue.Empty()
+	assert.True(t, empty)
+}
+
+func TestPullJobFromQueueWaitsForStaleDeployment(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+	lcm := &fakeLCM{killErr: gerrf(codes.Unavailable, "still being deleted")}
+	s.lcm = lcm
+
+	retried := createDeployableRecord("retried", "alice", 1)
+	retried.Retries = 1
+	retried.StaleDeployment = "job-old"
+	assert.NoError(t, s.repo.Store(retried))
+	assert.NoError(t, queue.Enqueue("retried", 0))
+
+	// pretend the background cleanup is running, so the test deletes the deployment itself
+	s.staleDeployments.Store("retried", true)
+	s.pullJobFromQueue("ANY")
+	assert.Empty(t, lcm.deployed)
+	tr, _ := s.repo.Find("retried")
+	assert.Equal(t, staleDeploymentReason, tr.TrainingStatus.StatusMessage)
+
+	// the job stays queued until the LCM confirmed that the deployment is gone
+	assert.Error(t, s.deleteStaleDeployment("retried", "alice", "job-old", logger.LocLogger(logWith("retried", "alice"))))
+	tr, _ = s.repo.Find("retried")
+	assert.Equal(t, "job-old", tr.StaleDeployment)
+
+	lcm.killErr = nil
+	assert.NoError(t, s.deleteStaleDeployment("retried", "alice", "job-old", logger.LocLogger(logWith("retried", "alice"))))
+	assert.Equal(t, []string{"job-old", "job-old"}, lcm.killed)
+	tr, _ = s.repo.Find("retried")
+	assert.Empty(t, tr.StaleDeployment)
+	assert.Empty(t, tr.TrainingStatus.StatusMessage)
+
+	s.pullJobFromQueue("ANY")
+	assert.Equal(t, []string{"retried"}, lcm.deployed)
+	tr, _ = s.repo.Find("retried")
+	assert.NotEmpty(t, tr.JobID)
+}
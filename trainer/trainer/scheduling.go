@@ -0,0 +1,132 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// maximum number of node labels and tolerations a training may set
+const maxSchedulingConstraints = 20
+
+var (
+	tolerationOperators = map[string]bool{"": true, "Equal": true, "Exists": true}
+	taintEffects        = map[string]bool{"": true, "NoSchedule": true, "PreferNoSchedule": true, "NoExecute": true}
+
+	// node labels the LCM selects from other fields of the training
+	reservedNodeLabels = map[string]string{
+		"kubernetes.io/arch":      "architecture",
+		"beta.kubernetes.io/arch": "architecture",
+	}
+)
+
+// isSchedulingKeyAllowed returns whether users may select nodes by a label key or tolerate taints with the key. The
+// deployment can restrict the keys with the comma separated keys in learner.scheduling.keys, where a trailing * matches
+// all keys with the prefix.
+func isSchedulingKeyAllowed(key string) bool {
+	allowed := strings.TrimSpace(viper.GetString(learnerSchedulingKeysKey))
+	if allowed == "" {
+		return true
+	}
+	for _, pattern := range strings.Split(allowed, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == key || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(key, strings.TrimSuffix(pattern, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateScheduling returns a message describing what is wrong with the scheduling constraints of a training, or "".
+func validateScheduling(r *grpc_trainer_v2.ResourceRequirements) string {
+	if r == nil {
+		return ""
+	}
+	switch strings.ToLower(r.Schedpolicy) {
+	case "", "spread", "pack", "dense":
+	default:
+		return fmt.Sprintf("Scheduling policy '%s' must be spread or pack", r.Schedpolicy)
+	}
+	if r.Architecture != "" && len(validation.IsValidLabelValue(r.Architecture)) > 0 {
+		return fmt.Sprintf("'%s' is not a valid architecture", r.Architecture)
+	}
+	if len(r.NodeSelector)+len(r.Tolerations) > maxSchedulingConstraints {
+		return fmt.Sprintf("A training can set at most %d node selector labels and tolerations", maxSchedulingConstraints)
+	}
+
+	// check the keys in order, so the message does not change between calls
+	keys := make([]string, 0, len(r.NodeSelector))
+	for key := range r.NodeSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Sprintf("Node selector key '%s' is not valid: %s", key, errs[0])
+		}
+		if field, ok := reservedNodeLabels[key]; ok {
+			return fmt.Sprintf("Node selector key '%s' cannot be set, use %s instead", key, field)
+		}
+		if !isSchedulingKeyAllowed(key) {
+			return fmt.Sprintf("Node selector key '%s' is not allowed", key)
+		}
+		if errs := validation.IsValidLabelValue(r.NodeSelector[key]); len(errs) > 0 {
+			return fmt.Sprintf("Value of node selector key '%s' is not valid: %s", key, errs[0])
+		}
+	}
+
+	for i, t := range r.Tolerations {
+		if t == nil || t.Key == "" {
+			return fmt.Sprintf("Toleration %d has no key", i+1)
+		}
+		if errs := validation.IsQualifiedName(t.Key); len(errs) > 0 {
+			return fmt.Sprintf("Toleration key '%s' is not valid: %s", t.Key, errs[0])
+		}
+		if !isSchedulingKeyAllowed(t.Key) {
+			return fmt.Sprintf("Toleration key '%s' is not allowed", t.Key)
+		}
+		if !tolerationOperators[t.Operator] {
+			return fmt.Sprintf("Operator of toleration '%s' must be Equal or Exists", t.Key)
+		}
+		if t.Operator == "Exists" && t.Value != "" {
+			return fmt.Sprintf("Toleration '%s' with operator Exists cannot have a value", t.Key)
+		}
+		if errs := validation.IsValidLabelValue(t.Value); len(errs) > 0 {
+			return fmt.Sprintf("Value of toleration '%s' is not valid: %s", t.Key, errs[0])
+		}
+		if !taintEffects[t.Effect] {
+			return fmt.Sprintf("Effect of toleration '%s' must be NoSchedule, PreferNoSchedule or NoExecute", t.Key)
+		}
+	}
+	return ""
+}
+
+// lcmTolerations returns the tolerations of a training as the LCM expects them
+func lcmTolerations(tolerations []*grpc_trainer_v2.Toleration) []*service.Toleration {
+	var result []*service.Toleration
+	for _, t := range tolerations {
+		result = append(result, &service.Toleration{Key: t.Key, Operator: t.Operator, Value: t.Value, Effect: t.Effect})
+	}
+	return result
+}
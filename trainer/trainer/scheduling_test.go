@@ -0,0 +1,95 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/commons/service"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidateScheduling(t *testing.T) {
+	valid := &grpc_trainer_v2.ResourceRequirements{
+		Schedpolicy:  "Spread",
+		Architecture: "ppc64le",
+		NodeSelector: map[string]string{"pool": "training", "node.example.com/disk": "ssd"},
+		Tolerations: []*grpc_trainer_v2.Toleration{
+			{Key: "dedicated", Value: "ffdl", Effect: "NoSchedule"},
+			{Key: "preemptible", Operator: "Exists"},
+		},
+	}
+	assert.Empty(t, validateScheduling(nil))
+	assert.Empty(t, validateScheduling(&grpc_trainer_v2.ResourceRequirements{}))
+	assert.Empty(t, validateScheduling(valid))
+
+	for _, r := range []*grpc_trainer_v2.ResourceRequirements{
+		{Schedpolicy: "random"},
+		{Architecture: "x86 64"},
+		{NodeSelector: map[string]string{"-pool": "training"}},
+		{NodeSelector: map[string]string{"pool": "not valid"}},
+		{NodeSelector: map[string]string{"kubernetes.io/arch": "amd64"}},
+		{Tolerations: []*grpc_trainer_v2.Toleration{nil}},
+		{Tolerations: []*grpc_trainer_v2.Toleration{{Value: "ffdl"}}},
+		{Tolerations: []*grpc_trainer_v2.Toleration{{Key: "dedicated", Operator: "In"}}},
+		{Tolerations: []*grpc_trainer_v2.Toleration{{Key: "dedicated", Operator: "Exists", Value: "ffdl"}}},
+		{Tolerations: []*grpc_trainer_v2.Toleration{{Key: "dedicated", Effect: "NoRun"}}},
+	} {
+		assert.NotEmpty(t, validateScheduling(r), "%v", r)
+	}
+
+	viper.Set(learnerSchedulingKeysKey, "pool, node.example.com/*")
+	defer viper.Set(learnerSchedulingKeysKey, "")
+	assert.Equal(t, "Toleration key 'dedicated' is not allowed", validateScheduling(valid))
+	valid.Tolerations = []*grpc_trainer_v2.Toleration{{Key: "node.example.com/preemptible", Operator: "Exists"}}
+	assert.Empty(t, validateScheduling(valid))
+	valid.NodeSelector["zone"] = "a"
+	assert.Equal(t, "Node selector key 'zone' is not allowed", validateScheduling(valid))
+}
+
+func TestRenderTrainingJobScheduling(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	lcm := &fakeLCM{}
+	s.lcm = lcm
+	assert.NoError(t, s.repo.Store(createParentRecord("parent", "alice", grpc_trainer_v2.Status_COMPLETED)))
+
+	req := createDependentRequest("parent")
+	req.Training.Resources = &grpc_trainer_v2.ResourceRequirements{
+		Learners:     2,
+		Schedpolicy:  "spread",
+		Architecture: "ppc64le",
+		NodeSelector: map[string]string{"pool": "training"},
+		Tolerations:  []*grpc_trainer_v2.Toleration{{Key: "dedicated", Value: "ffdl", Effect: "NoSchedule"}},
+	}
+	_, err := s.RenderTrainingJob(context.Background(), req)
+	assert.NoError(t, err)
+	if assert.Len(t, lcm.rendered, 1) {
+		resources := lcm.rendered[0].Resources
+		assert.Equal(t, "spread", resources.Schedpolicy)
+		assert.Equal(t, "ppc64le", resources.Architecture)
+		assert.Equal(t, map[string]string{"pool": "training"}, resources.NodeSelector)
+		assert.Equal(t, []*service.Toleration{{Key: "dedicated", Value: "ffdl", Effect: "NoSchedule"}}, resources.Tolerations)
+	}
+
+	req.Training.Resources.NodeSelector = map[string]string{"kubernetes.io/arch": "amd64"}
+	_, err = s.RenderTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
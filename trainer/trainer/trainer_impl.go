@@ -69,6 +69,9 @@ const (
 
 	collectionNameTrainingJobs = "training_jobs"
 	collectionNameJobHistory   = "job_history"
+	collectionNameExperiments  = "experiments"
+	collectionNameIdempotency  = "idempotency_keys"
+	collectionNameUsage        = "usage"
 
 	debugLogsMode = false
 
@@ -83,6 +86,70 @@ const (
 	gpuLimitsQuerySizeKey = "gpu.limits.query.size"
 
 	pollIntervalKey = "queue.poll.interval"
+
+	// set to "local" to keep training records and queues in memory instead of mongo
+	trainerModeKey = "trainer.mode"
+	localMode      = "local"
+
+	// number of jobs behind a blocked head of the queue that are considered for backfilling, 0 disables backfill
+	backfillWindowKey = "queue.backfill.window"
+	// time in seconds after which a head of the queue blocked by the GPU limit stops backfilling
+	backfillReservationKey = "queue.backfill.reservation"
+
+	// maximum absolute priority a user may request for a training job
+	maxPriorityKey = "queue.priority.max"
+	// time in seconds a job has to wait in the queue to gain one priority level
+	priorityAgingIntervalKey = "queue.priority.aging.interval"
+
+	// maximum number of trials of a single experiment
+	maxExperimentTrialsKey = "experiment.trials.max"
+
+	// number of attempts of a training job that fails with a retryable error code, 1 disables retries. Stuck jobs
+	// the LCM reconciler fails with S105 are only redeployed if this is above 1 or the job asks for more attempts.
+	retryAttemptsKey = "retry.attempts.default"
+	// maximum number of attempts a user may request for a training job
+	maxRetryAttemptsKey = "retry.attempts.max"
+	// comma separated error codes of failures that are retried
+	retryErrorCodesKey = "retry.errorcodes"
+	// time in seconds to wait before the first retry of a training job
+	retryBackoffKey = "retry.backoff"
+
+	// maximum duration of a training job that does not set max_duration, such as "24h", 0 for unlimited
+	defaultMaxDurationKey = "maxduration.default"
+	// maximum duration a user may request for a training job, 0 for unlimited
+	maxMaxDurationKey = "maxduration.max"
+
+	// time in seconds after which a watch stream checks for status changes handled by other trainer replicas
+	watchPollIntervalKey = "watch.poll.interval"
+
+	// time in seconds between two runs of the retention reaper
+	retentionIntervalKey = "retention.interval"
+	// days after their deletion after which deleted trainings are purged, 0 keeps them forever
+	retentionDeletedDaysKey = "retention.deleted.days"
+	// days after they finished after which completed and failed trainings are purged, 0 keeps them forever
+	retentionFinishedDaysKey = "retention.finished.days"
+	// label that keeps a finished training from being purged when it is set to "true"
+	retentionKeepLabelKey = "retention.keep.label"
+	// set to true to only report the trainings the retention reaper would purge
+	retentionDryRunKey = "retention.dryrun"
+	// maximum number of trainings purged by each retention rule in one run
+	retentionBatchSizeKey = "retention.batch.size"
+
+	// time in seconds during which a create request with the same idempotency key returns the same training
+	idempotencyTTLKey = "idempotency.ttl"
+
+	// colon separated git protocols model definitions may be fetched with, as in GIT_ALLOW_PROTOCOL
+	modelGitProtocolsKey = "modeldefinition.git.protocols"
+	// maximum size in MB of a git repository that a model definition is fetched from
+	modelGitMaxSizeKey = "modeldefinition.git.maxsize"
+	// maximum size in MB of a fetched model definition archive
+	modelMaxSizeKey = "modeldefinition.maxsize"
+
+	// comma separated environment variables users cannot set for the learner, in addition to the built-in ones
+	learnerEnvDenylistKey = "learner.env.denylist"
+
+	// comma separated node label and taint keys users may use in node selectors and tolerations, all if empty
+	learnerSchedulingKeysKey = "learner.scheduling.keys"
 )
 
 const (
@@ -104,17 +171,26 @@ type Service interface {
 
 type trainerMetrics struct {
 	createTrainingJobCounter          metrics.Counter
+	replayTrainingJobCounter          metrics.Counter
 	deleteTrainingJobCounter          metrics.Counter
 	haltTrainingJobCounter            metrics.Counter
 	downloadTrainedModelJobCounter    metrics.Counter
 	downloadTrainingMetricsJobCounter metrics.Counter
 	rateLimitTrainingJobCounter       metrics.Counter
+	quotaLimitTrainingJobCounter      metrics.Counter
 	trainingJobFailedCounter          metrics.Counter
 	trainingJobSucceededCounter       metrics.Counter
 	uploadModelFailedCounter          metrics.Counter
 	enqueueJobCounter                 metrics.Counter
 	dequeueJobCounter                 metrics.Counter
+	backfillJobCounter                metrics.Counter
+	retryTrainingJobCounter           metrics.Counter
+	createExperimentCounter           metrics.Counter
+	experimentTrialCounter            metrics.Counter
 	deleteJobFromQueueCounter         metrics.Counter
+	purgeTrainingJobCounter           metrics.Counter
+	purgeTrainingJobFailedCounter     metrics.Counter
+	purgeCandidatesGauge              metrics.Gauge
 	queueSizeGauge                    metrics.Gauge
 	clusterWideGPUUsageGauge          metrics.Gauge
 	clusterWideGPUUsageCounter        metrics.Counter
@@ -126,7 +202,7 @@ type trainerMetrics struct {
 
 type queueHandler struct {
 	stopQueue chan struct{}
-	*TrainingJobQueue
+	JobQueue
 }
 
 type trainerService struct {
@@ -135,30 +211,74 @@ type trainerService struct {
 	lcm                 client.LcmClient
 	repo                repository
 	jobHistoryRepo      jobHistoryRepository
+	experimentRepo      experimentRepository
+	idempotencyRepo     idempotencyRepository
+	usageRepo           usageRepository
 	modelsBucket        string
 	trainedModelsBucket string
 	metrics             *trainerMetrics
 	tds                 tdsClient.TrainingDataClient
 	queues              map[string]*queueHandler
 	queuesStarted       bool
+	experimentLock      JobQueue // only its lock is used, so experiments do not hold up the job queues
+	stopExperiments     chan struct{}
+	stopRetention       chan struct{}
+	watchers            *statusWatchers
+	staleDeployments    sync.Map // ids of the trainings whose stale deployment is being deleted
 	service.Lifecycle
 }
 
+// setConfigDefaults sets the defaults of the trainer configuration
+func setConfigDefaults() {
+	config.SetDefault(gpuLimitsQuerySizeKey, 200)
+	config.SetDefault(pollIntervalKey, 60) // in seconds
+	config.SetDefault(maxPriorityKey, 10)
+	config.SetDefault(priorityAgingIntervalKey, 600) // in seconds
+	config.SetDefault(backfillWindowKey, 0)
+	config.SetDefault(backfillReservationKey, 1800) // in seconds
+	config.SetDefault(maxExperimentTrialsKey, 1000)
+	config.SetDefault(retryAttemptsKey, 1)
+	config.SetDefault(maxRetryAttemptsKey, 5)
+	config.SetDefault(retryErrorCodesKey, defaultRetryErrorCodes)
+	config.SetDefault(retryBackoffKey, 60) // in seconds
+	config.SetDefault(defaultMaxDurationKey, "0")
+	config.SetDefault(maxMaxDurationKey, "0")
+	config.SetDefault(watchPollIntervalKey, 10)   // in seconds
+	config.SetDefault(retentionIntervalKey, 3600) // in seconds
+	config.SetDefault(retentionDeletedDaysKey, 0)
+	config.SetDefault(retentionFinishedDaysKey, 0)
+	config.SetDefault(retentionKeepLabelKey, "keep")
+	config.SetDefault(retentionDryRunKey, false)
+	config.SetDefault(retentionBatchSizeKey, 100)
+	config.SetDefault(idempotencyTTLKey, 86400)
+	config.SetDefault(modelGitProtocolsKey, "https:ssh:git")
+	config.SetDefault(modelGitMaxSizeKey, 500) // MB
+	config.SetDefault(modelMaxSizeKey, 100)    // MB
+	config.SetDefault(learnerEnvDenylistKey, "")
+	config.SetDefault(learnerSchedulingKeysKey, "")
+}
+
 // NewService creates a new trainer service.
 func NewService() Service {
 	logr := logger.LogServiceBasic(logger.LogkeyTrainerService)
 
-	config.FatalOnAbsentKey(mongoAddressKey)
-	config.SetDefault(gpuLimitsQuerySizeKey, 200)
-	config.SetDefault(pollIntervalKey, 60) // in seconds
+	if isLocalMode() {
+		// no object store needs to be configured either
+		config.SetDefault("objectstore.type", storage.DataStoreTypeInMemory)
+	} else {
+		config.FatalOnAbsentKey(mongoAddressKey)
+	}
+	setConfigDefaults()
 
 	trainerMetrics := trainerMetrics{
 		createTrainingJobCounter:          metricsmon.NewCounter("trainer_trainings_create_total", "Metrics for total number of training jobs created", []string{"framework", "version", "gpus", "cpus", "gpuType", "memory"}),
+		replayTrainingJobCounter:          metricsmon.NewCounter("trainer_trainings_create_replayed_total", "Metrics for total number of create requests answered with the training of an earlier request with the same idempotency key", []string{}),
 		deleteTrainingJobCounter:          metricsmon.NewCounter("trainer_trainings_delete_total", "Metrics for total number of training jobs deleted", []string{}),
 		haltTrainingJobCounter:            metricsmon.NewCounter("trainer_trainings_halt_total", "Metrics for total number of training jobs halted", []string{}),
 		downloadTrainedModelJobCounter:    metricsmon.NewCounter("trainer_model_download_total", "Metrics for total number of trained models downloaded", []string{}),
 		downloadTrainingMetricsJobCounter: metricsmon.NewCounter("trainer_metrics_download_total", "Metrics for total number of training metrics downloaded", []string{}),
 		rateLimitTrainingJobCounter:       metricsmon.NewCounter("trainer_ratelimitinvocations_total", "Metrics for total rate limit invocations on trainer", []string{}),
+		quotaLimitTrainingJobCounter:      metricsmon.NewCounter("trainer_quotalimitinvocations_total", "Metrics for total number of times a training job was blocked by a user or tenant quota", []string{"quota"}),
 		trainingJobFailedCounter:          metricsmon.NewCounter("trainer_trainings_failed_total", "Metrics for failed training jobs", []string{"framework", "version", "gpus", "cpus", "memory", "type", "errorcode"}),
 		trainingJobSucceededCounter:       metricsmon.NewCounter("trainer_trainings_success_total", "Metrics for succeeded training jobs", []string{"framework", "version", "gpus", "cpus", "memory"}),
 		clusterWideGPUUsageGauge:          metricsmon.NewGauge("trainer_cluster_wide_gpu_usage", "metrics for cluster wide gpu usage", []string{"gpuType"}),
@@ -172,9 +292,17 @@ func NewService() Service {
 
 		enqueueJobCounter:         metricsmon.NewCounter("trainer_jobs_enqueued_total", "Metrics for number of jobs enqueued", []string{}),
 		dequeueJobCounter:         metricsmon.NewCounter("trainer_jobs_dequeued_total", "Metrics for number of jobs dequeued", []string{}),
+		backfillJobCounter:        metricsmon.NewCounter("trainer_jobs_backfilled_total", "Metrics for number of jobs started ahead of a blocked job at the head of the queue", []string{}),
+		retryTrainingJobCounter:   metricsmon.NewCounter("trainer_trainings_retry_total", "Metrics for number of failed training jobs that were queued again", []string{"errorcode"}),
+		createExperimentCounter:   metricsmon.NewCounter("trainer_experiments_create_total", "Metrics for total number of experiments created", []string{"strategy"}),
+		experimentTrialCounter:    metricsmon.NewCounter("trainer_experiment_trials_total", "Metrics for total number of experiment trials started", []string{}),
 		deleteJobFromQueueCounter: metricsmon.NewCounter("trainer_jobs_queue_deleted_total", "Metrics for number of jobs deleted from queue", []string{}),
 		queueSizeGauge:            metricsmon.NewGauge("trainer_queue_size", "Metrics for queue size", []string{"gpuType"}),
 
+		purgeTrainingJobCounter:       metricsmon.NewCounter("trainer_trainings_purged_total", "Metrics for number of trainings purged by the retention reaper", []string{"reason"}),
+		purgeTrainingJobFailedCounter: metricsmon.NewCounter("trainer_trainings_purge_failed_total", "Metrics for number of trainings the retention reaper failed to purge", []string{"step"}),
+		purgeCandidatesGauge:          metricsmon.NewGauge("trainer_trainings_purge_candidates", "Metrics for number of trainings selected by the retention policy in the last run", []string{"reason"}),
+
 		createTrainingDuration: metricsmon.NewSummary("trainer_create_time_duration", "Time duration for create training job", []string{}),
 	}
 
@@ -189,20 +317,58 @@ func NewService() Service {
 		trainerMetrics.trainerServiceRestartCounter.With("reason", "objectstore").Add(1)
 	}
 
-	repo, err := newTrainingsRepository(viper.GetString(mongoAddressKey),
-		viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
-		viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), "training_jobs")
-	if err != nil {
-		logr.WithError(err).Fatalf("Cannot create repository with %s %s %s", viper.GetString(mongoAddressKey), viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey))
-		trainerMetrics.trainerServiceRestartCounter.With("reason", "createrepository").Add(1)
-	}
-	jobHistoryRepo, err := newJobHistoryRepository(viper.GetString(mongoAddressKey),
-		viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
-		viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), collectionNameJobHistory)
-	if err != nil {
-		logr.WithError(err).Fatalf("Cannot create repository with %s %s %s %s", viper.GetString("mongo.address"),
-			viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey), collectionNameJobHistory)
-		trainerMetrics.trainerServiceRestartCounter.With("reason", "createrepository").Add(1)
+	var repo repository
+	var jobHistoryRepo jobHistoryRepository
+	var experimentRepo experimentRepository
+	var idempotencyRepo idempotencyRepository
+	var usageRepo usageRepository
+	if isLocalMode() {
+		logr.Infof("Running in local mode, training records and queues are kept in memory")
+		repo = newInMemTrainingsRepository()
+		jobHistoryRepo = newInMemJobHistoryRepository()
+		experimentRepo = newInMemExperimentRepository()
+		idempotencyRepo = newInMemIdempotencyRepository()
+		usageRepo = newInMemUsageRepository()
+	} else {
+		repo, err = newTrainingsRepository(viper.GetString(mongoAddressKey),
+			viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
+			viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), "training_jobs")
+		if err != nil {
+			logr.WithError(err).Fatalf("Cannot create repository with %s %s %s", viper.GetString(mongoAddressKey), viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey))
+			trainerMetrics.trainerServiceRestartCounter.With("reason", "createrepository").Add(1)
+		}
+		jobHistoryRepo, err = newJobHistoryRepository(viper.GetString(mongoAddressKey),
+			viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
+			viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), collectionNameJobHistory)
+		if err != nil {
+			logr.WithError(err).Fatalf("Cannot create repository with %s %s %s %s", viper.GetString("mongo.address"),
+				viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey), collectionNameJobHistory)
+			trainerMetrics.trainerServiceRestartCounter.With("reason", "createrepository").Add(1)
+		}
+		experimentRepo, err = newExperimentRepository(viper.GetString(mongoAddressKey),
+			viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
+			viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), collectionNameExperiments)
+		if err != nil {
+			logr.WithError(err).Fatalf("Cannot create repository with %s %s %s %s", viper.GetString(mongoAddressKey),
+				viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey), collectionNameExperiments)
+			trainerMetrics.trainerServiceRestartCounter.With("reason", "createrepository").Add(1)
+		}
+		idempotencyRepo, err = newIdempotencyRepository(viper.GetString(mongoAddressKey),
+			viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
+			viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), collectionNameIdempotency, idempotencyTTL())
+		if err != nil {
+			logr.WithError(err).Fatalf("Cannot create repository with %s %s %s %s", viper.GetString(mongoAddressKey),
+				viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey), collectionNameIdempotency)
+			trainerMetrics.trainerServiceRestartCounter.With("reason", "createrepository").Add(1)
+		}
+		usageRepo, err = newUsageRepository(viper.GetString(mongoAddressKey),
+			viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
+			viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), collectionNameUsage)
+		if err != nil {
+			logr.WithError(err).Fatalf("Cannot create repository with %s %s %s %s", viper.GetString(mongoAddressKey),
+				viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey), collectionNameUsage)
+			trainerMetrics.trainerServiceRestartCounter.With("reason", "createrepository").Add(1)
+		}
 	}
 
 	queues := make(map[string]*queueHandler)
@@ -211,9 +377,7 @@ func NewService() Service {
 	for _, gpuType := range gpuTypes {
 		// only create a queue if there is a limit set
 		if getGpuLimitByType(gpuType) > 0 {
-			queue, err := newTrainingJobQueue(viper.GetString(mongoAddressKey),
-				viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
-				viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), QueueName(gpuType), LockName(gpuType))
+			queue, err := newJobQueue(gpuType)
 			if err != nil {
 				logr.WithError(err).Fatalf("Cannot create queue with %s %s %s", viper.GetString(mongoAddressKey), viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey))
 				trainerMetrics.trainerServiceRestartCounter.With("reason", "createqueue").Add(1)
@@ -223,24 +387,33 @@ func NewService() Service {
 		}
 	}
 
-	anyQueue, err := newTrainingJobQueue(viper.GetString(mongoAddressKey),
-		viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
-		viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), QueueName("ANY"), LockName("ANY"))
+	anyQueue, err := newJobQueue("ANY")
 	if err != nil {
 		logr.WithError(err).Fatalf("Cannot create queue with %s %s %s", viper.GetString(mongoAddressKey), viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey))
 		trainerMetrics.trainerServiceRestartCounter.With("reason", "createqueue").Add(1)
 	}
 	queues["ANY"] = &queueHandler{make(chan struct{}), anyQueue}
 
+	experimentLock, err := newJobQueue("EXPERIMENTS")
+	if err != nil {
+		logr.WithError(err).Fatalf("Cannot create experiment lock with %s %s %s", viper.GetString(mongoAddressKey), viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey))
+		trainerMetrics.trainerServiceRestartCounter.With("reason", "createqueue").Add(1)
+	}
+
 	s := &trainerService{
 		datastore:           ds,
 		repo:                repo,
 		jobHistoryRepo:      jobHistoryRepo,
+		experimentRepo:      experimentRepo,
+		idempotencyRepo:     idempotencyRepo,
+		usageRepo:           usageRepo,
 		modelsBucket:        getModelsBucket(),
 		trainedModelsBucket: getTrainedModelsBucket(),
 		metrics:             &trainerMetrics,
 		queues:              queues,
 		queuesStarted:       false,
+		experimentLock:      experimentLock,
+		watchers:            newStatusWatchers(),
 	}
 	logr.Infof("Bucket for model definitions: %s", s.modelsBucket)
 	logr.Infof("Bucket for trained models: %s", s.trainedModelsBucket)
@@ -249,30 +422,58 @@ func NewService() Service {
 		grpc_trainer_v2.RegisterTrainerServer(s.Server, s)
 	}
 	s.StartQueues()
+	s.startExperimentScheduler()
+	s.startRetentionReaper()
 	return s
 }
 
+// isLocalMode returns true if the trainer keeps its state in memory instead of mongo
+func isLocalMode() bool {
+	return viper.GetString(trainerModeKey) == localMode
+}
+
+// newJobQueue creates the queue for the GPU type, in memory when running in local mode and in mongo otherwise
+func newJobQueue(gpuType string) (JobQueue, error) {
+	if isLocalMode() {
+		return newInMemJobQueue(), nil
+	}
+	return newTrainingJobQueue(viper.GetString(mongoAddressKey),
+		viper.GetString(mongoDatabaseKey), viper.GetString(mongoUsernameKey),
+		viper.GetString(mongoPasswordKey), config.GetMongoCertLocation(), QueueName(gpuType), LockName(gpuType))
+}
+
 // NewTestService creates a new service instance for testing
 func NewTestService(ds storage.DataStore, repo repository, jobHistoryRepo jobHistoryRepository,
 	lcm client.LcmClient, tds tdsClient.TrainingDataClient, queues map[string]*queueHandler) Service {
 
+	setConfigDefaults()
 	config.SetDefault(gpuLimitsQuerySizeKey, 100)
-	config.SetDefault(pollIntervalKey, 1) // set poll interval lower to run tests faster
+	config.SetDefault(pollIntervalKey, 1)      // set poll interval lower to run tests faster
+	config.SetDefault(watchPollIntervalKey, 1) // and the watch poll interval as well
 
 	trainerMetrics := trainerMetrics{
 		createTrainingJobCounter:          discard.NewCounter(),
+		replayTrainingJobCounter:          discard.NewCounter(),
 		deleteTrainingJobCounter:          discard.NewCounter(),
 		haltTrainingJobCounter:            discard.NewCounter(),
 		downloadTrainedModelJobCounter:    discard.NewCounter(),
 		downloadTrainingMetricsJobCounter: discard.NewCounter(),
 		rateLimitTrainingJobCounter:       discard.NewCounter(),
+		quotaLimitTrainingJobCounter:      discard.NewCounter(),
 		trainingJobFailedCounter:          discard.NewCounter(),
 		trainingJobSucceededCounter:       discard.NewCounter(),
 		uploadModelFailedCounter:          discard.NewCounter(),
 		enqueueJobCounter:                 discard.NewCounter(),
 		dequeueJobCounter:                 discard.NewCounter(),
+		backfillJobCounter:                discard.NewCounter(),
+		retryTrainingJobCounter:           discard.NewCounter(),
+		createExperimentCounter:           discard.NewCounter(),
+		experimentTrialCounter:            discard.NewCounter(),
 		queueSizeGauge:                    discard.NewGauge(),
 		deleteJobFromQueueCounter:         discard.NewCounter(),
+		purgeTrainingJobCounter:           discard.NewCounter(),
+		purgeTrainingJobFailedCounter:     discard.NewCounter(),
+		purgeCandidatesGauge:              discard.NewGauge(),
 		clusterWideGPUUsageGauge:          discard.NewGauge(),
 		clusterWideGPUUsageCounter:        discard.NewCounter(),
 		createTrainingDuration:            discard.NewHistogram(),
@@ -285,6 +486,9 @@ func NewTestService(ds storage.DataStore, repo repository, jobHistoryRepo jobHis
 		datastore:           ds,
 		repo:                repo,
 		jobHistoryRepo:      jobHistoryRepo,
+		experimentRepo:      newInMemExperimentRepository(),
+		idempotencyRepo:     newInMemIdempotencyRepository(),
+		usageRepo:           newInMemUsageRepository(),
 		lcm:                 lcm,
 		modelsBucket:        getModelsBucket(),
 		trainedModelsBucket: getTrainedModelsBucket(),
@@ -292,6 +496,8 @@ func NewTestService(ds storage.DataStore, repo repository, jobHistoryRepo jobHis
 		tds:                 tds,
 		queues:              queues,
 		queuesStarted:       false,
+		experimentLock:      newInMemJobQueue(),
+		watchers:            newStatusWatchers(),
 	}
 
 	s.RegisterService = func() {
@@ -349,10 +555,22 @@ func (s *trainerService) StopTrainer() {
 	// Close mongodb connections
 	s.repo.Close()
 	s.jobHistoryRepo.Close()
+	s.experimentRepo.Close()
+	s.idempotencyRepo.Close()
+	s.usageRepo.Close()
+	if s.stopExperiments != nil {
+		close(s.stopExperiments)
+	}
+	if s.experimentLock != nil {
+		s.experimentLock.Close()
+	}
+	if s.stopRetention != nil {
+		close(s.stopRetention)
+	}
 	for _, qHandler := range s.queues {
 		qHandler.stopQueue <- struct{}{}
 		close(qHandler.stopQueue)
-		qHandler.TrainingJobQueue.session.Close()
+		qHandler.Close()
 	}
 	s.Stop() // stop Service
 
@@ -392,88 +610,132 @@ func (s *trainerService) pullJobFromQueue(gpuType string) {
 		return
 	}
 
-	nextJobID, err := qHandler.Peek()
+	entries, err := qHandler.PeekN(qSize)
 	if err != nil {
 		logr.Errorf("failed to peek %s training job queue", gpuType)
 		return
 	}
-	if nextJobID == "" {
-		logr.Errorf("job pulled from %s queue is nil", gpuType)
+	trainingRecord, headIndex := s.nextQueuedJob(qHandler, gpuType, entries, logr)
+	if trainingRecord == nil {
 		return
 	}
 
-	trainingRecord, err := s.repo.Find(nextJobID)
-	if err != nil {
-		if err == mgo.ErrNotFound {
-			logr.Debugf("job %s not found in mongo, assuming job was deleted", nextJobID)
-			qHandler.Delete(nextJobID)
-			s.metrics.deleteJobFromQueueCounter.Add(1)
-			return
+	logr.Debugf("got training job %s from %s queue", trainingRecord.TrainingID, gpuType)
+
+	rateLimited, reason, clusterLimited := s.rateLimitTrainingJob(trainingRecord, logr)
+	if rateLimited {
+		logr.Debugf("training job %s is rate-limited (%s), leaving in %s queue", trainingRecord.TrainingID, reason, gpuType)
+		// let the user know why the job is still waiting, and remember since when the cluster is too busy for it
+		changed := trainingRecord.TrainingStatus.StatusMessage != reason
+		trainingRecord.TrainingStatus.StatusMessage = reason
+		if clusterLimited && trainingRecord.BlockedSince == 0 {
+			trainingRecord.BlockedSince = time.Now().UnixNano() / int64(time.Millisecond)
+			changed = true
+		} else if !clusterLimited && trainingRecord.BlockedSince != 0 {
+			trainingRecord.BlockedSince = 0
+			changed = true
+		}
+		if changed {
+			if err := s.repo.Store(trainingRecord); err != nil {
+				logr.WithError(err).Warnf("failed to store rate-limit reason for training job %s", trainingRecord.TrainingID)
+			}
 		}
-		logr.WithError(err).Errorf("error retrieving training job")
+		s.backfillFromQueue(qHandler, gpuType, trainingRecord, entries, headIndex, logr)
 		return
 	}
 
-	if trainingRecord.Deleted {
-		logr.Debugf("job %s was deleted", nextJobID)
-		qHandler.Delete(nextJobID)
-		s.metrics.deleteJobFromQueueCounter.Add(1)
-		return
-	}
-	if trainingRecord.TrainingStatus.Status != grpc_trainer_v2.Status_QUEUED {
-		logr.Warnf("job %s expected status QUEUED but found %s, removing job from queue", nextJobID, trainingRecord.TrainingStatus)
-		qHandler.Delete(nextJobID)
-		s.metrics.deleteJobFromQueueCounter.Add(1)
+	if !s.startQueuedJob(qHandler, gpuType, trainingRecord, logr) {
+		// submitting to LCM failed, don't update job history or dequeue
 		return
 	}
 
-	logr.Debugf("got training job %s from %s queue", nextJobID, gpuType)
+	qHandler.Unlock()
+	locked = false
 
-	rateLimited := s.rateLimitTrainingJob(trainingRecord, logr)
-	if rateLimited {
-		logr.Debugf("training job %s is rate-limited, leaving in %s queue", trainingRecord.TrainingID, gpuType)
-		return
-	}
+	s.recordQueuedJobStart(trainingRecord)
+}
 
-	err = s.submitJobToLCM(trainingRecord, logr)
-	if err != nil {
-		// submitting to LCM failed, don't update job history or dequeue
-		return
-	}
+// nextQueuedJob returns the first training job in the queue entries that may be started, and its index in entries.
+// Entries of deleted jobs and of jobs that are no longer queued are removed from the queue. Retried jobs waiting for
+// their backoff or for the deployment of their previous attempt to be deleted are left in the queue for later, so they
+// do not hold up the jobs behind them.
+// trainer should acquire the queue lock before calling nextQueuedJob()
+func (s *trainerService) nextQueuedJob(qHandler *queueHandler, gpuType string, entries []Entry, logr *logger.LocLoggingEntry) (*TrainingRecord, int) {
+	for i, entry := range entries {
+		trainingRecord, err := s.repo.Find(entry.TrainingID)
+		if err != nil {
+			if err == mgo.ErrNotFound {
+				logr.Debugf("job %s not found in mongo, assuming job was deleted", entry.TrainingID)
+				qHandler.Delete(entry.TrainingID)
+				s.metrics.deleteJobFromQueueCounter.Add(1)
+				continue
+			}
+			logr.WithError(err).Errorf("error retrieving training job")
+			return nil, -1
+		}
 
-	dequeuedJob, dequeueErr := qHandler.Dequeue()
-	if dequeueErr != nil {
-		logr.WithError(dequeueErr).Errorf("Failed to dequeue training job %s", trainingRecord.TrainingID)
-	}
-	if dequeueErr == nil && dequeuedJob != trainingRecord.TrainingID {
-		logr.Errorf("expected to dequeue job %s, but got %s instead. This should never happen", trainingRecord.TrainingID, dequeuedJob)
-		enqueueErr := qHandler.Enqueue(dequeuedJob)
-		if enqueueErr != nil {
-			logr.Errorf("job %s should not have been dequeued, and could not be re-enqueued. the record will stay in mongo but the job will never run", dequeuedJob)
-			// find and update record with FAILED status
-			if dequeuedTrainingRecord, err := s.repo.Find(dequeuedJob); err != nil {
-				// this is only a problem if the dequeued job is still QUEUED, since it will stay QUEUED forever. if it has already been submitted to LCM, it should not be in the queue
-				if dequeuedTrainingRecord.TrainingStatus.Status == grpc_trainer_v2.Status_QUEUED {
-					_, err = updateTrainingJobPostLock(s, &grpc_trainer_v2.UpdateRequest{
-						TrainingId:    dequeuedJob,
-						UserId:        dequeuedTrainingRecord.UserID,
-						Status:        grpc_trainer_v2.Status_FAILED,
-						StatusMessage: "Job was dequeued without being submitted",
-						ErrorCode:     trainerClient.ErrCodeFailDequeue,
-					})
-					if err != nil {
-						logr.WithError(err).Errorln("Unable to update job status to FAILED")
-					}
+		if trainingRecord.Deleted {
+			logr.Debugf("job %s was deleted", entry.TrainingID)
+			qHandler.Delete(entry.TrainingID)
+			s.metrics.deleteJobFromQueueCounter.Add(1)
+			continue
+		}
+		if trainingRecord.TrainingStatus.Status != grpc_trainer_v2.Status_QUEUED {
+			logr.Warnf("job %s expected status QUEUED but found %s, removing job from queue", entry.TrainingID, trainingRecord.TrainingStatus)
+			qHandler.Delete(entry.TrainingID)
+			s.metrics.deleteJobFromQueueCounter.Add(1)
+			continue
+		}
+
+		if reason := backoffReason(trainingRecord, time.Now()); reason != "" {
+			logr.Debugf("training job %s is backing off (%s), skipping it in %s queue", entry.TrainingID, reason, gpuType)
+			if trainingRecord.TrainingStatus.StatusMessage != reason {
+				trainingRecord.TrainingStatus.StatusMessage = reason
+				if err := s.repo.Store(trainingRecord); err != nil {
+					logr.WithError(err).Warnf("failed to store backoff reason for training job %s", trainingRecord.TrainingID)
 				}
 			}
+			continue
 		}
+		if trainingRecord.StaleDeployment != "" {
+			reason := staleDeploymentReason
+			logr.Debugf("training job %s is %s, skipping it in %s queue", entry.TrainingID, reason, gpuType)
+			s.cleanupStaleDeployment(trainingRecord, logr)
+			if trainingRecord.TrainingStatus.StatusMessage != reason {
+				trainingRecord.TrainingStatus.StatusMessage = reason
+				if err := s.repo.Store(trainingRecord); err != nil {
+					logr.WithError(err).Warnf("failed to store cleanup reason for training job %s", trainingRecord.TrainingID)
+				}
+			}
+			continue
+		}
+		return trainingRecord, i
 	}
-	s.metrics.dequeueJobCounter.Add(1)
+	return nil, -1
+}
 
-	qHandler.Unlock()
-	locked = false
+// startQueuedJob submits a job waiting in the queue to the LCM and removes it from the queue.
+// trainer should acquire the queue lock before calling startQueuedJob()
+func (s *trainerService) startQueuedJob(qHandler *queueHandler, gpuType string, trainingRecord *TrainingRecord, logr *logger.LocLoggingEntry) bool {
+	err := s.submitJobToLCM(trainingRecord, logr)
+	if err != nil {
+		return false
+	}
+
+	// remove the submitted job by id rather than dequeueing the head, since it may have been backfilled
+	// or priority aging may have changed the order of the queue since Peek()
+	deleted, deleteErr := qHandler.Delete(trainingRecord.TrainingID)
+	if deleteErr != nil {
+		logr.WithError(deleteErr).Errorf("Failed to dequeue training job %s", trainingRecord.TrainingID)
+	} else if !deleted {
+		logr.Errorf("expected to dequeue job %s, but it was no longer in the %s queue", trainingRecord.TrainingID, gpuType)
+	}
+	s.metrics.dequeueJobCounter.Add(1)
+	return true
+}
 
-	// store job state transition
+// recordQueuedJobStart stores the job state transition of a job that was started from the queue
+func (s *trainerService) recordQueuedJobStart(trainingRecord *TrainingRecord) {
 	timestamp := trainerClient.CurrentTimestampAsString()
 	e := &JobHistoryEntry{
 		TrainingID:    trainingRecord.TrainingID,
@@ -482,10 +744,15 @@ func (s *trainerService) pullJobFromQueue(gpuType string) {
 		StatusMessage: trainingRecord.TrainingStatus.StatusMessage,
 		ErrorCode:     trainingRecord.TrainingStatus.ErrorCode,
 	}
-	s.jobHistoryRepo.RecordJobStatus(e)
+	s.recordJobStatus(trainingRecord.UserID, e)
 }
 
 func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_trainer_v2.CreateRequest) (*grpc_trainer_v2.CreateResponse, error) {
+	return s.createTrainingJob(ctx, req, nil)
+}
+
+// createTrainingJob creates a training job, hyperParameters are the parameter values of an experiment trial or nil
+func (s *trainerService) createTrainingJob(ctx context.Context, req *grpc_trainer_v2.CreateRequest, hyperParameters map[string]string) (*grpc_trainer_v2.CreateResponse, error) {
 	duration := metrics.NewTimer(s.metrics.createTrainingDuration)
 	defer duration.ObserveDuration()
 
@@ -501,8 +768,37 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 		return nil, err
 	}
 
+	// set once the training was created, otherwise its idempotency key is released
+	created := false
+	if req.IdempotencyKey != "" {
+		trainingID, claimed, err := s.claimIdempotencyKey(req, id, logr)
+		if err != nil {
+			return nil, err
+		}
+		if !claimed {
+			logr.Infof("Idempotency key was already used for training %s, not creating another one", trainingID)
+			s.metrics.replayTrainingJobCounter.Add(1)
+			return &grpc_trainer_v2.CreateResponse{TrainingId: trainingID}, nil
+		}
+		defer func() {
+			if !created {
+				s.releaseIdempotencyKey(req, id, logr)
+			}
+		}()
+	}
+
+	if err := fetchModelDefinition(ctx, req, logr); err != nil {
+		return nil, err
+	}
+
 	setDefaultResourceRequirements(req.Training)
 
+	dependenciesCompleted, err := s.resolveDependencies(req, logr)
+	if err != nil {
+		return nil, err
+	}
+	waiting := !dependenciesCompleted
+
 	//request is validated, now bump up the counter
 	logFrameworkVersionValue := fmt.Sprintf("%s-%s", req.ModelDefinition.Framework.Name, req.ModelDefinition.Framework.Version)
 	logGpuTypeUsagesValue := fmt.Sprintf("%s-%v", req.Training.Resources.GpuType, req.Training.Resources.Gpus)
@@ -570,20 +866,6 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 	modelWithoutContent := *req.ModelDefinition
 	modelWithoutContent.Content = nil
 
-	// get evaluation metrics from create request
-	evaluationMetricsSpec := ""
-	if req.EvaluationMetrics != nil {
-		logr.Debugf("EMExtractionSpec ImageTag: %s", req.EvaluationMetrics.ImageTag)
-		wrapper := make(map[string]interface{})
-		wrapper["evaluation_metrics"] = req.EvaluationMetrics
-		data, err := yaml.Marshal(wrapper)
-		if err != nil {
-			logr.WithError(err).Errorf("Can't re-marshal evaluation metrics specification")
-		}
-		evaluationMetricsSpec = string(data)
-		logr.Debugf("Set evaluation_metrics to: %s<eof>", evaluationMetricsSpec)
-	}
-
 	tr := &TrainingRecord{
 		TrainingID:      id,
 		UserID:          req.UserId,
@@ -595,7 +877,11 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 			SubmissionTimestamp: trainerClient.CurrentTimestampAsString(),
 		},
 		Metrics:               nil,
-		EvaluationMetricsSpec: evaluationMetricsSpec,
+		EvaluationMetricsSpec: evaluationMetricsSpec(req, logr),
+		Priority:              req.Priority,
+		HyperParameters:       hyperParameters,
+		ResultsLocation:       resultsLocation(outputDatastore, id),
+		Labels:                req.Labels,
 	}
 
 	gpuType := TransformResourceName(req.Training.Resources.GpuType)
@@ -605,6 +891,7 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 	}
 
 	rateLimited := true
+	reason := ""
 	qSize, err := qHandler.Size()
 	logGpuTypeQueueSize := fmt.Sprintf("%s_%s", gpuType, "queue_size")
 	logr.WithFields(logrus.Fields{
@@ -613,14 +900,29 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 	logr.Infof("queue %s has %d elements", gpuType, qSize)
 	s.metrics.queueSizeGauge.With("gpuType", gpuType).Set(float64(qSize))
 
-	if err == nil && qSize == 0 {
-		rateLimited = s.rateLimitTrainingJob(tr, logr)
+	if err == nil && qSize == 0 && !waiting {
+		rateLimited, reason, _ = s.rateLimitTrainingJob(tr, logr)
 	}
 
-	if rateLimited {
+	if waiting {
+		// the job is queued once the trainings it depends on have completed
+		logr.Infof("training job %s is waiting for %v", tr.TrainingID, req.Training.DependsOn)
+		tr.TrainingStatus.Status = grpc_trainer_v2.Status_WAITING
+		tr.TrainingStatus.StatusMessage = fmt.Sprintf("Waiting for %s", strings.Join(req.Training.DependsOn, ", "))
+		err := s.repo.Store(tr)
+		if err != nil {
+			logr.WithError(err).Errorf("Failed to store training record")
+			return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+		}
+		cl.Observe("stored record in mongo")
+
+		// the dependencies might have finished while the record was stored
+		s.releaseWaitingJob(tr.TrainingID, logr)
+	} else if rateLimited {
 		// either queue was not empty or rate-limiting was needed, so send this job to the queue
 		logr.Infof("training job %s is rate-limited, adding to queue %s", tr.TrainingID, gpuType)
-		enqueueErr := qHandler.Enqueue(id)
+		tr.TrainingStatus.StatusMessage = reason
+		enqueueErr := qHandler.Enqueue(id, tr.Priority)
 		if enqueueErr != nil {
 			// store training record with FAILED status
 			tr.TrainingStatus.Status = grpc_trainer_v2.Status_FAILED
@@ -631,7 +933,7 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 				logr.WithError(err).Errorln("Unable to store job with status FAILED")
 			}
 
-			// err logged in Enqueue(id)
+			// err logged in Enqueue(id, priority)
 			return nil, gerrf(codes.Internal, grpcErrorDesc(enqueueErr))
 		}
 
@@ -655,6 +957,13 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 		cl.Observe("submitted job to lcm")
 	}
 
+	s.recordJobStatus(tr.UserID, &JobHistoryEntry{
+		TrainingID:    tr.TrainingID,
+		Timestamp:     tr.TrainingStatus.SubmissionTimestamp,
+		Status:        tr.TrainingStatus.Status,
+		StatusMessage: tr.TrainingStatus.StatusMessage,
+	})
+
 	//request is validated, now bump up the counter
 	logUserGpuValue := fmt.Sprintf("%s-%s-%v", req.UserId, req.Training.Resources.GpuType, req.Training.Resources.Gpus)
 
@@ -675,9 +984,27 @@ func (s *trainerService) CreateTrainingJob(ctx context.Context, req *grpc_traine
 		"framework", req.ModelDefinition.Framework.Name,
 		"version", req.ModelDefinition.Framework.Version).Add(float64(req.Training.Resources.Gpus))
 
+	created = true
 	return &grpc_trainer_v2.CreateResponse{TrainingId: id}, nil
 }
 
+// evaluationMetricsSpec returns the evaluation metrics of a create request as the YAML the log collectors read
+func evaluationMetricsSpec(req *grpc_trainer_v2.CreateRequest, logr *logger.LocLoggingEntry) string {
+	evaluationMetricsSpec := ""
+	if req.EvaluationMetrics != nil {
+		logr.Debugf("EMExtractionSpec ImageTag: %s", req.EvaluationMetrics.ImageTag)
+		wrapper := make(map[string]interface{})
+		wrapper["evaluation_metrics"] = req.EvaluationMetrics
+		data, err := yaml.Marshal(wrapper)
+		if err != nil {
+			logr.WithError(err).Errorf("Can't re-marshal evaluation metrics specification")
+		}
+		evaluationMetricsSpec = string(data)
+		logr.Debugf("Set evaluation_metrics to: %s<eof>", evaluationMetricsSpec)
+	}
+	return evaluationMetricsSpec
+}
+
 func (s *trainerService) GetTrainingJob(ctx context.Context, req *grpc_trainer_v2.GetRequest) (*grpc_trainer_v2.GetResponse, error) {
 	logr := logger.LocLogger(logWith(req.TrainingId, req.UserId))
 
@@ -709,6 +1036,8 @@ func (s *trainerService) GetTrainingJob(ctx context.Context, req *grpc_trainer_v
 		Status:          tr.TrainingStatus,
 		Datastores:      tr.Datastores,
 		Metrics:         tr.Metrics,
+		Priority:        tr.Priority,
+		Labels:          tr.Labels,
 	}
 	return &grpc_trainer_v2.GetResponse{
 		Job: jobb,
@@ -738,6 +1067,55 @@ func (s *trainerService) UpdateTrainingJob(ctx context.Context, req *grpc_traine
 	return updateTrainingJobPostLock(s, req)
 }
 
+// SetTrainingJobPriority changes the priority of a queued training job. It is meant for administrators and
+// therefore neither checks the owner of the job nor restricts the priority to the range allowed for users.
+func (s *trainerService) SetTrainingJobPriority(ctx context.Context, req *grpc_trainer_v2.PriorityRequest) (*grpc_trainer_v2.PriorityResponse, error) {
+	logr := logger.LocLogger(logEntry().WithField(logger.LogkeyTrainingID, req.TrainingId))
+	logr.Debugf("SetTrainingJobPriority called for training %s with priority %d", req.TrainingId, req.Priority)
+
+	tr, err := s.repo.Find(req.TrainingId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, gerrf(codes.NotFound, "Training with id %s not found.", req.TrainingId)
+		}
+		logr.WithError(err).Errorf("Cannot retrieve training record")
+		return nil, err
+	}
+	if tr.TrainingStatus == nil || tr.TrainingStatus.Status != grpc_trainer_v2.Status_QUEUED {
+		return nil, gerrf(codes.FailedPrecondition, "Training with id %s is not queued.", req.TrainingId)
+	}
+
+	gpuType := TransformResourceName(tr.Training.Resources.GpuType)
+	qHandler := s.queues[gpuType]
+	if qHandler == nil {
+		qHandler = s.queues["ANY"]
+	}
+
+	qerr := qHandler.Lock()
+	if qerr != nil {
+		logr.WithError(qerr).Errorf("failed to lock %s queue", gpuType)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(qerr))
+	}
+	defer qHandler.Unlock()
+
+	updated, err := qHandler.SetPriority(req.TrainingId, req.Priority)
+	if err != nil {
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if !updated {
+		// the job was pulled from the queue in the meantime
+		return nil, gerrf(codes.FailedPrecondition, "Training with id %s is not queued.", req.TrainingId)
+	}
+
+	tr.Priority = req.Priority
+	if err := s.repo.Store(tr); err != nil {
+		logr.WithError(err).Errorf("Failed to store priority of training %s", req.TrainingId)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+
+	return &grpc_trainer_v2.PriorityResponse{TrainingId: req.TrainingId, Priority: req.Priority}, nil
+}
+
 // This method contains all the functionality of UpdateTrainingJob, minus the lock on the database.  This enables it to be called
 // from within another function, which already has the lock itself (Halt)
 func updateTrainingJobPostLock(s *trainerService, req *grpc_trainer_v2.UpdateRequest) (*grpc_trainer_v2.UpdateResponse, error) {
@@ -766,6 +1144,22 @@ func updateTrainingJobPostLock(s *trainerService, req *grpc_trainer_v2.UpdateReq
 		return nil, err
 	}
 
+	// a job queued for a retry or resumed after a halt is not running, so updates other than a halt come from the
+	// previous attempt
+	if originalStatus == grpc_trainer_v2.Status_QUEUED && (training.Retries > 0 || training.StaleDeployment != "") &&
+		req.Status != grpc_trainer_v2.Status_HALTED {
+		logr.Infof("Ignoring status %s of a previous attempt of training %s", req.Status, req.TrainingId)
+		return &grpc_trainer_v2.UpdateResponse{TrainingId: training.TrainingID}, nil
+	}
+
+	// jobs failing with a retryable error code are queued again until they run out of attempts
+	if req.Status == grpc_trainer_v2.Status_FAILED && originalStatus != grpc_trainer_v2.Status_COMPLETED &&
+		originalStatus != grpc_trainer_v2.Status_FAILED && originalStatus != grpc_trainer_v2.Status_HALTED {
+		if s.retryFailedJob(training, req, logr) {
+			return &grpc_trainer_v2.UpdateResponse{TrainingId: training.TrainingID}, nil
+		}
+	}
+
 	ts.Status = req.Status
 	ts.StatusMessage = req.StatusMessage
 	ts.ErrorCode = req.ErrorCode
@@ -773,14 +1167,21 @@ func updateTrainingJobPostLock(s *trainerService, req *grpc_trainer_v2.UpdateReq
 	nowMillis := trainerClient.CurrentTimestampAsString()
 
 	if req.Status == grpc_trainer_v2.Status_COMPLETED || req.Status == grpc_trainer_v2.Status_FAILED || req.Status == grpc_trainer_v2.Status_HALTED {
-		training.Datastores = nil
 		ts.CompletionTimestamp = nowMillis
 		if req.Timestamp != "" {
 			ts.CompletionTimestamp = req.Timestamp
 		}
-		// erase sensitive data from the db
+		// erase sensitive data from the db, a halted job gets it again with the request to resume it
+		training.Datastores = nil
+		if training.ModelDefinition.Framework.ImageLocation != nil {
+			training.CustomImage = true
+		}
 		training.ModelDefinition.Framework.ImageLocation = nil
 	}
+	if req.Status == grpc_trainer_v2.Status_HALTED && training.JobID != "" {
+		// a resumed job is only started again once the LCM confirmed that the halted deployment is gone
+		training.StaleDeployment = training.JobID
+	}
 	if req.Status == grpc_trainer_v2.Status_DOWNLOADING {
 		ts.DownloadStartTimestamp = nowMillis
 		if req.Timestamp != "" {
@@ -884,7 +1285,13 @@ func updateTrainingJobPostLock(s *trainerService, req *grpc_trainer_v2.UpdateReq
 			StatusMessage: req.StatusMessage,
 			ErrorCode:     req.ErrorCode,
 		}
-		s.jobHistoryRepo.RecordJobStatus(e)
+		s.recordJobStatus(training.UserID, e)
+	}
+
+	// start or cancel the trainings waiting for this one
+	if req.Status != originalStatus && (req.Status == grpc_trainer_v2.Status_COMPLETED || req.Status == grpc_trainer_v2.Status_FAILED || req.Status == grpc_trainer_v2.Status_HALTED) {
+		s.recordUsage(req.TrainingId, logr)
+		s.releaseDependents(req.TrainingId, logr)
 	}
 
 	return &grpc_trainer_v2.UpdateResponse{TrainingId: training.TrainingID}, nil
@@ -897,15 +1304,29 @@ func (s *trainerService) GetAllTrainingsJobs(ctx context.Context, req *grpc_trai
 	cl := instrumentation.NewCallLogger(ctx, "GetAllTrainingsJobs", logr)
 	defer cl.Returned()
 
-	jobs, err := s.repo.FindAll(req.UserId)
+	query, msg := newTrainingsQuery(req)
+	if msg != "" {
+		logr.Debugf("Invalid list request: %s", msg)
+		return nil, gerrf(codes.InvalidArgument, msg)
+	}
+	pageSize := query.Limit
+	if pageSize > 0 {
+		// one more record tells whether there is a next page
+		query.Limit++
+	}
+
+	jobs, err := s.repo.FindTrainings(query)
 	if err != nil {
 		msg := "Failed to retrieve all training jobs"
 		logr.WithError(err).Errorf(msg)
 		return nil, gerrf(codes.Internal, msg)
 	}
-	resp := &grpc_trainer_v2.GetAllResponse{
-		Jobs: make([]*grpc_trainer_v2.Job, len(jobs)),
+	resp := &grpc_trainer_v2.GetAllResponse{}
+	if pageSize > 0 && len(jobs) > pageSize {
+		jobs = jobs[:pageSize]
+		resp.NextPageToken = encodePageToken(query.cursorAt(jobs[pageSize-1]))
 	}
+	resp.Jobs = make([]*grpc_trainer_v2.Job, len(jobs))
 	for i, job := range jobs {
 		resp.Jobs[i] = &grpc_trainer_v2.Job{
 			UserId:          job.UserID,
@@ -915,6 +1336,8 @@ func (s *trainerService) GetAllTrainingsJobs(ctx context.Context, req *grpc_trai
 			Training:        job.Training,
 			Status:          job.TrainingStatus,
 			Datastores:      job.Datastores,
+			Priority:        job.Priority,
+			Labels:          job.Labels,
 		}
 	}
 	return resp, nil
@@ -949,6 +1372,25 @@ func (s *trainerService) deleteJobFromTDS(query *tdsService.Query, logr *logger.
 	return nil
 }
 
+// inQueue returns true if the training job has an entry in the queue.
+// trainer should acquire the queue lock before calling inQueue()
+func inQueue(qHandler *queueHandler, trainingID string) (bool, error) {
+	size, err := qHandler.Size()
+	if err != nil || size == 0 {
+		return false, err
+	}
+	entries, err := qHandler.PeekN(size)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range entries {
+		if entry.TrainingID == trainingID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (s *trainerService) deleteJobFromQueue(trainingID string, gpuType string, logr *logger.LocLoggingEntry) error {
 	qHandler := s.queues[gpuType]
 	if qHandler == nil {
@@ -1060,7 +1502,7 @@ func (s *trainerService) DeleteTrainingJob(ctx context.Context,
 		}()
 
 		// delete model content from data store
-		err = s.datastore.DeleteArchive(s.modelsBucket, getModelZipFileName(job.JobId))
+		err = s.datastore.DeleteArchive(s.modelsBucket, getModelZipFileName(job.TrainingId))
 		if err != nil {
 			logr.WithError(err).Errorf("Error deleting model from object store")
 			// log this error, but continue with deleting the training record anyway
@@ -1075,6 +1517,9 @@ func (s *trainerService) DeleteTrainingJob(ctx context.Context,
 		}
 		cl.Observe("deleted model from mongo")
 
+		// the trainings waiting for this one can never be started
+		s.releaseDependents(job.TrainingId, logr)
+
 		return &grpc_trainer_v2.DeleteResponse{TrainingId: job.JobId}, nil
 	}
 	return nil, gerrf(codes.NotFound, "Training with id '%s' not found.", req.TrainingId)
@@ -1149,10 +1594,179 @@ func (s *trainerService) HaltTrainingJob(ctx context.Context, req *grpc_trainer_
 	return nil, gerrf(codes.NotFound, "Training with id '%s' not found.", req.TrainingId)
 }
 
+// resumeCredentials sets the data stores and the image location of a halted training from the request to resume it.
+// The data store a dependent training reads the results of its parent from is derived from them again.
+func (s *trainerService) resumeCredentials(tr *TrainingRecord, req *grpc_trainer_v2.ResumeRequest) error {
+	if len(req.Datastores) == 0 {
+		return gerrf(codes.InvalidArgument, "The data stores of training %s are required to resume it.", req.TrainingId)
+	}
+	datastores := req.Datastores
+	if len(tr.Training.DependsOn) > 0 && findDatastore(tr.Training.InputData[0], datastores) == nil {
+		parent, err := s.repo.Find(tr.Training.DependsOn[0])
+		if err == mgo.ErrNotFound {
+			return gerrf(codes.FailedPrecondition, "Training %s it depends on was deleted", tr.Training.DependsOn[0])
+		}
+		if err != nil {
+			return gerrf(codes.Internal, grpcErrorDesc(err))
+		}
+		results, err := s.dependencyResultsStore(parent, datastores)
+		if err != nil {
+			return err
+		}
+		datastores = append(datastores, results)
+	}
+	for _, ids := range [][]string{tr.Training.InputData, tr.Training.OutputData} {
+		for _, id := range ids {
+			if findDatastore(id, datastores) == nil {
+				return gerrf(codes.InvalidArgument, "Data store %s of training %s is missing.", id, req.TrainingId)
+			}
+		}
+	}
+	if tr.CustomImage && req.ImageLocation == nil {
+		return gerrf(codes.InvalidArgument, "The image location of training %s is required to resume it.", req.TrainingId)
+	}
+
+	tr.Datastores = datastores
+	tr.ModelDefinition.Framework.ImageLocation = req.ImageLocation
+	return nil
+}
+
+// ResumeTrainingJob puts a halted training job back into the queue. The job keeps its training id, so the new
+// learners use the same model definition and result location, and are pointed at the previous checkpoints.
 func (s *trainerService) ResumeTrainingJob(ctx context.Context, req *grpc_trainer_v2.ResumeRequest) (*grpc_trainer_v2.ResumeResponse, error) {
 	logr := logger.LocLogger(logWith(req.TrainingId, req.UserId))
-	logr.Debugf("HaltTrainingJob called")
-	return nil, gerrf(codes.Unimplemented, "ResumeTrainingJob not implemented yet")
+	logr.Debugf("ResumeTrainingJob called")
+
+	cl := instrumentation.NewCallLogger(ctx, "ResumeTrainingJob", logr)
+	defer cl.Returned()
+
+	tr, err := s.repo.Find(req.TrainingId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, gerrf(codes.NotFound, "Training with id %s not found.", req.TrainingId)
+		}
+		logr.WithError(err).Errorf("Cannot retrieve training record")
+		return nil, err
+	}
+	if tr.UserID != req.UserId {
+		msg := fmt.Sprintf("User %s does not have permission to resume training with id %s.", req.UserId, req.TrainingId)
+		logr.Error(msg)
+		return nil, gerrf(codes.PermissionDenied, msg)
+	}
+	if tr.TrainingStatus.Status != grpc_trainer_v2.Status_HALTED {
+		return nil, gerrf(codes.FailedPrecondition, "Training with id %s is not halted.", req.TrainingId)
+	}
+	// the credentials were erased when the training was halted
+	if err := s.resumeCredentials(tr, req); err != nil {
+		return nil, err
+	}
+
+	// a training halted before the trainings it depends on completed goes back to waiting for them
+	dependenciesCompleted, reason, err := s.checkDependencies(tr)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot check the dependencies of training %s", req.TrainingId)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if reason != "" {
+		return nil, gerrf(codes.FailedPrecondition, "Training with id %s can no longer be resumed: %s", req.TrainingId, reason)
+	}
+	if !dependenciesCompleted {
+		tr.ResumeCount++
+		tr.TrainingStatus.Status = grpc_trainer_v2.Status_WAITING
+		tr.TrainingStatus.StatusMessage = fmt.Sprintf("Waiting for %s", strings.Join(tr.Training.DependsOn, ", "))
+		tr.TrainingStatus.ErrorCode = ""
+		tr.TrainingStatus.CompletionTimestamp = ""
+		err = s.repo.Store(tr)
+		if err != nil {
+			logr.WithError(err).Errorf("Failed to store resumed training %s", req.TrainingId)
+			return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+		}
+		s.recordJobStatus(tr.UserID, &JobHistoryEntry{
+			TrainingID:    tr.TrainingID,
+			Timestamp:     trainerClient.CurrentTimestampAsString(),
+			Status:        grpc_trainer_v2.Status_WAITING,
+			StatusMessage: "Resumed by user",
+		})
+		s.releaseWaitingJob(tr.TrainingID, logr)
+
+		status, err := s.repo.FindTrainingStatusID(tr.TrainingID)
+		if err != nil {
+			return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+		}
+		return &grpc_trainer_v2.ResumeResponse{TrainingId: tr.TrainingID, UserId: tr.UserID, Status: status}, nil
+	}
+
+	gpuType := TransformResourceName(tr.Training.Resources.GpuType)
+	qHandler := s.queues[gpuType]
+	if qHandler == nil {
+		qHandler = s.queues["ANY"]
+	}
+
+	// hold the queue lock so that a training resumed twice at the same time is enqueued only once
+	if err := qHandler.Lock(); err != nil {
+		logr.WithError(err).Errorf("Failed to lock %s queue", gpuType)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	defer func() {
+		if err := qHandler.Unlock(); err != nil {
+			logr.WithError(err).Errorf("Failed to unlock %s queue", gpuType)
+		}
+	}()
+
+	status, err := s.repo.FindTrainingStatusID(tr.TrainingID)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot retrieve training status")
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if status != grpc_trainer_v2.Status_HALTED {
+		return nil, gerrf(codes.FailedPrecondition, "Training with id %s is not halted.", req.TrainingId)
+	}
+	queued, err := inQueue(qHandler, tr.TrainingID)
+	if err != nil {
+		logr.WithError(err).Errorf("Failed to check %s queue for training %s", gpuType, req.TrainingId)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+
+	tr.ResumeCount++
+	tr.TrainingStatus.Status = grpc_trainer_v2.Status_QUEUED
+	tr.TrainingStatus.StatusMessage = ""
+	tr.TrainingStatus.ErrorCode = ""
+	tr.TrainingStatus.CompletionTimestamp = ""
+
+	// store the record before enqueueing it, so the queue never sees the job as HALTED
+	err = s.repo.Store(tr)
+	if err != nil {
+		logr.WithError(err).Errorf("Failed to store resumed training %s", req.TrainingId)
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	cl.Observe("stored record in mongo")
+
+	if queued {
+		// the entry of the halted job was not removed, it is started from there
+		logr.Warnf("training %s is already in %s queue, not enqueueing it again", tr.TrainingID, gpuType)
+	} else {
+		enqueueErr := qHandler.Enqueue(tr.TrainingID, tr.Priority)
+		if enqueueErr != nil {
+			tr.TrainingStatus.Status = grpc_trainer_v2.Status_HALTED
+			tr.Datastores = nil
+			tr.ModelDefinition.Framework.ImageLocation = nil
+			if err := s.repo.Store(tr); err != nil {
+				logr.WithError(err).Errorln("Unable to restore job status HALTED")
+			}
+			return nil, gerrf(codes.Internal, grpcErrorDesc(enqueueErr))
+		}
+		s.metrics.enqueueJobCounter.Add(1)
+	}
+
+	e := &JobHistoryEntry{
+		TrainingID:    tr.TrainingID,
+		Timestamp:     trainerClient.CurrentTimestampAsString(),
+		Status:        grpc_trainer_v2.Status_QUEUED,
+		StatusMessage: "Resumed by user",
+	}
+	s.recordJobStatus(tr.UserID, e)
+
+	return &grpc_trainer_v2.ResumeResponse{TrainingId: tr.TrainingID, UserId: tr.UserID, Status: grpc_trainer_v2.Status_QUEUED}, nil
 }
 
 func (s *trainerService) GetModelDefinition(req *grpc_trainer_v2.ModelDefinitionRequest, stream grpc_trainer_v2.Trainer_GetModelDefinitionServer) error {
@@ -1327,6 +1941,8 @@ func (s *trainerService) waitUntilJobStart(req *grpc_trainer_v2.TrainedModelLogR
 	startTime := time.Now()
 	lastReportTime := time.Now()
 	if req.Follow == true {
+		wakeups := s.watchers.subscribe(req.UserId)
+		defer s.watchers.unsubscribe(wakeups)
 		for {
 			tr, err := s.repo.Find(req.TrainingId)
 			if err != nil {
@@ -1335,6 +1951,7 @@ func (s *trainerService) waitUntilJobStart(req *grpc_trainer_v2.TrainedModelLogR
 			statusID := tr.TrainingStatus.Status
 			if !(statusID == grpc_trainer_v2.Status_NOT_STARTED ||
 				statusID == grpc_trainer_v2.Status_QUEUED ||
+				statusID == grpc_trainer_v2.Status_WAITING ||
 				statusID == grpc_trainer_v2.Status_PENDING) {
 				break
 			}
@@ -1358,7 +1975,11 @@ func (s *trainerService) waitUntilJobStart(req *grpc_trainer_v2.TrainedModelLogR
 				lastReportTime = time.Now()
 			}
 
-			time.Sleep(time.Second * 2)
+			// check again when the status of one of the user's trainings changes
+			select {
+			case <-wakeups:
+			case <-time.After(time.Second * 2):
+			}
 		}
 	}
 
@@ -1665,7 +2286,12 @@ func (s *trainerService) validateRequest(log *logrus.Entry, req *grpc_trainer_v2
 	//}
 	//
 	if len(m.Content) == 0 {
-		return s.failCreateRequest("Model definition content is not set", req, log)
+		if m.Location == "" {
+			return s.failCreateRequest("Model definition content or location is not set", req, log)
+		}
+		if msg := validateModelLocation(m.Location, req.Datastores); msg != "" {
+			return s.failCreateRequest(msg, req, log)
+		}
 	}
 
 	// validate Training object
@@ -1680,11 +2306,23 @@ func (s *trainerService) validateRequest(log *logrus.Entry, req *grpc_trainer_v2
 	if t.InputData == nil || len(t.InputData) == 0 {
 		return s.failCreateRequest("Training input data is not set", req, log)
 	}
-	if len(t.InputData) > 1 {
-		return s.failCreateRequest("Training input data can only contain one id", req, log)
+	if max := int32(viper.GetInt(maxPriorityKey)); req.Priority > max || req.Priority < -max {
+		return s.failCreateRequest(fmt.Sprintf("Training priority must be between %d and %d", -max, max), req, log)
 	}
-	if t.OutputData != nil && len(t.OutputData) > 1 {
-		return s.failCreateRequest("Training output data can only contain one id", req, log)
+	if msg := validateRetryPolicy(t.RetryPolicy); msg != "" {
+		return s.failCreateRequest(msg, req, log)
+	}
+	if msg := validateMaxDuration(t.MaxDuration); msg != "" {
+		return s.failCreateRequest(msg, req, log)
+	}
+	if msg := validateLabels(req.Labels); msg != "" {
+		return s.failCreateRequest(msg, req, log)
+	}
+	if msg := validateLearnerEnv(t.Env, t.SecretEnv); msg != "" {
+		return s.failCreateRequest(msg, req, log)
+	}
+	if msg := validateScheduling(t.Resources); msg != "" {
+		return s.failCreateRequest(msg, req, log)
 	}
 
 	// validate datastores
@@ -1697,6 +2335,12 @@ func (s *trainerService) validateRequest(log *logrus.Entry, req *grpc_trainer_v2
 		return s.failCreateRequest("Data stores is empty", req, log)
 	}
 
+	for _, v := range ds {
+		if v.Id == internalObjectStoreID {
+			return s.failCreateRequest(fmt.Sprintf("Data store id '%s' is reserved", internalObjectStoreID), req, log)
+		}
+	}
+
 	for _, name := range t.InputData {
 		ds := findDatastore(name, req.Datastores)
 		if ds == nil {
@@ -1718,6 +2362,13 @@ func (s *trainerService) validateRequest(log *logrus.Entry, req *grpc_trainer_v2
 			}
 		}
 	}
+
+	if msg := validateDataReferences("input", t.InputData, req.Datastores); msg != "" {
+		return s.failCreateRequest(msg, req, log)
+	}
+	if msg := validateDataReferences("output", t.OutputData, req.Datastores); msg != "" {
+		return s.failCreateRequest(msg, req, log)
+	}
 	return nil
 }
 
@@ -1827,8 +2478,14 @@ func (s *trainerService) tdsClient() (tdsClient.TrainingDataClient, error) {
 func (s *trainerService) createJobConfig(tr *TrainingRecord) (*service.JobDeploymentRequest, error) {
 	logr := logger.LocLogger(logWith(tr.TrainingID, tr.UserID))
 
-	// training data/results - assume only one training input and output data at this point
+	// training data/results - the first input and output data are the ones DATA_DIR and RESULT_DIR point to
 	trainingData := findDatastore(tr.Training.InputData[0], tr.Datastores)
+	if trainingData.Id == internalObjectStoreID {
+		// the results of the training this one depends on, read with the trainer's own credentials
+		internal := s.getOutputDatastore(nil, nil)
+		internal.Fields["bucket"] = trainingData.Fields["bucket"]
+		trainingData = internal
+	}
 	trainingResults := s.getOutputDatastore(tr.Training.OutputData, tr.Datastores)
 
 	// Environment variables
@@ -1901,7 +2558,10 @@ func (s *trainerService) createJobConfig(tr *TrainingRecord) (*service.JobDeploy
 	if trainingResults.Connection["project_id"] != "" {
 		envvars["RESULT_STORE_PROJECTID"] = trainingResults.Connection["project_id"]
 	}
-	envvars["RESULT_STORE_OBJECTID"] = fmt.Sprintf("%s/%s", trainingResults.Fields["bucket"], tr.TrainingID)
+	envvars["RESULT_STORE_OBJECTID"] = resultsLocation(trainingResults, tr.TrainingID)
+
+	// every input and output data, by its id
+	setDataReferenceEnvVars(envvars, tr)
 
 	// Storing data in container at
 	envvars["DATA_DIR"] = trainingData.Fields["bucket"]
@@ -1937,8 +2597,21 @@ func (s *trainerService) createJobConfig(tr *TrainingRecord) (*service.JobDeploy
 		envvars["DLAAS_PROFILING"] = "true"
 	}
 
-	// labels
+	// lets the LCM point the learners at the checkpoints of the halted run
+	if tr.ResumeCount > 0 {
+		envvars["RESUME_FROM_CHECKPOINT"] = "true"
+	}
+
+	// parameter values of an experiment trial
+	for name, value := range tr.HyperParameters {
+		envvars[hyperParameterEnvVar(name)] = value
+	}
+
+	// labels, the user-defined ones are set on the learner pods
 	labels := make(map[string]string)
+	for k, v := range tr.Labels {
+		labels[k] = v
+	}
 	labels["training_id"] = tr.TrainingID
 	labels["user_id"] = tr.UserID
 	labels["gpu_type"] = tr.Training.Resources.GpuType
@@ -1961,6 +2634,9 @@ func (s *trainerService) createJobConfig(tr *TrainingRecord) (*service.JobDeploy
 		Version:               tr.ModelDefinition.Framework.Version,
 		ImageLocation:         parseImageLocation(tr),
 		EvaluationMetricsSpec: tr.EvaluationMetricsSpec,
+		MaxDurationSeconds:    int64(maxDuration(tr.Training) / time.Second),
+		LearnerEnvVars:        tr.Training.Env,
+		LearnerSecretEnvVars:  learnerSecretEnvVars(tr.Training.SecretEnv),
 	}
 
 	return job, nil
@@ -2009,14 +2685,18 @@ func setDefaultResourceRequirements(t *grpc_trainer_v2.Training) {
 
 func getResourceRequirements(t *grpc_trainer_v2.Training) *service.ResourceRequirements {
 	return &service.ResourceRequirements{
-		Cpus:        float64(t.Resources.Cpus),
-		Gpus:        float64(t.Resources.Gpus),
-		Memory:      float64(t.Resources.Memory),
-		MemoryUnit:  service.ResourceRequirements_MemoryUnit(service.ResourceRequirements_MemoryUnit_value[t.Resources.MemoryUnit.String()]),
-		Storage:     float64(t.Resources.Storage),
-		StorageUnit: service.ResourceRequirements_MemoryUnit(service.ResourceRequirements_MemoryUnit_value[t.Resources.StorageUnit.String()]),
-		Learners:    t.Resources.Learners,
-		GpuType:     t.Resources.GpuType,
+		Cpus:         float64(t.Resources.Cpus),
+		Gpus:         float64(t.Resources.Gpus),
+		Memory:       float64(t.Resources.Memory),
+		MemoryUnit:   service.ResourceRequirements_MemoryUnit(service.ResourceRequirements_MemoryUnit_value[t.Resources.MemoryUnit.String()]),
+		Storage:      float64(t.Resources.Storage),
+		StorageUnit:  service.ResourceRequirements_MemoryUnit(service.ResourceRequirements_MemoryUnit_value[t.Resources.StorageUnit.String()]),
+		Learners:     t.Resources.Learners,
+		GpuType:      t.Resources.GpuType,
+		Schedpolicy:  t.Resources.Schedpolicy,
+		Architecture: t.Resources.Architecture,
+		NodeSelector: t.Resources.NodeSelector,
+		Tolerations:  lcmTolerations(t.Resources.Tolerations),
 	}
 }
 
@@ -2024,7 +2704,7 @@ func getResourceRequirements(t *grpc_trainer_v2.Training) *service.ResourceRequi
 func (s *trainerService) getOutputDatastore(outputData []string, datastores []*grpc_trainer_v2.Datastore) *grpc_trainer_v2.Datastore {
 	var ds *grpc_trainer_v2.Datastore
 	if len(outputData) > 0 {
-		ds = findDatastore(outputData[0], datastores) // we assume there is only one output data at this point b/c the underlying system does not support more
+		ds = findDatastore(outputData[0], datastores) // the first output data is where the results are stored
 	}
 	if ds == nil {
 		ds = &grpc_trainer_v2.Datastore{
@@ -2067,8 +2747,11 @@ func (s *trainerService) submitJobToLCM(tr *TrainingRecord, logr *logger.LocLogg
 		return gerrf(codes.Internal, grpcErrorDesc(err))
 	}
 
-	// store training record with PENDING status
+	// store training record with PENDING status, clearing any rate-limit reason recorded while queued
+	tr.JobID = jobConfig.Name
 	tr.TrainingStatus.Status = grpc_trainer_v2.Status_PENDING
+	tr.TrainingStatus.StatusMessage = ""
+	tr.BlockedSince = 0
 	err = s.repo.Store(tr)
 	if err != nil {
 		logr.WithError(err).Errorf("Failed to resolve output datastore")
@@ -2111,24 +2794,31 @@ func (s *trainerService) submitJobToLCM(tr *TrainingRecord, logr *logger.LocLogg
 }
 
 // determine if this job should be rate-limited by checking if the total number of GPUs
-// would exceed the limit set for the GPU type
-func (s *trainerService) rateLimitTrainingJob(trainingRecord *TrainingRecord, logr *logger.LocLoggingEntry) bool {
+// would exceed the limit set for the GPU type, or if the job would exceed one of the per-user
+// or per-tenant quotas. If the job is rate-limited, the second return value describes which
+// limit blocked it, and the third is true if it was the cluster wide GPU limit.
+func (s *trainerService) rateLimitTrainingJob(trainingRecord *TrainingRecord, logr *logger.LocLoggingEntry) (bool, string, bool) {
 	var rateLimit = false
+	var reason = ""
+
+	// a retried job waits for its backoff and for its previous deployment to be deleted, nextQueuedJob skips it so it
+	// does not hold up the jobs behind it
+	if backoff := backoffReason(trainingRecord, time.Now()); backoff != "" {
+		return true, backoff, false
+	}
+	if trainingRecord.StaleDeployment != "" {
+		return true, staleDeploymentReason, false
+	}
 
 	gpuType := trainingRecord.Training.Resources.GpuType
 	limit := getGpuLimitByType(gpuType)
-	if limit == 0 {
-		return false
-	}
 
 	// get total number of GPUs requested for this job
-	gpusRequested := trainingRecord.Training.Resources.Gpus
-	if trainingRecord.Training.Resources.Learners > 1 {
-		// trainingRecord.Training.Resources.Gpus is GPUs used per learner
-		gpusRequested = trainingRecord.Training.Resources.Gpus * float32(trainingRecord.Training.Resources.Learners)
-	}
-	if gpusRequested == 0 {
-		return false
+	gpusRequested := totalGpus(trainingRecord.Training.Resources)
+
+	checkClusterLimit := limit > 0 && gpusRequested > 0
+	if !checkClusterLimit && !quotasEnabled() {
+		return false, "", false
 	}
 
 	// find the GPUs used that count toward this limit
@@ -2136,44 +2826,55 @@ func (s *trainerService) rateLimitTrainingJob(trainingRecord *TrainingRecord, lo
 	logr.Debugf("running records (%d)", len(records))
 	if err != nil || len(records) == 0 {
 		logr.WithError(err).Warnf("did not execute rate limiting correctly, returned number of records count is %d", len(records))
-		return false
-	}
-	var totalGPUsUsedCount float32
-	var matchingGPUConsumingRecords []*TrainingRecord
-	for _, record := range records {
-		trainingStatus := record.TrainingStatus.Status
-		if trainingStatus == grpc_trainer_v2.Status_COMPLETED || trainingStatus == grpc_trainer_v2.Status_HALTED || trainingStatus == grpc_trainer_v2.Status_FAILED || trainingStatus == grpc_trainer_v2.Status_QUEUED {
-			//ignore these since they don't count towards active resource usage
-		} else if TransformResourceName(record.Training.Resources.GpuType) == TransformResourceName(gpuType) {
-			//only count matching gpu type
-			matchingGPUConsumingRecords = append(matchingGPUConsumingRecords, record)
-			gpusUsed := record.Training.Resources.Gpus
-			if record.Training.Resources.Learners > 1 {
-				gpusUsed = record.Training.Resources.Gpus * float32(record.Training.Resources.Learners)
+		return false, "", false
+	}
+
+	if checkClusterLimit {
+		var totalGPUsUsedCount float32
+		var matchingGPUConsumingRecords []*TrainingRecord
+		for _, record := range records {
+			if !isConsumingResources(record) {
+				//ignore these since they don't count towards active resource usage
+			} else if TransformResourceName(record.Training.Resources.GpuType) == TransformResourceName(gpuType) {
+				//only count matching gpu type
+				matchingGPUConsumingRecords = append(matchingGPUConsumingRecords, record)
+				totalGPUsUsedCount = totalGPUsUsedCount + totalGpus(record.Training.Resources)
 			}
-			totalGPUsUsedCount = totalGPUsUsedCount + gpusUsed
 		}
+		s.metrics.clusterWideGPUUsageGauge.With("gpuType", gpuType).Set(float64(totalGPUsUsedCount))
+
+		if int64(totalGPUsUsedCount+gpusRequested) > limit {
+			rateLimit = true
+			reason = fmt.Sprintf("cluster wide %s GPU limit exceeded: limit %d, in use %v, requested %v",
+				TransformResourceName(gpuType), limit, totalGPUsUsedCount, gpusRequested)
+			if logr.Logger.Level >= logrus.DebugLevel {
+				for _, record := range matchingGPUConsumingRecords {
+					logr.Debugf("Found a gpu consuming training %v has a status %s and using gpus %v with submission time as %v and process start time as %v and error code %v",
+						record.TrainingID, record.TrainingStatus.Status, record.Training.Resources.Gpus, record.TrainingStatus.SubmissionTimestamp,
+						record.TrainingStatus.ProcessStartTimestamp, record.TrainingStatus.ErrorCode)
+				}
+			}
+		}
+
+		logr.Debugf("result of rate-limiting for job %s is %t; gpu type %s has limit %d, total used %v, requested %v",
+			trainingRecord.TrainingID, rateLimit, TransformResourceName(gpuType), limit, totalGPUsUsedCount, gpusRequested)
 	}
-	s.metrics.clusterWideGPUUsageGauge.With("gpuType", gpuType).Set(float64(totalGPUsUsedCount))
 
-	if int64(totalGPUsUsedCount+gpusRequested) > limit {
-		rateLimit = true
-		if logr.Logger.Level >= logrus.DebugLevel {
-			for _, record := range matchingGPUConsumingRecords {
-				logr.Debugf("Found a gpu consuming training %v has a status %s and using gpus %v with submission time as %v and process start time as %v and error code %v",
-					record.TrainingID, record.TrainingStatus.Status, record.Training.Resources.Gpus, record.TrainingStatus.SubmissionTimestamp,
-					record.TrainingStatus.ProcessStartTimestamp, record.TrainingStatus.ErrorCode)
-			}
+	clusterLimited := rateLimit
+	if !rateLimit {
+		if violation := checkQuotas(trainingRecord, records); violation != nil {
+			rateLimit = true
+			reason = violation.String()
+			s.metrics.quotaLimitTrainingJobCounter.With("quota", violation.quota).Add(1)
+			logr.Debugf("training job %s is blocked by quota: %s", trainingRecord.TrainingID, reason)
 		}
 	}
 
-	logr.Debugf("result of rate-limiting for job %s is %t; gpu type %s has limit %d, total used %v, requested %v",
-		trainingRecord.TrainingID, rateLimit, TransformResourceName(gpuType), limit, totalGPUsUsedCount, gpusRequested)
 	if rateLimit {
 		s.metrics.rateLimitTrainingJobCounter.Add(1)
 	}
 
-	return rateLimit
+	return rateLimit, reason, clusterLimited
 }
 
 func getGpuLimitQuerySize() int {
@@ -0,0 +1,201 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/storage"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// newInMemTestService creates a trainer backed by in-memory repositories and the given queues, without
+// starting the queue pollers so that tests can pull jobs themselves
+func newInMemTestService(t *testing.T, queues map[string]*queueHandler) *trainerService {
+	ds, err := storage.NewInMemObjectStore(nil)
+	assert.NoError(t, err)
+	s := NewTestService(ds, newInMemTrainingsRepository(), newInMemJobHistoryRepository(), nil, nil,
+		map[string]*queueHandler{}).(*trainerService)
+	s.queues = queues
+	return s
+}
+
+// createDeployableRecord creates a queued training record that can be submitted to the LCM
+func createDeployableRecord(id string, userID string, gpus float32) *TrainingRecord {
+	tr := createParentRecord(id, userID, grpc_trainer_v2.Status_QUEUED)
+	tr.Training.Resources.Gpus = gpus
+	tr.Training.InputData = []string{"store"}
+	tr.Training.OutputData = []string{"store"}
+	tr.Datastores = []*grpc_trainer_v2.Datastore{
+		{Id: "store", Type: "mount_cos", Fields: map[string]string{"bucket": "data"}, Connection: map[string]string{}},
+	}
+	return tr
+}
+
+func TestPullJobFromQueueRateLimited(t *testing.T) {
+	viper.Set(gpuLimitsKey, "nvidia-TeslaK80=4")
+	defer viper.Set(gpuLimitsKey, "")
+
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"NVIDIA_TESLAK80": {make(chan struct{}), queue},
+	})
+
+	assert.NoError(t, s.repo.Store(createQuotaRecord("running", "alice", 4, 1, grpc_trainer_v2.Status_PROCESSING)))
+	assert.NoError(t, s.repo.Store(createQuotaRecord("queued", "bob", 1, 1, grpc_trainer_v2.Status_QUEUED)))
+	assert.NoError(t, queue.Enqueue("queued", 0))
+
+	s.pullJobFromQueue("NVIDIA_TESLAK80")
+
+	// the job stays queued, and the user can see why
+	size, _ := queue.Size()
+	assert.Equal(t, 1, size)
+	tr, err := s.repo.Find("queued")
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, tr.TrainingStatus.Status)
+	assert.Contains(t, tr.TrainingStatus.StatusMessage, "GPU limit exceeded")
+
+	// the queue lock was released
+	assert.NoError(t, queue.Lock())
+	assert.NoError(t, queue.Unlock())
+}
+
+func TestPullJobFromQueueDeleted(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+
+	assert.NoError(t, s.repo.Store(createQuotaRecord("deleted", "alice", 1, 1, grpc_trainer_v2.Status_QUEUED)))
+	assert.NoError(t, s.repo.Delete("deleted"))
+	assert.NoError(t, queue.Enqueue("deleted", 0))
+	assert.NoError(t, queue.Enqueue("missing", 0))
+
+	s.pullJobFromQueue("ANY")
+	s.pullJobFromQueue("ANY")
+
+	empty, _ := queue.Empty()
+	assert.True(t, empty)
+}
+
+func TestResumeTrainingJob(t *testing.T) {
+	queue := newInMemJobQueue()
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+
+	tr := createParentRecord("halted", "alice", grpc_trainer_v2.Status_HALTED)
+	tr.Training.OutputData = []string{"results"}
+	tr.CustomImage = true
+	assert.NoError(t, s.repo.Store(tr))
+	assert.NoError(t, s.repo.Store(createParentRecord("running", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	req := &grpc_trainer_v2.ResumeRequest{TrainingId: "halted", UserId: "bob"}
+	_, err := s.ResumeTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.PermissionDenied, grpcCode(err))
+	_, err = s.ResumeTrainingJob(context.Background(), &grpc_trainer_v2.ResumeRequest{TrainingId: "running", UserId: "alice"})
+	assert.Equal(t, codes.FailedPrecondition, grpcCode(err))
+
+	// the credentials erased when the training was halted have to be given again
+	req.UserId = "alice"
+	_, err = s.ResumeTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+	req.Datastores = []*grpc_trainer_v2.Datastore{{Id: "data"}}
+	_, err = s.ResumeTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+	req.Datastores = append(req.Datastores, &grpc_trainer_v2.Datastore{Id: "results"})
+	_, err = s.ResumeTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+	req.ImageLocation = &grpc_trainer_v2.ImageLocation{Registry: "registry.example.com", AccessToken: "token"}
+
+	resp, err := s.ResumeTrainingJob(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, resp.Status)
+
+	id, _ := queue.Peek()
+	assert.Equal(t, "halted", id)
+	tr, _ = s.repo.Find("halted")
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, tr.TrainingStatus.Status)
+	assert.EqualValues(t, 1, tr.ResumeCount)
+	assert.Len(t, tr.Datastores, 2)
+	assert.Equal(t, "token", tr.ModelDefinition.Framework.ImageLocation.AccessToken)
+
+	history := s.jobHistoryRepo.GetJobStatusHistory("halted")
+	if assert.Len(t, history, 1) {
+		assert.Equal(t, "Resumed by user", history[0].StatusMessage)
+	}
+}
+
+func TestHaltTrainingJobErasesCredentials(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), newInMemJobQueue()},
+	})
+	tr := createDeployableRecord("running", "alice", 1)
+	tr.JobID = "job-1"
+	tr.ModelDefinition.Framework.ImageLocation = &grpc_trainer_v2.ImageLocation{AccessToken: "token"}
+	tr.TrainingStatus.Status = grpc_trainer_v2.Status_PROCESSING
+	assert.NoError(t, s.repo.Store(tr))
+
+	_, err := s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "running",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_HALTED,
+	})
+	assert.NoError(t, err)
+	tr, _ = s.repo.Find("running")
+	assert.Empty(t, tr.Datastores)
+	assert.Nil(t, tr.ModelDefinition.Framework.ImageLocation)
+	assert.True(t, tr.CustomImage)
+	// the resumed job waits for the LCM to delete the halted deployment
+	assert.Equal(t, "job-1", tr.StaleDeployment)
+}
+
+func TestResumeTrainingJobQueueLock(t *testing.T) {
+	queue := newInMemJobQueue()
+	queue.lockRetries, queue.lockRetryDelay = 1, 0
+	s := newInMemTestService(t, map[string]*queueHandler{
+		"ANY": {make(chan struct{}), queue},
+	})
+
+	assert.NoError(t, s.repo.Store(createParentRecord("halted", "alice", grpc_trainer_v2.Status_HALTED)))
+	req := &grpc_trainer_v2.ResumeRequest{
+		TrainingId: "halted",
+		UserId:     "alice",
+		Datastores: []*grpc_trainer_v2.Datastore{{Id: "results"}},
+	}
+
+	// the job is not resumed while the queue is locked
+	assert.True(t, queue.tryLock())
+	_, err := s.ResumeTrainingJob(context.Background(), req)
+	assert.Equal(t, codes.Internal, grpcCode(err))
+	status, _ := s.repo.FindTrainingStatusID("halted")
+	assert.Equal(t, grpc_trainer_v2.Status_HALTED, status)
+	assert.NoError(t, queue.Unlock())
+
+	// a job that still has an entry in the queue is not enqueued again
+	assert.NoError(t, queue.Enqueue("halted", 0))
+	resp, err := s.ResumeTrainingJob(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_trainer_v2.Status_QUEUED, resp.Status)
+	size, _ := queue.Size()
+	assert.Equal(t, 1, size)
+	assert.True(t, queue.tryLock(), "queue lock is released")
+}
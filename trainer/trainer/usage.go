@@ -0,0 +1,350 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/IBM/FfDL/commons/logger"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const millisecondsPerHour = 60 * 60 * 1000
+
+// UsageRecord stores the resources a finished training consumed in the Mongo collection "usage". The records are kept
+// when the training is deleted or purged, so that they can be accounted for later.
+type UsageRecord struct {
+	ID         bson.ObjectId     `bson:"_id,omitempty" json:"id"`
+	TrainingID string            `bson:"training_id" json:"training_id"`
+	UserID     string            `bson:"user_id" json:"user_id"`
+	Labels     map[string]string `bson:"labels,omitempty" json:"labels"`
+	// in the format of TrainingStatus.CompletionTimestamp
+	CompletionTimestamp string                         `bson:"completion_timestamp" json:"completion_timestamp"`
+	Usage               *grpc_trainer_v2.ResourceUsage `bson:"usage" json:"usage"`
+}
+
+type usageRepository interface {
+	// Record stores the usage of a training, replacing the one recorded when an earlier attempt finished.
+	Record(u *UsageRecord) error
+	// SetLabels replaces the labels of the usage of a training, if it was recorded.
+	SetLabels(trainingID string, labels map[string]string) error
+	Find(q *usageQuery) ([]*UsageRecord, error)
+	Close()
+}
+
+type usageRecordsRepository struct {
+	session    *mgo.Session
+	database   string
+	collection string
+}
+
+// inMemUsageRepository is a usageRepository kept in memory, for running the trainer without mongo.
+type inMemUsageRepository struct {
+	mtx     sync.RWMutex
+	records map[string]*UsageRecord // by training ID
+}
+
+// newUsageRepository creates a new repo for the usage of finished trainings.
+func newUsageRepository(mongoURI string, database string, username string, password string,
+	cert string, collection string) (usageRepository, error) {
+	log := logger.LocLogger(log.StandardLogger().WithField("module", "usageRepository"))
+	log.Debugf("Creating mongo usage repository for %s, collection %s:", mongoURI, collection)
+
+	session, err := ConnectMongo(mongoURI, database, username, password, cert)
+	if err != nil {
+		return nil, err
+	}
+	collectionObj := session.DB(database).C(collection)
+
+	repo := &usageRecordsRepository{
+		session:    session,
+		database:   collectionObj.Database.Name,
+		collection: collection,
+	}
+
+	// create index
+	if err := collectionObj.EnsureIndex(mgo.Index{Key: []string{"training_id"}, Unique: true}); err != nil {
+		log.WithError(err).Errorf("Cannot create the unique index of usage records")
+		return nil, err
+	}
+	collectionObj.EnsureIndexKey("completion_timestamp", "user_id")
+
+	return repo, nil
+}
+
+// newInMemUsageRepository creates a new in-memory repo for the usage of finished trainings.
+func newInMemUsageRepository() usageRepository {
+	return &inMemUsageRepository{records: make(map[string]*UsageRecord)}
+}
+
+func (r *usageRecordsRepository) Record(u *UsageRecord) error {
+	sess := r.session.Clone()
+	defer sess.Close()
+	_, err := sess.DB(r.database).C(r.collection).Upsert(bson.M{"training_id": u.TrainingID}, u)
+	return err
+}
+
+func (r *usageRecordsRepository) SetLabels(trainingID string, labels map[string]string) error {
+	sess := r.session.Clone()
+	defer sess.Close()
+	err := sess.DB(r.database).C(r.collection).Update(bson.M{"training_id": trainingID},
+		bson.M{"$set": bson.M{"labels": labels}})
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// Find returns the usage of the trainings matching a usage query
+func (r *usageRecordsRepository) Find(q *usageQuery) ([]*UsageRecord, error) {
+	sess := r.session.Clone()
+	defer sess.Close()
+
+	selector := bson.M{}
+	if q.UserID != "" {
+		selector["user_id"] = q.UserID
+	}
+	completed := bson.M{"$gt": ""}
+	if q.CompletedAfter != "" {
+		completed["$gte"] = q.CompletedAfter
+	}
+	if q.CompletedBefore != "" {
+		completed["$lt"] = q.CompletedBefore
+	}
+	selector["completion_timestamp"] = completed
+	selectLabels(selector, q.Labels)
+
+	var records []*UsageRecord
+	if err := sess.DB(r.database).C(r.collection).Find(selector).All(&records); err != nil {
+		log.WithError(err).Errorf("Cannot retrieve usage records")
+		return nil, err
+	}
+	return records, nil
+}
+
+func (r *usageRecordsRepository) Close() {
+	log.Debugf("Closing mongo session")
+	defer r.session.Close()
+}
+
+func (r *inMemUsageRepository) Record(u *UsageRecord) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	c := *u
+	r.records[u.TrainingID] = &c
+	return nil
+}
+
+func (r *inMemUsageRepository) SetLabels(trainingID string, labels map[string]string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if u := r.records[trainingID]; u != nil {
+		c := *u
+		c.Labels = labels
+		r.records[trainingID] = &c
+	}
+	return nil
+}
+
+func (r *inMemUsageRepository) Find(q *usageQuery) ([]*UsageRecord, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	var result []*UsageRecord
+	for _, u := range r.records {
+		if q.matches(u) {
+			c := *u
+			result = append(result, &c)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemUsageRepository) Close() {
+}
+
+// usageQuery selects the finished trainings whose usage is aggregated
+type usageQuery struct {
+	// "" for the trainings of all users
+	UserID string
+	// completion timestamps in the format of TrainingStatus.CompletionTimestamp, empty if the range is open
+	CompletedAfter  string
+	CompletedBefore string
+	Labels          []labelRequirement
+}
+
+func (q *usageQuery) matches(u *UsageRecord) bool {
+	if u.Usage == nil || u.CompletionTimestamp == "" {
+		return false
+	}
+	if q.UserID != "" && u.UserID != q.UserID {
+		return false
+	}
+	completed := u.CompletionTimestamp
+	if q.CompletedAfter != "" && completed < q.CompletedAfter {
+		return false
+	}
+	if q.CompletedBefore != "" && completed >= q.CompletedBefore {
+		return false
+	}
+	for _, l := range q.Labels {
+		if !l.matches(u.Labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetUsage returns the resources consumed by the finished trainings matching the request, including deleted and purged
+// ones. A training counts towards the time window it finished in.
+func (s *trainerService) GetUsage(ctx context.Context, req *grpc_trainer_v2.UsageRequest) (*grpc_trainer_v2.UsageResponse, error) {
+	logr := logger.LocLogger(logWith("", req.UserId))
+	logr.Debugf("GetUsage called")
+
+	if req.CompletedAfter < 0 || req.CompletedBefore < 0 {
+		return nil, gerrf(codes.InvalidArgument, "Completion times must not be negative")
+	}
+	labels, err := parseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return nil, gerrf(codes.InvalidArgument, "Invalid label selector: %s", err.Error())
+	}
+	q := &usageQuery{UserID: req.UserId, Labels: labels}
+	if req.CompletedAfter > 0 {
+		q.CompletedAfter = strconv.FormatInt(req.CompletedAfter, 10)
+	}
+	if req.CompletedBefore > 0 {
+		q.CompletedBefore = strconv.FormatInt(req.CompletedBefore, 10)
+	}
+
+	records, err := s.usageRepo.Find(q)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot retrieve the usage of trainings")
+		return nil, gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	return &grpc_trainer_v2.UsageResponse{Entries: aggregateUsage(records, req.GroupByLabel)}, nil
+}
+
+// aggregateUsage sums up the usage of trainings by user, or by the value of groupByLabel if it is set
+func aggregateUsage(records []*UsageRecord, groupByLabel string) []*grpc_trainer_v2.UsageEntry {
+	byKey := make(map[string]*grpc_trainer_v2.UsageEntry)
+	var entries []*grpc_trainer_v2.UsageEntry
+	for _, u := range records {
+		key := u.UserID
+		if groupByLabel != "" {
+			key = u.Labels[groupByLabel]
+		}
+		e := byKey[key]
+		if e == nil {
+			e = &grpc_trainer_v2.UsageEntry{Key: key, Usage: &grpc_trainer_v2.ResourceUsage{}}
+			byKey[key] = e
+			entries = append(entries, e)
+		}
+		e.Trainings++
+		e.Usage.GpuHours += u.Usage.GpuHours
+		e.Usage.CpuHours += u.Usage.CpuHours
+		e.Usage.MemoryHours += u.Usage.MemoryHours
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+// recordUsage stores the resources a finished training consumed, apart from its record, which the retention policy
+// may purge
+func (s *trainerService) recordUsage(trainingID string, logr *logger.LocLoggingEntry) {
+	tr, err := s.repo.Find(trainingID)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot retrieve training %s to record its usage", trainingID)
+		return
+	}
+	u := &UsageRecord{
+		TrainingID: tr.TrainingID,
+		UserID:     tr.UserID,
+		Labels:     tr.Labels,
+		Usage:      resourceUsage(tr, s.jobHistoryRepo.GetJobStatusHistory(trainingID)),
+	}
+	if tr.TrainingStatus != nil {
+		u.CompletionTimestamp = tr.TrainingStatus.CompletionTimestamp
+	}
+	if err := s.usageRepo.Record(u); err != nil {
+		logr.WithError(err).Errorf("Cannot record the usage of training %s", trainingID)
+	}
+}
+
+// resourceUsage computes the resources consumed by the learners of a training from its job history. The learners
+// count from the time they start processing until the training leaves the PROCESSING and STORING statuses, which
+// happens once per attempt of a training that is retried or resumed.
+func resourceUsage(tr *TrainingRecord, history []*JobHistoryEntry) *grpc_trainer_v2.ResourceUsage {
+	var running, start int64
+	for _, e := range history {
+		ts, err := strconv.ParseInt(e.Timestamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch e.Status {
+		case grpc_trainer_v2.Status_PROCESSING:
+			if start == 0 {
+				start = ts
+			}
+		case grpc_trainer_v2.Status_STORING:
+		default:
+			if start != 0 && ts > start {
+				running += ts - start
+			}
+			start = 0
+		}
+	}
+
+	usage := &grpc_trainer_v2.ResourceUsage{}
+	if tr.Training == nil || tr.Training.Resources == nil || running == 0 {
+		return usage
+	}
+	resources := tr.Training.Resources
+	learnerHours := float64(running) / millisecondsPerHour
+	if resources.Learners > 1 {
+		learnerHours *= float64(resources.Learners)
+	}
+	usage.GpuHours = float64(resources.Gpus) * learnerHours
+	usage.CpuHours = float64(resources.Cpus) * learnerHours
+	usage.MemoryHours = memoryGiB(resources) * learnerHours
+	return usage
+}
+
+// memoryGiB returns the memory requested by each learner in GiB
+func memoryGiB(resources *grpc_trainer_v2.ResourceRequirements) float64 {
+	memory := float64(resources.Memory)
+	switch resources.MemoryUnit {
+	case grpc_trainer_v2.SizeUnit_MB:
+		return memory * 1000 * 1000 / (1 << 30)
+	case grpc_trainer_v2.SizeUnit_MiB:
+		return memory / 1024
+	case grpc_trainer_v2.SizeUnit_GB:
+		return memory * 1000 * 1000 * 1000 / (1 << 30)
+	}
+	return memory
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const hour = millisecondsPerHour
+
+func historyEntry(id string, status grpc_trainer_v2.Status, millis int64) *JobHistoryEntry {
+	return &JobHistoryEntry{TrainingID: id, Status: status, Timestamp: formatMillis(millis)}
+}
+
+// formatMillis returns the timestamp of a time relative to an arbitrary point, with the 13 digits of actual timestamps
+func formatMillis(millis int64) string {
+	return strconv.FormatInt(1500000000000+millis, 10)
+}
+
+func createUsageRecord(id string, userID string, completed int64, usage *grpc_trainer_v2.ResourceUsage) *UsageRecord {
+	return &UsageRecord{TrainingID: id, UserID: userID, CompletionTimestamp: formatMillis(completed), Usage: usage}
+}
+
+func TestResourceUsage(t *testing.T) {
+	tr := createQuotaRecord("training-1", "alice", 2, 2, grpc_trainer_v2.Status_COMPLETED)
+	tr.Training.Resources.Cpus = 4
+	tr.Training.Resources.Memory = 512
+	tr.Training.Resources.MemoryUnit = grpc_trainer_v2.SizeUnit_MiB
+
+	history := []*JobHistoryEntry{
+		historyEntry("training-1", grpc_trainer_v2.Status_QUEUED, 0),
+		historyEntry("training-1", grpc_trainer_v2.Status_PROCESSING, hour),
+		historyEntry("training-1", grpc_trainer_v2.Status_PROCESSING, 2*hour),
+		// the first attempt is halted and resumed
+		historyEntry("training-1", grpc_trainer_v2.Status_HALTED, 3*hour),
+		historyEntry("training-1", grpc_trainer_v2.Status_PENDING, 10*hour),
+		historyEntry("training-1", grpc_trainer_v2.Status_DOWNLOADING, 11*hour),
+		historyEntry("training-1", grpc_trainer_v2.Status_PROCESSING, 12*hour),
+		historyEntry("training-1", grpc_trainer_v2.Status_STORING, 13*hour),
+		historyEntry("training-1", grpc_trainer_v2.Status_COMPLETED, 14*hour),
+	}
+	// 4 hours running, for 2 learners
+	assert.Equal(t, &grpc_trainer_v2.ResourceUsage{GpuHours: 16, CpuHours: 32, MemoryHours: 4}, resourceUsage(tr, history))
+
+	// trainings that never ran consumed nothing
+	assert.Equal(t, &grpc_trainer_v2.ResourceUsage{}, resourceUsage(tr, history[:1]))
+}
+
+func TestUpdateTrainingJobRecordsUsage(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	assert.NoError(t, s.repo.Store(createParentRecord("training-1", "alice", grpc_trainer_v2.Status_PENDING)))
+
+	for _, e := range []*JobHistoryEntry{
+		historyEntry("training-1", grpc_trainer_v2.Status_PROCESSING, hour),
+		historyEntry("training-1", grpc_trainer_v2.Status_COMPLETED, 3*hour),
+	} {
+		_, err := s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+			TrainingId: e.TrainingID,
+			UserId:     "alice",
+			Status:     e.Status,
+			Timestamp:  e.Timestamp,
+		})
+		assert.NoError(t, err)
+	}
+
+	records, err := s.usageRepo.Find(&usageQuery{})
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "training-1", records[0].TrainingID)
+		assert.Equal(t, "alice", records[0].UserID)
+		assert.Equal(t, formatMillis(3*hour), records[0].CompletionTimestamp)
+		assert.Equal(t, &grpc_trainer_v2.ResourceUsage{GpuHours: 2}, records[0].Usage)
+	}
+
+	// the usage is grouped by the current labels of the training
+	_, err = s.SetTrainingJobLabels(context.Background(), &grpc_trainer_v2.LabelsRequest{
+		TrainingId: "training-1",
+		UserId:     "alice",
+		Labels:     map[string]string{"team": "vision"},
+	})
+	assert.NoError(t, err)
+	records, err = s.usageRepo.Find(&usageQuery{})
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, map[string]string{"team": "vision"}, records[0].Labels)
+	}
+}
+
+func TestGetUsage(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	first := createUsageRecord("first", "alice", 10*hour, &grpc_trainer_v2.ResourceUsage{GpuHours: 1, CpuHours: 2, MemoryHours: 3})
+	first.Labels = map[string]string{"team": "vision"}
+	second := createUsageRecord("second", "alice", 20*hour, &grpc_trainer_v2.ResourceUsage{GpuHours: 4, CpuHours: 5, MemoryHours: 6})
+	second.Labels = map[string]string{"team": "speech"}
+	third := createUsageRecord("third", "bob", 30*hour, &grpc_trainer_v2.ResourceUsage{GpuHours: 8})
+	third.Labels = map[string]string{"team": "vision"}
+	// trainings that have not finished do not count
+	running := createUsageRecord("running", "bob", 0, &grpc_trainer_v2.ResourceUsage{GpuHours: 16})
+	running.CompletionTimestamp = ""
+	for _, u := range []*UsageRecord{first, second, third, running} {
+		assert.NoError(t, s.usageRepo.Record(u))
+	}
+
+	resp, err := s.GetUsage(context.Background(), &grpc_trainer_v2.UsageRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*grpc_trainer_v2.UsageEntry{
+		{Key: "alice", Trainings: 2, Usage: &grpc_trainer_v2.ResourceUsage{GpuHours: 5, CpuHours: 7, MemoryHours: 9}},
+		{Key: "bob", Trainings: 1, Usage: &grpc_trainer_v2.ResourceUsage{GpuHours: 8}},
+	}, resp.Entries)
+
+	resp, err = s.GetUsage(context.Background(), &grpc_trainer_v2.UsageRequest{GroupByLabel: "team"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*grpc_trainer_v2.UsageEntry{
+		{Key: "speech", Trainings: 1, Usage: &grpc_trainer_v2.ResourceUsage{GpuHours: 4, CpuHours: 5, MemoryHours: 6}},
+		{Key: "vision", Trainings: 2, Usage: &grpc_trainer_v2.ResourceUsage{GpuHours: 9, CpuHours: 2, MemoryHours: 3}},
+	}, resp.Entries)
+
+	resp, err = s.GetUsage(context.Background(), &grpc_trainer_v2.UsageRequest{
+		UserId:          "alice",
+		LabelSelector:   "team=vision",
+		CompletedAfter:  1500000000000 + 5*hour,
+		CompletedBefore: 1500000000000 + 25*hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*grpc_trainer_v2.UsageEntry{
+		{Key: "alice", Trainings: 1, Usage: &grpc_trainer_v2.ResourceUsage{GpuHours: 1, CpuHours: 2, MemoryHours: 3}},
+	}, resp.Entries)
+
+	resp, err = s.GetUsage(context.Background(), &grpc_trainer_v2.UsageRequest{CompletedAfter: 1500000000000 + 40*hour})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Entries)
+
+	_, err = s.GetUsage(context.Background(), &grpc_trainer_v2.UsageRequest{LabelSelector: "team=vision/cv"})
+	assert.Equal(t, codes.InvalidArgument, grpcCode(err))
+}
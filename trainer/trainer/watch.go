@@ -0,0 +1,279 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/FfDL/commons/logger"
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/mgo.v2"
+)
+
+// statusWatchers wakes up the watch streams of a user when one of the user's trainings records a status transition.
+// Only the streams served by this trainer replica are woken up, the streams served by other replicas notice the
+// transition when they poll.
+type statusWatchers struct {
+	mtx sync.Mutex
+	// the user id of each subscription
+	subs map[chan string]string
+}
+
+func newStatusWatchers() *statusWatchers {
+	return &statusWatchers{subs: make(map[chan string]string)}
+}
+
+// subscribe returns a channel receiving the ids of the trainings of the user that changed their status
+func (w *statusWatchers) subscribe(userID string) chan string {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	ch := make(chan string, 16)
+	w.subs[ch] = userID
+	return ch
+}
+
+func (w *statusWatchers) unsubscribe(ch chan string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	delete(w.subs, ch)
+}
+
+// notify wakes up the watch streams of a user. A stream that is too busy to take the notification catches up when
+// it polls.
+func (w *statusWatchers) notify(userID string, trainingID string) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	for ch, user := range w.subs {
+		if user != userID {
+			continue
+		}
+		select {
+		case ch <- trainingID:
+		default:
+		}
+	}
+}
+
+// recordJobStatus stores a status transition in the job history and wakes up the streams watching the training
+func (s *trainerService) recordJobStatus(userID string, e *JobHistoryEntry) {
+	s.jobHistoryRepo.RecordJobStatus(e)
+	s.watchers.notify(userID, e.TrainingID)
+}
+
+// trainingStatusID returns the status of the training, NOT_STARTED if it has none
+func trainingStatusID(tr *TrainingRecord) grpc_trainer_v2.Status {
+	if tr.TrainingStatus == nil {
+		return grpc_trainer_v2.Status_NOT_STARTED
+	}
+	return tr.TrainingStatus.Status
+}
+
+func watchPollInterval() time.Duration {
+	return time.Duration(viper.GetInt(watchPollIntervalKey)) * time.Second
+}
+
+// statusStream sends the status transitions of trainings to a watch stream, each of them once
+type statusStream struct {
+	s    *trainerService
+	send func(*grpc_trainer_v2.StatusEvent) error
+	// only transitions at or after this time are sent, "" to send all of them
+	since string
+	// the number of transitions sent for each training
+	sent map[string]int
+	// the status of the last transition sent for each training
+	last map[string]grpc_trainer_v2.Status
+}
+
+func newStatusStream(s *trainerService, since string, send func(*grpc_trainer_v2.StatusEvent) error) *statusStream {
+	return &statusStream{
+		s:     s,
+		send:  send,
+		since: since,
+		sent:  make(map[string]int),
+		last:  make(map[string]grpc_trainer_v2.Status),
+	}
+}
+
+// sendTransitions sends the transitions of the training that were not sent yet
+func (st *statusStream) sendTransitions(tr *TrainingRecord) error {
+	var entries []*grpc_trainer_v2.StatusHistoryEntry
+	for _, e := range statusHistory(tr, st.s.jobHistoryRepo.GetJobStatusHistory(tr.TrainingID), time.Now()) {
+		if e.Timestamp >= st.since {
+			entries = append(entries, e)
+		}
+	}
+	if st.sent[tr.TrainingID] > len(entries) {
+		// the job history only grows, this only guards against slicing out of range
+		st.sent[tr.TrainingID] = len(entries)
+	}
+	for _, e := range entries[st.sent[tr.TrainingID]:] {
+		err := st.send(&grpc_trainer_v2.StatusEvent{
+			TrainingId:    tr.TrainingID,
+			Status:        e.Status,
+			Timestamp:     e.Timestamp,
+			StatusMessage: e.StatusMessage,
+			ErrorCode:     e.ErrorCode,
+		})
+		if err != nil {
+			return err
+		}
+		st.sent[tr.TrainingID]++
+		st.last[tr.TrainingID] = e.Status
+	}
+	return nil
+}
+
+// finished returns true if the final status of the training has been sent. The record is updated before the job
+// history, so a training can be finished before its last transition is recorded.
+func (st *statusStream) finished(tr *TrainingRecord) bool {
+	status := tr.TrainingStatus.Status
+	last, ok := st.last[tr.TrainingID]
+	return isFinalStatus(status) && ok && last == status
+}
+
+// WatchTrainingJob streams the status transitions of a training until it has finished
+func (s *trainerService) WatchTrainingJob(req *grpc_trainer_v2.GetRequest, stream grpc_trainer_v2.Trainer_WatchTrainingJobServer) error {
+	logr := logger.LocLogger(logWith(req.TrainingId, req.UserId))
+	logr.Debugf("WatchTrainingJob called for training %s", req.TrainingId)
+
+	tr, err := s.repo.Find(req.TrainingId)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return gerrf(codes.NotFound, "Training with id %s not found.", req.TrainingId)
+		}
+		logr.WithError(err).Errorf("Cannot retrieve training record")
+		return gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	if tr.UserID != req.UserId {
+		msg := fmt.Sprint("User does not have permission to read training data")
+		logr.Error(msg)
+		return gerrf(codes.PermissionDenied, msg)
+	}
+
+	wakeups := s.watchers.subscribe(req.UserId)
+	defer s.watchers.unsubscribe(wakeups)
+	ticker := time.NewTicker(watchPollInterval())
+	defer ticker.Stop()
+
+	st := newStatusStream(s, "", stream.Send)
+	for {
+		if err := st.sendTransitions(tr); err != nil {
+			logr.WithError(err).Debugf("Cannot send status transition")
+			return err
+		}
+		if st.finished(tr) {
+			return nil
+		}
+
+		woken := false
+		for !woken {
+			select {
+			case <-stream.Context().Done():
+				return nil
+			case <-ticker.C:
+				woken = true
+			case id := <-wakeups:
+				woken = id == req.TrainingId
+			}
+		}
+
+		tr, err = s.repo.Find(req.TrainingId)
+		if err != nil {
+			if err == mgo.ErrNotFound {
+				// the training was deleted
+				return nil
+			}
+			logr.WithError(err).Errorf("Cannot retrieve training record")
+			return gerrf(codes.Internal, grpcErrorDesc(err))
+		}
+	}
+}
+
+// WatchUserTrainingJobs streams the status transitions of all trainings of a user from the time of the call on
+func (s *trainerService) WatchUserTrainingJobs(req *grpc_trainer_v2.WatchRequest, stream grpc_trainer_v2.Trainer_WatchUserTrainingJobsServer) error {
+	logr := logger.LocLogger(logEntry().WithField(logger.LogkeyUserID, req.UserId))
+	logr.Debugf("WatchUserTrainingJobs called")
+
+	wakeups := s.watchers.subscribe(req.UserId)
+	defer s.watchers.unsubscribe(wakeups)
+	ticker := time.NewTicker(watchPollInterval())
+	defer ticker.Stop()
+
+	st := newStatusStream(s, trainerClient.CurrentTimestampAsString(), stream.Send)
+
+	// the status of each training when it was last checked, to find the trainings that changed when polling
+	known := make(map[string]grpc_trainer_v2.Status)
+	trainings, err := s.repo.FindAllStatuses(req.UserId)
+	if err != nil {
+		logr.WithError(err).Errorf("Cannot retrieve training records")
+		return gerrf(codes.Internal, grpcErrorDesc(err))
+	}
+	for _, tr := range trainings {
+		known[tr.TrainingID] = trainingStatusID(tr)
+	}
+
+	for {
+		var changed []*TrainingRecord
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case id := <-wakeups:
+			tr, err := s.repo.Find(id)
+			if err != nil {
+				if err != mgo.ErrNotFound {
+					logr.WithError(err).Warnf("Cannot retrieve training %s", id)
+				}
+				continue
+			}
+			changed = append(changed, tr)
+		case <-ticker.C:
+			// the notifications only cover the transitions recorded by this trainer replica, polling catches the
+			// others. Only the statuses are loaded, and the full records of the trainings that changed.
+			trainings, err := s.repo.FindAllStatuses(req.UserId)
+			if err != nil {
+				logr.WithError(err).Warnf("Cannot retrieve training records")
+				continue
+			}
+			for _, t := range trainings {
+				if status, ok := known[t.TrainingID]; ok && status == trainingStatusID(t) {
+					continue
+				}
+				tr, err := s.repo.Find(t.TrainingID)
+				if err != nil {
+					if err != mgo.ErrNotFound {
+						logr.WithError(err).Warnf("Cannot retrieve training %s", t.TrainingID)
+					}
+					continue
+				}
+				changed = append(changed, tr)
+			}
+		}
+
+		for _, tr := range changed {
+			known[tr.TrainingID] = trainingStatusID(tr)
+			if err := st.sendTransitions(tr); err != nil {
+				logr.WithError(err).Debugf("Cannot send status transition")
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2017-2018 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trainer
+
+import (
+	"testing"
+	"time"
+
+	trainerClient "github.com/IBM/FfDL/trainer/client"
+	"github.com/IBM/FfDL/trainer/trainer/grpc_trainer_v2"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// fakeStatusStream serves as the stream of both watch calls
+type fakeStatusStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *grpc_trainer_v2.StatusEvent
+}
+
+func newFakeStatusStream(ctx context.Context) *fakeStatusStream {
+	return &fakeStatusStream{ctx: ctx, events: make(chan *grpc_trainer_v2.StatusEvent, 10)}
+}
+
+func (f *fakeStatusStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeStatusStream) Send(e *grpc_trainer_v2.StatusEvent) error {
+	f.events <- e
+	return nil
+}
+
+func (f *fakeStatusStream) next(t *testing.T) *grpc_trainer_v2.StatusEvent {
+	select {
+	case e := <-f.events:
+		return e
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "no status event received")
+		return &grpc_trainer_v2.StatusEvent{}
+	}
+}
+
+func waitForWatchers(t *testing.T, s *trainerService, n int) {
+	for i := 0; i < 500; i++ {
+		s.watchers.mtx.Lock()
+		subscribed := len(s.watchers.subs)
+		s.watchers.mtx.Unlock()
+		if subscribed >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Fail(t, "watch stream did not subscribe")
+}
+
+func TestWatchTrainingJob(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	assert.NoError(t, s.repo.Store(createParentRecord("watched", "alice", grpc_trainer_v2.Status_PROCESSING)))
+	assert.NoError(t, s.jobHistoryRepo.RecordJobStatus(&JobHistoryEntry{
+		TrainingID: "watched",
+		Timestamp:  "1500000000000",
+		Status:     grpc_trainer_v2.Status_PROCESSING,
+	}))
+
+	stream := newFakeStatusStream(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- s.WatchTrainingJob(&grpc_trainer_v2.GetRequest{TrainingId: "watched", UserId: "alice"}, stream)
+	}()
+
+	// the transitions the training already went through come first
+	assert.Equal(t, grpc_trainer_v2.Status_PROCESSING, stream.next(t).Status)
+
+	_, err := s.UpdateTrainingJob(context.Background(), &grpc_trainer_v2.UpdateRequest{
+		TrainingId: "watched",
+		UserId:     "alice",
+		Status:     grpc_trainer_v2.Status_FAILED,
+		ErrorCode:  "C201",
+		Timestamp:  "1500000100000",
+	})
+	assert.NoError(t, err)
+	e := stream.next(t)
+	assert.Equal(t, "watched", e.TrainingId)
+	assert.Equal(t, grpc_trainer_v2.Status_FAILED, e.Status)
+	assert.Equal(t, "C201", e.ErrorCode)
+	assert.Equal(t, "1500000100000", e.Timestamp)
+
+	// the stream ends with the training
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "watch stream did not end")
+	}
+
+	err = s.WatchTrainingJob(&grpc_trainer_v2.GetRequest{TrainingId: "watched", UserId: "bob"}, stream)
+	assert.Equal(t, codes.PermissionDenied, grpcCode(err))
+	err = s.WatchTrainingJob(&grpc_trainer_v2.GetRequest{TrainingId: "missing", UserId: "alice"}, stream)
+	assert.Equal(t, codes.NotFound, grpcCode(err))
+}
+
+func TestWatchUserTrainingJobs(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	assert.NoError(t, s.repo.Store(createParentRecord("a", "alice", grpc_trainer_v2.Status_PROCESSING)))
+	assert.NoError(t, s.repo.Store(createParentRecord("b", "bob", grpc_trainer_v2.Status_PROCESSING)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newFakeStatusStream(ctx)
+	done := make(chan error)
+	go func() {
+		done <- s.WatchUserTrainingJobs(&grpc_trainer_v2.WatchRequest{UserId: "alice"}, stream)
+	}()
+	waitForWatchers(t, s, 1)
+
+	for _, update := range []*grpc_trainer_v2.UpdateRequest{
+		{TrainingId: "b", UserId: "bob", Status: grpc_trainer_v2.Status_COMPLETED},
+		{TrainingId: "a", UserId: "alice", Status: grpc_trainer_v2.Status_STORING},
+		{TrainingId: "a", UserId: "alice", Status: grpc_trainer_v2.Status_COMPLETED},
+	} {
+		_, err := s.UpdateTrainingJob(context.Background(), update)
+		assert.NoError(t, err)
+	}
+
+	// only the trainings of the user are streamed
+	for _, status := range []grpc_trainer_v2.Status{grpc_trainer_v2.Status_STORING, grpc_trainer_v2.Status_COMPLETED} {
+		e := stream.next(t)
+		assert.Equal(t, "a", e.TrainingId)
+		assert.Equal(t, status, e.Status)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "watch stream did not end")
+	}
+	assert.Empty(t, stream.events)
+}
+
+func TestWatchUserTrainingJobsPolls(t *testing.T) {
+	s := newInMemTestService(t, map[string]*queueHandler{})
+	assert.NoError(t, s.repo.Store(createParentRecord("a", "alice", grpc_trainer_v2.Status_PROCESSING)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newFakeStatusStream(ctx)
+	go s.WatchUserTrainingJobs(&grpc_trainer_v2.WatchRequest{UserId: "alice"}, stream)
+	waitForWatchers(t, s, 1)
+	time.Sleep(10 * time.Millisecond)
+
+	// another trainer replica records the transition, so this one is not notified
+	tr, err := s.repo.Find("a")
+	assert.NoError(t, err)
+	tr.TrainingStatus.Status = grpc_trainer_v2.Status_COMPLETED
+	assert.NoError(t, s.repo.Store(tr))
+	assert.NoError(t, s.jobHistoryRepo.RecordJobStatus(&JobHistoryEntry{
+		TrainingID: "a",
+		Timestamp:  trainerClient.CurrentTimestampAsString(),
+		Status:     grpc_trainer_v2.Status_COMPLETED,
+	}))
+
+	e := stream.next(t)
+	assert.Equal(t, "a", e.TrainingId)
+	assert.Equal(t, grpc_trainer_v2.Status_COMPLETED, e.Status)
+}